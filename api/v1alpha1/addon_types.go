@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	nutanixv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/github.com/nutanix-cloud-native/cluster-api-provider-nutanix/api/v1beta1"
 )
@@ -20,14 +21,27 @@ const (
 	CNIProviderCilium = "Cilium"
 	CNIProviderFlow   = "Flow"
 
+	CiliumEncryptionTypeWireGuard = "WireGuard"
+	CiliumEncryptionTypeIPsec     = "IPsec"
+
+	CiliumRoutingModeTunnel = "Tunnel"
+	CiliumRoutingModeNative = "Native"
+
+	CiliumTunnelProtocolVXLAN  = "VXLAN"
+	CiliumTunnelProtocolGeneve = "Geneve"
+
 	CSIProviderAWSEBS    = "aws-ebs"
 	CSIProviderNutanix   = "nutanix"
 	CSIProviderLocalPath = "local-path"
 
-	VirtualIPProviderKubeVIP = "KubeVIP"
+	VirtualIPProviderKubeVIP           = "KubeVIP"
+	VirtualIPProviderKeepalivedHAProxy = "KeepalivedHAProxy"
 
 	ServiceLoadBalancerProviderMetalLB = "MetalLB"
 
+	ServiceLoadBalancerAdvertisementModeL2  = "L2"
+	ServiceLoadBalancerAdvertisementModeBGP = "BGP"
+
 	RegistryProviderCNCFDistribution = "CNCF Distribution"
 
 	IngressProviderAWSLoadBalancerController = "aws-lb-controller"
@@ -35,6 +49,9 @@ const (
 	AddonStrategyClusterResourceSet AddonStrategy = "ClusterResourceSet"
 	AddonStrategyHelmAddon          AddonStrategy = "HelmAddon"
 
+	AddonValuesModeReplace AddonValuesMode = "Replace"
+	AddonValuesModeMerge   AddonValuesMode = "Merge"
+
 	VolumeBindingImmediate            = storagev1.VolumeBindingImmediate
 	VolumeBindingWaitForFirstConsumer = storagev1.VolumeBindingWaitForFirstConsumer
 
@@ -120,10 +137,36 @@ type GenericAddons struct {
 
 	// +kubebuilder:validation:Optional
 	Registry *RegistryAddon `json:"registry,omitempty"`
+
+	// HelmChartOverrides overrides the Helm charts of individual addon components for the cluster, e.g. to pin or
+	// advance a component independently of the default Helm charts.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=component
+	HelmChartOverrides []HelmChartOverride `json:"helmChartOverrides,omitempty"`
+}
+
+// HelmChartOverride overrides the Helm chart of an addon component.
+type HelmChartOverride struct {
+	// Component is the name of the addon component, as used in the default Helm addons ConfigMap, e.g. cilium.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Component string `json:"component"`
+
+	// Version of the Helm chart.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// RepositoryURL of the Helm chart. Defaults to the repository of the default Helm chart.
+	// +kubebuilder:validation:Optional
+	RepositoryURL string `json:"repositoryURL,omitempty"`
 }
 
 type AddonStrategy string
 
+type AddonValuesMode string
+
 // CNI defines CNI provider configuration.
 type CNI struct {
 	// CNI provider to deploy.
@@ -145,6 +188,11 @@ type CNI struct {
 	// for the CNI provider images. The Secret will be copied to the workload cluster.
 	// +kubebuilder:validation:Optional
 	ImagePullCredentials *ImagePullCredentials `json:"imagePullCredentials,omitempty"`
+
+	// Cilium contains settings for the Cilium CNI provider, that are merged into its Helm values.
+	// Only supported with the Cilium provider and the HelmAddon strategy.
+	// +kubebuilder:validation:Optional
+	Cilium *CiliumConfiguration `json:"cilium,omitempty"`
 }
 
 // NutanixCNI defines CNI configuration for Nutanix clusters, which additionally support Flow.
@@ -168,11 +216,116 @@ type NutanixCNI struct {
 	// for the CNI provider images. The Secret will be copied to the workload cluster.
 	// +kubebuilder:validation:Optional
 	ImagePullCredentials *ImagePullCredentials `json:"imagePullCredentials,omitempty"`
+
+	// Cilium contains settings for the Cilium CNI provider, that are merged into its Helm values.
+	// Only supported with the Cilium provider and the HelmAddon strategy.
+	// +kubebuilder:validation:Optional
+	Cilium *CiliumConfiguration `json:"cilium,omitempty"`
+}
+
+// CiliumConfiguration contains settings for the Cilium CNI provider.
+type CiliumConfiguration struct {
+	// Hubble configures the Hubble observability platform.
+	// +kubebuilder:validation:Optional
+	Hubble *CiliumHubble `json:"hubble,omitempty"`
+
+	// Encryption configures transparent encryption of the traffic between pods.
+	// +kubebuilder:validation:Optional
+	Encryption *CiliumEncryption `json:"encryption,omitempty"`
+
+	// Routing configures how the traffic between pods on different nodes is routed.
+	// +kubebuilder:validation:Optional
+	Routing *CiliumRouting `json:"routing,omitempty"`
+
+	// BandwidthManager configures the bandwidth manager, that enforces the egress bandwidth limits
+	// set on pods with the kubernetes.io/egress-bandwidth annotation.
+	// +kubebuilder:validation:Optional
+	BandwidthManager *CiliumBandwidthManager `json:"bandwidthManager,omitempty"`
+
+	// BPFMasquerade masquerades the traffic leaving the cluster using eBPF, instead of iptables.
+	// Requires kube-proxy to be disabled.
+	// +kubebuilder:validation:Optional
+	BPFMasquerade *bool `json:"bpfMasquerade,omitempty"`
+}
+
+// CiliumHubble configures the Hubble observability platform.
+type CiliumHubble struct {
+	// Enabled enables Hubble on every node.
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Relay enables Hubble Relay, that aggregates the flows of all the nodes.
+	// Requires Hubble to be enabled.
+	// +kubebuilder:validation:Optional
+	Relay *bool `json:"relay,omitempty"`
+
+	// UI enables the Hubble UI, that visualizes the flows aggregated by Hubble Relay.
+	// Requires Hubble Relay to be enabled.
+	// +kubebuilder:validation:Optional
+	UI *bool `json:"ui,omitempty"`
+}
+
+// CiliumEncryption configures transparent encryption of the traffic between pods.
+type CiliumEncryption struct {
+	// Type of encryption.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=WireGuard;IPsec
+	Type string `json:"type"`
+
+	// NodeEncryption also encrypts the traffic between nodes, and between pods and nodes.
+	// Only supported with WireGuard.
+	// +kubebuilder:validation:Optional
+	NodeEncryption bool `json:"nodeEncryption,omitempty"`
+
+	// A reference to the Secret containing the IPsec keys in the `keys` key.
+	// The Secret must be in the same namespace as the Cluster, and is copied to the workload cluster.
+	// Required with IPsec.
+	// +kubebuilder:validation:Optional
+	IPsecKeySecretRef *LocalObjectReference `json:"ipsecKeySecretRef,omitempty"`
+}
+
+// CiliumRouting configures how the traffic between pods on different nodes is routed.
+type CiliumRouting struct {
+	// Mode is Tunnel to encapsulate the traffic between nodes, or Native to route it using the network
+	// of the nodes.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Tunnel;Native
+	Mode string `json:"mode"`
+
+	// TunnelProtocol is the encapsulation protocol. Only supported with the Tunnel mode.
+	// Must be Geneve if kube-proxy is disabled.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=VXLAN;Geneve
+	TunnelProtocol string `json:"tunnelProtocol,omitempty"`
+
+	// IPv4NativeRoutingCIDR is the CIDR in which the traffic is routed without masquerading.
+	// Required with the Native mode.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=cidr
+	// +kubebuilder:validation:MaxLength=18
+	IPv4NativeRoutingCIDR string `json:"ipv4NativeRoutingCIDR,omitempty"`
+
+	// AutoDirectNodeRoutes adds routes to the pod CIDRs of the other nodes, if all the nodes share an L2 network.
+	// Only supported with the Native mode.
+	// +kubebuilder:validation:Optional
+	AutoDirectNodeRoutes bool `json:"autoDirectNodeRoutes,omitempty"`
+}
+
+// CiliumBandwidthManager configures the bandwidth manager.
+type CiliumBandwidthManager struct {
+	// Enabled enables the bandwidth manager.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// BBR uses the BBR TCP congestion control for pods. Requires the bandwidth manager to be enabled,
+	// and a Linux kernel 5.18 or later on the nodes.
+	// +kubebuilder:validation:Optional
+	BBR bool `json:"bbr,omitempty"`
 }
 
 // AddonConfig contains the configuration for the Addon provider.
 type AddonConfig struct {
-	// Values contains the helm values for the CNI when HelmAddon is the strategy.
+	// Values contains the helm values for the addon when HelmAddon is the strategy.
 	// +kubebuilder:validation:Optional
 	Values *AddonValues `json:"values,omitempty"`
 }
@@ -183,6 +336,13 @@ type AddonValues struct {
 	// which contains inline YAML representing the values for the Helm chart.
 	// +kubebuilder:validation:Optional
 	SourceRef *ValuesReference `json:"sourceRef,omitempty"`
+
+	// Mode is Replace to use the values of SourceRef instead of the default values, or Merge to
+	// deep-merge them over the default values. When merging, lists replace the lists of the default values.
+	// Merge is only supported with the HelmAddon strategy. Defaults to Replace.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Replace;Merge
+	Mode AddonValuesMode `json:"mode,omitempty"`
 }
 
 type ImagePullCredentials struct {
@@ -214,9 +374,14 @@ type NFD struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=ClusterResourceSet;HelmAddon
 	Strategy AddonStrategy `json:"strategy,omitzero"`
+
+	// AddonConfig contains the configuration for the Node Feature Discovery (NFD).
+	// +kubebuilder:validation:Optional
+	AddonConfig `json:",inline"`
 }
 
 // ClusterAutoscaler tells us to enable or disable the cluster-autoscaler addon.
+// +kubebuilder:validation:XValidation:rule="!has(self.priorities) || (has(self.expander) && self.expander == 'priority')",message="priorities can only be set when the expander is priority"
 type ClusterAutoscaler struct {
 	// Addon strategy used to deploy cluster-autoscaler to the management cluster
 	// targeting the workload cluster.
@@ -224,6 +389,87 @@ type ClusterAutoscaler struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=ClusterResourceSet;HelmAddon
 	Strategy AddonStrategy `json:"strategy,omitzero"`
+
+	// AddonConfig contains the configuration for the cluster-autoscaler.
+	// +kubebuilder:validation:Optional
+	AddonConfig `json:",inline"`
+
+	// Expander is the strategy used to select the node group to scale up when more than one node group can be
+	// scaled up. Defaults to the cluster-autoscaler default, random.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=random;most-pods;least-waste;least-nodes;priority
+	Expander ClusterAutoscalerExpander `json:"expander,omitempty"`
+
+	// Priorities are the priorities of the node groups used by the priority expander. The node groups with names
+	// matching the patterns of the highest priority are scaled up first.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=map
+	// +listMapKey=priority
+	Priorities []ClusterAutoscalerNodeGroupPriority `json:"priorities,omitempty"`
+
+	// ScaleDown configures when cluster-autoscaler removes unneeded nodes.
+	// +kubebuilder:validation:Optional
+	ScaleDown *ClusterAutoscalerScaleDown `json:"scaleDown,omitempty"`
+
+	// BalanceSimilarNodeGroups keeps the sizes of node groups with the same instance type and labels balanced.
+	// +kubebuilder:validation:Optional
+	BalanceSimilarNodeGroups *bool `json:"balanceSimilarNodeGroups,omitempty"`
+
+	// MaxNodeProvisionTime is the maximum time cluster-autoscaler waits for a node to be provisioned before the
+	// node group is considered to have failed to scale up.
+	// +kubebuilder:validation:Optional
+	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty"`
+}
+
+type ClusterAutoscalerExpander string
+
+const (
+	ClusterAutoscalerExpanderRandom     ClusterAutoscalerExpander = "random"
+	ClusterAutoscalerExpanderMostPods   ClusterAutoscalerExpander = "most-pods"
+	ClusterAutoscalerExpanderLeastWaste ClusterAutoscalerExpander = "least-waste"
+	ClusterAutoscalerExpanderLeastNodes ClusterAutoscalerExpander = "least-nodes"
+	ClusterAutoscalerExpanderPriority   ClusterAutoscalerExpander = "priority"
+)
+
+// ClusterAutoscalerNodeGroupPriority is the priority of the node groups with names matching the patterns.
+type ClusterAutoscalerNodeGroupPriority struct {
+	// Priority of the node groups. Node groups with a higher priority are scaled up first.
+	// +kubebuilder:validation:Required
+	Priority int32 `json:"priority"`
+
+	// NodeGroupPatterns are regular expressions matched against the names of the node groups, e.g. ".*-gpu-.*".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	NodeGroupPatterns []string `json:"nodeGroupPatterns"`
+}
+
+// ClusterAutoscalerScaleDown configures when cluster-autoscaler removes unneeded nodes.
+type ClusterAutoscalerScaleDown struct {
+	// DelayAfterAdd is how long after a scale up the scale down evaluation resumes.
+	// +kubebuilder:validation:Optional
+	DelayAfterAdd *metav1.Duration `json:"delayAfterAdd,omitempty"`
+
+	// DelayAfterDelete is how long after a node is deleted the scale down evaluation resumes.
+	// +kubebuilder:validation:Optional
+	DelayAfterDelete *metav1.Duration `json:"delayAfterDelete,omitempty"`
+
+	// DelayAfterFailure is how long after a failed scale down the scale down evaluation resumes.
+	// +kubebuilder:validation:Optional
+	DelayAfterFailure *metav1.Duration `json:"delayAfterFailure,omitempty"`
+
+	// UnneededTime is how long a node must be unneeded before it is removed.
+	// +kubebuilder:validation:Optional
+	UnneededTime *metav1.Duration `json:"unneededTime,omitempty"`
+
+	// UtilizationThreshold is the ratio of the requested resources to the allocatable resources of a node, between
+	// 0 and 1, below which the node can be considered for removal, e.g. "0.5".
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	UtilizationThreshold string `json:"utilizationThreshold,omitempty"`
 }
 
 type GenericCSI struct {
@@ -241,6 +487,10 @@ type GenericCOSI struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=HelmAddon
 	Strategy AddonStrategy `json:"strategy,omitzero"`
+
+	// AddonConfig contains the configuration for the COSI controller.
+	// +kubebuilder:validation:Optional
+	AddonConfig `json:",inline"`
 }
 
 type SnapshotController struct {
@@ -314,6 +564,10 @@ type CSIProvider struct {
 	// The reference to any secret used by the CSI Provider.
 	// +kubebuilder:validation:Optional
 	Credentials *CSICredentials `json:"credentials,omitempty"`
+
+	// AddonConfig contains the configuration for the CSI provider.
+	// +kubebuilder:validation:Optional
+	AddonConfig `json:",inline"`
 }
 
 type StorageClassConfig struct {
@@ -361,6 +615,10 @@ type CCM struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=ClusterResourceSet;HelmAddon
 	Strategy AddonStrategy `json:"strategy,omitzero"`
+
+	// AddonConfig contains the configuration for the CCM.
+	// +kubebuilder:validation:Optional
+	AddonConfig `json:",inline"`
 }
 
 type CCMCredentials struct {
@@ -379,26 +637,180 @@ type ServiceLoadBalancer struct {
 	// Configuration for the chosen ServiceLoadBalancer provider.
 	// +kubebuilder:validation:Optional
 	Configuration *ServiceLoadBalancerConfiguration `json:"configuration,omitempty"`
+
+	// AddonConfig contains the configuration for the ServiceLoadBalancer provider.
+	// +kubebuilder:validation:Optional
+	AddonConfig `json:",inline"`
 }
 
+// +kubebuilder:validation:XValidation:rule="has(self.addressRanges) != has(self.addressPools)",message="exactly one of addressRanges or addressPools must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.addressPools) || !self.addressPools.exists(p, has(p.advertisementMode) && p.advertisementMode == 'BGP') || has(self.bgpPeers)",message="bgpPeers must be set when an address pool uses the BGP advertisement mode"
 type ServiceLoadBalancerConfiguration struct {
-	// AddressRanges is a list of IPv4 address ranges the
+	// AddressRanges is a list of IPv4 and IPv6 address ranges the
 	// provider uses to choose an address for a load balancer.
+	// The addresses are advertised using L2.
+	// Mutually exclusive with addressPools.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	AddressRanges []AddressRange `json:"addressRanges,omitempty"`
+
+	// AddressPools is a list of named address pools the
+	// provider uses to choose an address for a load balancer.
+	// Each pool is advertised using its own advertisement mode.
+	// Mutually exclusive with addressRanges.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	AddressPools []ServiceLoadBalancerAddressPool `json:"addressPools,omitempty"`
+
+	// BGPPeers is a list of BGP routers the addresses of the pools
+	// using the BGP advertisement mode are advertised to.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	BGPPeers []ServiceLoadBalancerBGPPeer `json:"bgpPeers,omitempty"`
+}
+
+// AddressRange defines an IPv4 or IPv6 range, either from a start to an end address, or as a CIDR.
+// +kubebuilder:validation:XValidation:rule="has(self.cidr) != has(self.start)",message="exactly one of cidr or start and end must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.start) == has(self.end)",message="start and end must be set together"
+type AddressRange struct {
+	// Start is the first address of the range, for example 10.100.1.1 or fd00:100::1.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=45
+	Start string `json:"start,omitempty"`
+
+	// End is the last address of the range, for example 10.100.1.20 or fd00:100::20.
+	// It must be of the same family as start, and must not be before start.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=45
+	End string `json:"end,omitempty"`
+
+	// CIDR includes all the addresses of the range, for example 10.100.1.0/27 or fd00:100::/123.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=cidr
+	// +kubebuilder:validation:MaxLength=49
+	CIDR string `json:"cidr,omitempty"`
+}
+
+// String returns the range in CIDR or start-end notation.
+func (r AddressRange) String() string {
+	if r.CIDR != "" {
+		return r.CIDR
+	}
+	return r.Start + "-" + r.End
+}
+
+// ServiceLoadBalancerAddressPool defines a named pool of addresses, and how they are advertised.
+type ServiceLoadBalancerAddressPool struct {
+	// Name of the address pool.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// AddressRanges is a list of IPv4 and IPv6 address ranges in the pool.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=10
 	AddressRanges []AddressRange `json:"addressRanges"`
+
+	// AdvertisementMode is how the addresses of the pool are advertised.
+	// L2 answers ARP requests for the addresses from one node.
+	// BGP advertises the addresses to the BGP peers from all nodes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=L2;BGP
+	// +kubebuilder:default=L2
+	AdvertisementMode string `json:"advertisementMode,omitempty"`
+
+	// AutoAssign allows the provider to choose addresses from this pool automatically.
+	// If false, an address from the pool is only used if it is explicitly requested by the Service.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	AutoAssign *bool `json:"autoAssign,omitempty"`
 }
 
-// AddressRange defines an IPv4 range.
-type AddressRange struct {
-	// +kubebuilder:validation:Format=ipv4
+// ServiceLoadBalancerBGPPeer defines a BGP router to establish a session with.
+type ServiceLoadBalancerBGPPeer struct {
+	// Name of the BGP peer.
 	// +kubebuilder:validation:Required
-	Start string `json:"start"`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
 
-	// +kubebuilder:validation:Format=ipv4
+	// AS number to use for the local end of the session.
 	// +kubebuilder:validation:Required
-	End string `json:"end"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	MyASN int64 `json:"myASN"`
+
+	// AS number to expect from the remote end of the session.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	PeerASN int64 `json:"peerASN"`
+
+	// Address of the BGP peer.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Format=ipv4
+	PeerAddress string `json:"peerAddress"`
+
+	// Port of the BGP peer. Defaults to 179.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16384
+	PeerPort int32 `json:"peerPort,omitempty"`
+
+	// A reference to the Secret containing the password for TCP MD5 authenticated sessions.
+	// The Secret must be in the same namespace as the Cluster, must be of type kubernetes.io/basic-auth,
+	// and must contain the password in the `password` key.
+	// +kubebuilder:validation:Optional
+	PasswordSecretRef *LocalObjectReference `json:"passwordSecretRef,omitempty"`
+
+	// BFDProfile enables a BFD session with the BGP peer, to detect failures faster.
+	// +kubebuilder:validation:Optional
+	BFDProfile *ServiceLoadBalancerBFDProfile `json:"bfdProfile,omitempty"`
+
+	// EBGPMultiHop must be true if the BGP peer is multiple hops away.
+	// +kubebuilder:validation:Optional
+	EBGPMultiHop bool `json:"ebgpMultiHop,omitempty"`
+}
+
+// ServiceLoadBalancerBFDProfile defines the settings of a BFD session.
+type ServiceLoadBalancerBFDProfile struct {
+	// The minimum interval, in milliseconds, at which control packets can be received.
+	// Defaults to 300.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=60000
+	ReceiveInterval *int32 `json:"receiveInterval,omitempty"`
+
+	// The minimum interval, in milliseconds, at which control packets are sent.
+	// Defaults to 300.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=60000
+	TransmitInterval *int32 `json:"transmitInterval,omitempty"`
+
+	// The number of missed control packets after which the session is considered down.
+	// Defaults to 3.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=255
+	DetectMultiplier *int32 `json:"detectMultiplier,omitempty"`
+
+	// PassiveMode waits for control packets from the peer, instead of starting the session.
+	// +kubebuilder:validation:Optional
+	PassiveMode *bool `json:"passiveMode,omitempty"`
 }
 
 type RegistryAddon struct {
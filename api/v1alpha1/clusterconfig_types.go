@@ -420,13 +420,31 @@ type User struct {
 
 // EncryptionAtRest defines the configuration to enable encryption at REST
 // This configuration is used by API server to encrypt data before storing it in ETCD.
-// Currently the encryption only enabled for secrets and configmaps.
+// By default, secrets and configmaps are encrypted.
 type EncryptionAtRest struct {
-	// Encryption providers
+	// Encryption providers, and the resources they encrypt. The API server encrypts a resource with the
+	// providers of the first entry that lists the resource, or a wildcard that matches it.
 	// +kubebuilder:default={{aescbc:{}}}
-	// +kubebuilder:validation:MaxItems=1
+	// +kubebuilder:validation:MaxItems=10
 	// +kubebuilder:validation:Optional
 	Providers []EncryptionProviders `json:"providers,omitempty"`
+
+	// Rotation requests rotating the encryption keys.
+	// +kubebuilder:validation:Optional
+	Rotation *EncryptionAtRestRotation `json:"rotation,omitempty"`
+}
+
+// EncryptionAtRestRotation requests rotating the encryption keys. The keys are rotated without downtime:
+// a new key is added, the control plane is rolled out, the new key is made the key used to encrypt,
+// the control plane is rolled out again, the encrypted resources are re-encrypted with the new key,
+// and, finally, the old key is removed, and the control plane is rolled out a third time.
+type EncryptionAtRestRotation struct {
+	// Trigger identifies the requested rotation. Changing the value to one that differs from the
+	// last rotation starts a new rotation. For example, set it to the current date.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Trigger string `json:"trigger"`
 }
 
 type EncryptionProviders struct {
@@ -434,12 +452,81 @@ type EncryptionProviders struct {
 	AESCBC *AESConfiguration `json:"aescbc,omitempty"`
 	// +kubebuilder:validation:Optional
 	Secretbox *SecretboxConfiguration `json:"secretbox,omitempty"`
+	// KMS encrypts resources using an external Key Management Service, through a KMS v2 plugin.
+	// The KMS provider is used to encrypt, if it is set together with other providers.
+	// +kubebuilder:validation:Optional
+	KMS *KMSConfiguration `json:"kms,omitempty"`
+
+	// Order of the providers. The first provider encrypts, and all providers decrypt. Every provider
+	// that is set must be listed. Defaults to kms, aescbc, secretbox.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=3
+	// +listType=set
+	Order []EncryptionProviderName `json:"order,omitempty"`
+
+	// Resources encrypted by the providers, in the "<resource>.<group>" format, for example,
+	// "certificates.cert-manager.io", or "<resource>" for the core group. "*.<group>" encrypts all
+	// resources of a group, "*." all resources of the core group, and "*.*" all resources.
+	// Defaults to secrets and configmaps.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	Resources []string `json:"resources,omitempty"`
 }
 
+// EncryptionProviderName is the name of an encryption provider.
+// +kubebuilder:validation:Enum=aescbc;secretbox;kms
+type EncryptionProviderName string
+
+const (
+	EncryptionProviderAESCBC    EncryptionProviderName = "aescbc"
+	EncryptionProviderSecretbox EncryptionProviderName = "secretbox"
+	EncryptionProviderKMS       EncryptionProviderName = "kms"
+)
+
 type AESConfiguration struct{}
 
 type SecretboxConfiguration struct{}
 
+// KMSConfiguration configures the API server to use a KMS v2 plugin.
+type KMSConfiguration struct {
+	// Name of the KMS plugin. The name is stored with the encrypted data, so it must not change once
+	// resources are encrypted.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Endpoint is the Unix domain socket the KMS plugin listens on,
+	// for example, unix:///var/run/kms-plugin/socket.sock.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^unix:///.+$`
+	Endpoint string `json:"endpoint"`
+
+	// Timeout for calls to the KMS plugin. Defaults to 3s.
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Plugin runs the KMS plugin as a static pod on the control plane machines.
+	// If not set, the KMS plugin must listen on the endpoint before the API server starts.
+	// +kubebuilder:validation:Optional
+	Plugin *KMSPlugin `json:"plugin,omitempty"`
+}
+
+// KMSPlugin defines the static pod that runs the KMS plugin. The directory of the endpoint socket
+// is mounted into the static pod, and into the API server.
+type KMSPlugin struct {
+	// Image of the KMS plugin.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// Args of the KMS plugin container.
+	// +kubebuilder:validation:Optional
+	Args []string `json:"args,omitempty"`
+}
+
 // DNS defines the DNS configuration for the cluster.
 type DNS struct {
 	// CoreDNS defines the CoreDNS configuration for the cluster.
@@ -44,14 +44,39 @@ type ControlPlaneEndpointSpec struct {
 
 type ControlPlaneVirtualIPSpec struct {
 	// Virtual IP provider to deploy.
+	// KubeVIP deploys kube-vip, using ARP to announce the virtual IP.
+	// KeepalivedHAProxy deploys keepalived, using VRRP to assign the virtual IP to a healthy control plane
+	// machine, and HAProxy, forwarding the virtual IP port to the API server.
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:validation:Enum=KubeVIP
+	// +kubebuilder:validation:Enum=KubeVIP;KeepalivedHAProxy
 	// +kubebuilder:default=KubeVIP
 	Provider string `json:"provider,omitempty"`
 
 	// Configuration for the chosen control-plane virtual IP provider.
 	// +kubebuilder:validation:Optional
 	Configuration *ControlPlaneVirtualIPConfiguration `json:"configuration,omitempty"`
+
+	// Configuration for the KeepalivedHAProxy provider.
+	// Must not be set for other providers.
+	// +kubebuilder:validation:Optional
+	KeepalivedHAProxy *KeepalivedHAProxyConfiguration `json:"keepalivedHAProxy,omitempty"`
+}
+
+type KeepalivedHAProxyConfiguration struct {
+	// The VRRP virtual router ID, which must be unique for each cluster in the same network.
+	// If left empty, an ID derived from the namespace and name of the cluster will be used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	VirtualRouterID int32 `json:"virtualRouterID,omitempty"`
+
+	// The network interface to assign the virtual IP to.
+	// If left empty, the interface of the route to the virtual IP will be used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9._-]+$`
+	Interface string `json:"interface,omitempty"`
 }
 
 type ControlPlaneVirtualIPConfiguration struct {
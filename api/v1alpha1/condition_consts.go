@@ -0,0 +1,83 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+// Conditions set on the Cluster by the lifecycle handlers to report the status of each addon.
+const (
+	// AddonCNIReadyCondition reports the status of the CNI addon.
+	AddonCNIReadyCondition = "AddonCNIReady"
+	// AddonMultusReadyCondition reports the status of the Multus addon.
+	AddonMultusReadyCondition = "AddonMultusReady"
+	// AddonCSIReadyCondition reports the status of the CSI addons.
+	AddonCSIReadyCondition = "AddonCSIReady"
+	// AddonSnapshotControllerReadyCondition reports the status of the CSI snapshot controller addon.
+	AddonSnapshotControllerReadyCondition = "AddonSnapshotControllerReady"
+	// AddonCCMReadyCondition reports the status of the CCM addon.
+	AddonCCMReadyCondition = "AddonCCMReady"
+	// AddonNFDReadyCondition reports the status of the NFD addon.
+	AddonNFDReadyCondition = "AddonNFDReady"
+	// AddonClusterAutoscalerReadyCondition reports the status of the cluster-autoscaler addon.
+	AddonClusterAutoscalerReadyCondition = "AddonClusterAutoscalerReady"
+	// AddonCOSIReadyCondition reports the status of the COSI controller addon.
+	AddonCOSIReadyCondition = "AddonCOSIReady"
+	// AddonRegistryReadyCondition reports the status of the registry addon.
+	AddonRegistryReadyCondition = "AddonRegistryReady"
+	// AddonServiceLoadBalancerReadyCondition reports the status of the Service LoadBalancer addon.
+	AddonServiceLoadBalancerReadyCondition = "AddonServiceLoadBalancerReady"
+	// AddonKonnectorAgentReadyCondition reports the status of the konnector-agent addon.
+	AddonKonnectorAgentReadyCondition = "AddonKonnectorAgentReady"
+	// AddonAWSLoadBalancerControllerReadyCondition reports the status of the AWS Load Balancer
+	// Controller addon.
+	AddonAWSLoadBalancerControllerReadyCondition = "AddonAWSLoadBalancerControllerReady"
+
+	// AddonAppliedReason is the reason used when an addon was applied successfully.
+	AddonAppliedReason = "Applied"
+	// AddonApplyFailedReason is the reason used when an addon failed to be applied.
+	AddonApplyFailedReason = "ApplyFailed"
+)
+
+// Conditions set on the Cluster by the preflight checks webhook.
+const (
+	// PreflightChecksPassedCondition reports the results of the preflight checks that run in
+	// report-only mode.
+	PreflightChecksPassedCondition = "PreflightChecksPassed"
+
+	// PreflightChecksPassedReason is the reason used when all report-only checks passed.
+	PreflightChecksPassedReason = "Passed"
+	// PreflightChecksFailedReason is the reason used when at least one report-only check failed.
+	PreflightChecksFailedReason = "Failed"
+	// PreflightChecksInternalErrorReason is the reason used when at least one report-only check
+	// failed due to an internal error.
+	PreflightChecksInternalErrorReason = "InternalError"
+)
+
+// Conditions set on the Cluster by the encryption key rotation controller.
+const (
+	// EncryptionKeysRotatedCondition reports the progress of the requested encryption at rest key
+	// rotation.
+	EncryptionKeysRotatedCondition = "EncryptionKeysRotated"
+
+	// EncryptionKeysRotatedReason is the reason used when the requested rotation completed.
+	EncryptionKeysRotatedReason = "Rotated"
+	// EncryptionKeysRotationInProgressReason is the reason used while the requested rotation is in
+	// progress. The message reports the current phase.
+	EncryptionKeysRotationInProgressReason = "InProgress"
+	// EncryptionKeysRotationFailedReason is the reason used when the current phase of the rotation
+	// failed. The phase is retried.
+	EncryptionKeysRotationFailedReason = "Failed"
+)
+
+// Conditions set on MachineDeployments, MachinePools and Clusters by the Cluster Autoscaler limits controller.
+const (
+	// ClusterAutoscalerAnnotationsValidCondition reports whether the Cluster Autoscaler annotations of a
+	// MachineDeployment or MachinePool, or of the MachineDeployments and MachinePools of a Cluster topology,
+	// are valid. Replicas are only kept within the Cluster Autoscaler limits if the limits are valid.
+	ClusterAutoscalerAnnotationsValidCondition = "ClusterAutoscalerAnnotationsValid"
+
+	// ClusterAutoscalerAnnotationsValidReason is the reason used when the annotations are valid.
+	ClusterAutoscalerAnnotationsValidReason = "Valid"
+	// ClusterAutoscalerAnnotationsInvalidReason is the reason used when at least one annotation is invalid.
+	// The message lists the invalid annotations.
+	ClusterAutoscalerAnnotationsInvalidReason = "Invalid"
+)
@@ -61,6 +61,17 @@ const (
 	// that all checks are skipped.
 	PreflightChecksSkipAllAnnotationValue = "all"
 
+	// PreflightChecksReportOnlyAnnotationKey is the key of the annotation on the Cluster used to run
+	// preflight checks in report-only mode. These checks run asynchronously, do not block admission,
+	// and report their results in the PreflightChecksPassed condition of the Cluster.
+	// The value is a comma-separated list of check names, or "all".
+	PreflightChecksReportOnlyAnnotationKey = "preflight.cluster.caren.nutanix.com/report-only"
+
+	// EncryptionAtRestRotationAnnotationKey is the key of the annotation on the Cluster used to request
+	// rotating the encryption at rest keys. Changing the value to one that differs from the last rotation
+	// starts a new rotation. The rotation trigger of the encryptionAtRest variable takes precedence.
+	EncryptionAtRestRotationAnnotationKey = APIGroup + "/encryption-at-rest-rotation"
+
 	// SkipCiliumKubeProxyReplacementValidation is the key of the annotation on the Cluster
 	// used to skip Cilium kube-proxy replacement validation.
 	SkipCiliumKubeProxyReplacementValidation = APIGroup + "/skip-cilium-kube-proxy-replacement-validation"
@@ -69,4 +80,32 @@ const (
 	// the Cilium preflight Helm addon (e.g. during BeforeClusterUpgrade). When set (e.g. "true", "1"),
 	// the preflight release is not applied before Cilium upgrade.
 	SkipCiliumPreflightAnnotationKey = APIGroup + "/skip-cilium-preflight"
+
+	// DebugAddonValuesAnnotationKey is the key of the annotation on the Cluster used to record the effective
+	// Helm values of the addons. When set to "true", the effective values of each addon deployed with the
+	// HelmAddon strategy are recorded in the EffectiveValuesAnnotationKey annotation of its HelmChartProxy.
+	DebugAddonValuesAnnotationKey = APIGroup + "/debug-addon-values"
+
+	// EffectiveValuesAnnotationKey is the key of the annotation on a HelmChartProxy that records its effective
+	// Helm values, after the user-provided values are merged over the default values.
+	EffectiveValuesAnnotationKey = APIGroup + "/effective-values"
+
+	// HelmChartOverridesForClusterAnnotationKey is the key of the annotation on a ConfigMap used to override the
+	// Helm charts of the addons of the Cluster in the same namespace named by the value of the annotation.
+	// The ConfigMap uses the same format as the default Helm addons ConfigMap.
+	HelmChartOverridesForClusterAnnotationKey = APIGroup + "/helm-chart-overrides-for-cluster"
+
+	// DebugHelmChartsAnnotationKey is the key of the annotation on the Cluster used to record where the Helm charts
+	// of the addons were read from. When set to "true", the source of the Helm chart of each addon deployed with the
+	// HelmAddon strategy is recorded in the HelmChartSourceAnnotationKey annotation of its HelmChartProxy.
+	DebugHelmChartsAnnotationKey = APIGroup + "/debug-helm-charts"
+
+	// HelmChartSourceAnnotationKey is the key of the annotation on a HelmChartProxy that records where its Helm
+	// chart was read from, including any per-cluster override.
+	HelmChartSourceAnnotationKey = APIGroup + "/helm-chart-source"
+
+	// NamespaceSyncClusterClassesAnnotationKey is the key of the annotation on a target namespace of the
+	// namespace sync controller that restricts the ClusterClasses copied to it. The value is a comma-separated
+	// list of ClusterClass names. If the annotation is not set, all selected ClusterClasses are copied.
+	NamespaceSyncClusterClassesAnnotationKey = APIGroup + "/namespacesync-clusterclasses"
 )
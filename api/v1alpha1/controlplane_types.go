@@ -3,7 +3,14 @@
 
 package v1alpha1
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 type GenericControlPlaneSpec struct {
+	// AuditPolicy configures the audit policy, the audit log retention, and the audit webhook backend of
+	// the API server. Defaults to the built-in audit policy, and the default audit log retention.
+	// +kubebuilder:validation:Optional
+	AuditPolicy *AuditPolicy `json:"auditPolicy,omitempty"`
+
 	// AutoRenewCertificates specifies the configuration for auto-renewing the
 	// certificates of the control plane.
 	// +kubebuilder:validation:Optional
@@ -19,6 +26,93 @@ type AutoRenewCertificatesSpec struct {
 	DaysBeforeExpiry int32 `json:"daysBeforeExpiry"`
 }
 
+type AuditPolicy struct {
+	// A reference to the ConfigMap containing the audit policy in the `policy.yaml` key.
+	// The ConfigMap must be in the same namespace as the Cluster.
+	// If not set, the built-in audit policy is used.
+	// +kubebuilder:validation:Optional
+	ConfigMapRef *LocalObjectReference `json:"configMapRef,omitempty"`
+
+	// Log configures the retention of the audit log files.
+	// +kubebuilder:validation:Optional
+	Log *AuditLog `json:"log,omitempty"`
+
+	// Webhook enables the audit webhook backend, that sends audit events to a remote API.
+	// +kubebuilder:validation:Optional
+	Webhook *AuditWebhook `json:"webhook,omitempty"`
+}
+
+type AuditLog struct {
+	// Maximum number of days to retain audit log files. Defaults to 30.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxAge *int32 `json:"maxAge,omitempty"`
+
+	// Maximum number of audit log files to retain. Defaults to 90.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxBackup *int32 `json:"maxBackup,omitempty"`
+
+	// Maximum size in megabytes of the audit log file before it is rotated. Defaults to 100.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxSize *int32 `json:"maxSize,omitempty"`
+}
+
+type AuditWebhook struct {
+	// A reference to the Secret containing the kubeconfig file of the audit webhook backend in the
+	// `kubeconfig` key. The Secret must be in the same namespace as the Cluster.
+	// +kubebuilder:validation:Required
+	KubeconfigSecretRef LocalObjectReference `json:"kubeconfigSecretRef"`
+
+	// Mode of sending audit events. "batch" buffers events and sends them asynchronously, "blocking" blocks
+	// the API server response on sending each event, and "blocking-strict" also fails the request if
+	// sending the event fails. Defaults to batch.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=batch;blocking;blocking-strict
+	Mode string `json:"mode,omitempty"`
+
+	// The amount of time to wait before retrying the first failed request. Defaults to 10s.
+	// +kubebuilder:validation:Optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+
+	// Batch configures the batch mode.
+	// +kubebuilder:validation:Optional
+	Batch *AuditWebhookBatch `json:"batch,omitempty"`
+}
+
+type AuditWebhookBatch struct {
+	// The size of the buffer to store events before batching and sending. Defaults to 10000.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	BufferSize *int32 `json:"bufferSize,omitempty"`
+
+	// The maximum size of a batch. Defaults to 400.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// The amount of time to wait before force sending a batch that has not reached the maximum size.
+	// Defaults to 30s.
+	// +kubebuilder:validation:Optional
+	MaxWait *metav1.Duration `json:"maxWait,omitempty"`
+
+	// Whether batching throttling is enabled. Defaults to true.
+	// +kubebuilder:validation:Optional
+	ThrottleEnable *bool `json:"throttleEnable,omitempty"`
+
+	// Maximum average number of batches per second. Defaults to 10.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ThrottleQPS *int32 `json:"throttleQPS,omitempty"`
+
+	// Maximum number of requests sent at the same moment if ThrottleQPS was not utilized before.
+	// Defaults to 15.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ThrottleBurst *int32 `json:"throttleBurst,omitempty"`
+}
+
 // DockerControlPlaneSpec defines the desired state of the control plane for a Docker cluster.
 type DockerControlPlaneSpec struct {
 	// +kubebuilder:validation:Optional
@@ -56,6 +56,8 @@ type AWSWorkerNodeConfigSpec struct {
 
 	KubeadmNodeSpec `json:",inline"`
 	GenericNodeSpec `json:",inline"`
+
+	GenericWorkerNodeSpec `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -82,6 +84,8 @@ type DockerWorkerNodeConfigSpec struct {
 
 	KubeadmNodeSpec `json:",inline"`
 	GenericNodeSpec `json:",inline"`
+
+	GenericWorkerNodeSpec `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -106,6 +110,8 @@ type NutanixWorkerNodeConfigSpec struct {
 
 	KubeadmNodeSpec `json:",inline"`
 	GenericNodeSpec `json:",inline"`
+
+	GenericWorkerNodeSpec `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -132,6 +138,8 @@ type EKSWorkerNodeConfigSpec struct {
 
 	EKSNodeSpec     `json:",inline"`
 	GenericNodeSpec `json:",inline"`
+
+	GenericWorkerNodeSpec `json:",inline"`
 }
 
 type EKSNodeSpec struct{}
@@ -155,6 +163,29 @@ type GenericNodeSpec struct {
 	Taints []Taint `json:"taints,omitempty"`
 }
 
+// GenericWorkerNodeSpec contains the configuration that only applies to worker nodes.
+type GenericWorkerNodeSpec struct {
+	// Autoscaling sets the limits used by cluster-autoscaler to scale the MachineDeployment. The limits are set as
+	// the cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size and
+	// cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size annotations of the MachineDeployment.
+	// +kubebuilder:validation:Optional
+	Autoscaling *WorkerAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// WorkerAutoscaling sets the limits used by cluster-autoscaler to scale a MachineDeployment.
+// +kubebuilder:validation:XValidation:rule="self.minSize <= self.maxSize",message="minSize must be less than or equal to maxSize"
+type WorkerAutoscaling struct {
+	// MinSize is the minimum number of replicas of the MachineDeployment. Set to 0 to scale from zero.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	MinSize int32 `json:"minSize"`
+
+	// MaxSize is the maximum number of replicas of the MachineDeployment.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxSize int32 `json:"maxSize"`
+}
+
 // The node this Taint is attached to has the "effect" on
 // any pod that does not tolerate the Taint.
 type Taint struct {
@@ -451,6 +451,7 @@ func (in *AWSWorkerNodeConfigSpec) DeepCopyInto(out *AWSWorkerNodeConfigSpec) {
 	}
 	in.KubeadmNodeSpec.DeepCopyInto(&out.KubeadmNodeSpec)
 	in.GenericNodeSpec.DeepCopyInto(&out.GenericNodeSpec)
+	in.GenericWorkerNodeSpec.DeepCopyInto(&out.GenericWorkerNodeSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSWorkerNodeConfigSpec.
@@ -553,6 +554,137 @@ func (in *AddressRange) DeepCopy() *AddressRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLog) DeepCopyInto(out *AuditLog) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackup != nil {
+		in, out := &in.MaxBackup, &out.MaxBackup
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLog.
+func (in *AuditLog) DeepCopy() *AuditLog {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditPolicy) DeepCopyInto(out *AuditPolicy) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(AuditLog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuditWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditPolicy.
+func (in *AuditPolicy) DeepCopy() *AuditPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhook) DeepCopyInto(out *AuditWebhook) {
+	*out = *in
+	out.KubeconfigSecretRef = in.KubeconfigSecretRef
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(AuditWebhookBatch)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhook.
+func (in *AuditWebhook) DeepCopy() *AuditWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBatch) DeepCopyInto(out *AuditWebhookBatch) {
+	*out = *in
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxWait != nil {
+		in, out := &in.MaxWait, &out.MaxWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ThrottleEnable != nil {
+		in, out := &in.ThrottleEnable, &out.ThrottleEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ThrottleQPS != nil {
+		in, out := &in.ThrottleQPS, &out.ThrottleQPS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ThrottleBurst != nil {
+		in, out := &in.ThrottleBurst, &out.ThrottleBurst
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBatch.
+func (in *AuditWebhookBatch) DeepCopy() *AuditWebhookBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoRenewCertificatesSpec) DeepCopyInto(out *AutoRenewCertificatesSpec) {
 	*out = *in
@@ -591,6 +723,7 @@ func (in *CCM) DeepCopyInto(out *CCM) {
 		*out = new(CCMCredentials)
 		**out = **in
 	}
+	in.AddonConfig.DeepCopyInto(&out.AddonConfig)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CCM.
@@ -628,6 +761,11 @@ func (in *CNI) DeepCopyInto(out *CNI) {
 		*out = new(ImagePullCredentials)
 		**out = **in
 	}
+	if in.Cilium != nil {
+		in, out := &in.Cilium, &out.Cilium
+		*out = new(CiliumConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNI.
@@ -671,6 +809,7 @@ func (in *CSIProvider) DeepCopyInto(out *CSIProvider) {
 		*out = new(CSICredentials)
 		**out = **in
 	}
+	in.AddonConfig.DeepCopyInto(&out.AddonConfig)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSIProvider.
@@ -683,9 +822,152 @@ func (in *CSIProvider) DeepCopy() *CSIProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumBandwidthManager) DeepCopyInto(out *CiliumBandwidthManager) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumBandwidthManager.
+func (in *CiliumBandwidthManager) DeepCopy() *CiliumBandwidthManager {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumBandwidthManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumConfiguration) DeepCopyInto(out *CiliumConfiguration) {
+	*out = *in
+	if in.Hubble != nil {
+		in, out := &in.Hubble, &out.Hubble
+		*out = new(CiliumHubble)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(CiliumEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = new(CiliumRouting)
+		**out = **in
+	}
+	if in.BandwidthManager != nil {
+		in, out := &in.BandwidthManager, &out.BandwidthManager
+		*out = new(CiliumBandwidthManager)
+		**out = **in
+	}
+	if in.BPFMasquerade != nil {
+		in, out := &in.BPFMasquerade, &out.BPFMasquerade
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumConfiguration.
+func (in *CiliumConfiguration) DeepCopy() *CiliumConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumEncryption) DeepCopyInto(out *CiliumEncryption) {
+	*out = *in
+	if in.IPsecKeySecretRef != nil {
+		in, out := &in.IPsecKeySecretRef, &out.IPsecKeySecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumEncryption.
+func (in *CiliumEncryption) DeepCopy() *CiliumEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumHubble) DeepCopyInto(out *CiliumHubble) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Relay != nil {
+		in, out := &in.Relay, &out.Relay
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UI != nil {
+		in, out := &in.UI, &out.UI
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumHubble.
+func (in *CiliumHubble) DeepCopy() *CiliumHubble {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumHubble)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumRouting) DeepCopyInto(out *CiliumRouting) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumRouting.
+func (in *CiliumRouting) DeepCopy() *CiliumRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
+	in.AddonConfig.DeepCopyInto(&out.AddonConfig)
+	if in.Priorities != nil {
+		in, out := &in.Priorities, &out.Priorities
+		*out = make([]ClusterAutoscalerNodeGroupPriority, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(ClusterAutoscalerScaleDown)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BalanceSimilarNodeGroups != nil {
+		in, out := &in.BalanceSimilarNodeGroups, &out.BalanceSimilarNodeGroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxNodeProvisionTime != nil {
+		in, out := &in.MaxNodeProvisionTime, &out.MaxNodeProvisionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscaler.
@@ -698,6 +980,61 @@ func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerNodeGroupPriority) DeepCopyInto(out *ClusterAutoscalerNodeGroupPriority) {
+	*out = *in
+	if in.NodeGroupPatterns != nil {
+		in, out := &in.NodeGroupPatterns, &out.NodeGroupPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerNodeGroupPriority.
+func (in *ClusterAutoscalerNodeGroupPriority) DeepCopy() *ClusterAutoscalerNodeGroupPriority {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerNodeGroupPriority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerScaleDown) DeepCopyInto(out *ClusterAutoscalerScaleDown) {
+	*out = *in
+	if in.DelayAfterAdd != nil {
+		in, out := &in.DelayAfterAdd, &out.DelayAfterAdd
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DelayAfterDelete != nil {
+		in, out := &in.DelayAfterDelete, &out.DelayAfterDelete
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DelayAfterFailure != nil {
+		in, out := &in.DelayAfterFailure, &out.DelayAfterFailure
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UnneededTime != nil {
+		in, out := &in.UnneededTime, &out.UnneededTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerScaleDown.
+func (in *ClusterAutoscalerScaleDown) DeepCopy() *ClusterAutoscalerScaleDown {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerScaleDown)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneEndpointSpec) DeepCopyInto(out *ControlPlaneEndpointSpec) {
 	*out = *in
@@ -741,6 +1078,11 @@ func (in *ControlPlaneVirtualIPSpec) DeepCopyInto(out *ControlPlaneVirtualIPSpec
 		*out = new(ControlPlaneVirtualIPConfiguration)
 		**out = **in
 	}
+	if in.KeepalivedHAProxy != nil {
+		in, out := &in.KeepalivedHAProxy, &out.KeepalivedHAProxy
+		*out = new(KeepalivedHAProxyConfiguration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneVirtualIPSpec.
@@ -825,7 +1167,7 @@ func (in *DockerAddons) DeepCopyInto(out *DockerAddons) {
 	if in.COSI != nil {
 		in, out := &in.COSI, &out.COSI
 		*out = new(DockerCOSI)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -842,7 +1184,7 @@ func (in *DockerAddons) DeepCopy() *DockerAddons {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerCOSI) DeepCopyInto(out *DockerCOSI) {
 	*out = *in
-	out.GenericCOSI = in.GenericCOSI
+	in.GenericCOSI.DeepCopyInto(&out.GenericCOSI)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerCOSI.
@@ -1053,6 +1395,7 @@ func (in *DockerWorkerNodeConfigSpec) DeepCopyInto(out *DockerWorkerNodeConfigSp
 	}
 	in.KubeadmNodeSpec.DeepCopyInto(&out.KubeadmNodeSpec)
 	in.GenericNodeSpec.DeepCopyInto(&out.GenericNodeSpec)
+	in.GenericWorkerNodeSpec.DeepCopyInto(&out.GenericWorkerNodeSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerWorkerNodeConfigSpec.
@@ -1225,6 +1568,7 @@ func (in *EKSWorkerNodeConfigSpec) DeepCopyInto(out *EKSWorkerNodeConfigSpec) {
 	}
 	out.EKSNodeSpec = in.EKSNodeSpec
 	in.GenericNodeSpec.DeepCopyInto(&out.GenericNodeSpec)
+	in.GenericWorkerNodeSpec.DeepCopyInto(&out.GenericWorkerNodeSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSWorkerNodeConfigSpec.
@@ -1247,6 +1591,11 @@ func (in *EncryptionAtRest) DeepCopyInto(out *EncryptionAtRest) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(EncryptionAtRestRotation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionAtRest.
@@ -1259,6 +1608,21 @@ func (in *EncryptionAtRest) DeepCopy() *EncryptionAtRest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionAtRestRotation) DeepCopyInto(out *EncryptionAtRestRotation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionAtRestRotation.
+func (in *EncryptionAtRestRotation) DeepCopy() *EncryptionAtRestRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionAtRestRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionProviders) DeepCopyInto(out *EncryptionProviders) {
 	*out = *in
@@ -1272,6 +1636,21 @@ func (in *EncryptionProviders) DeepCopyInto(out *EncryptionProviders) {
 		*out = new(SecretboxConfiguration)
 		**out = **in
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]EncryptionProviderName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionProviders.
@@ -1310,12 +1689,12 @@ func (in *GenericAddons) DeepCopyInto(out *GenericAddons) {
 	if in.NFD != nil {
 		in, out := &in.NFD, &out.NFD
 		*out = new(NFD)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ClusterAutoscaler != nil {
 		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
 		*out = new(ClusterAutoscaler)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.CCM != nil {
 		in, out := &in.CCM, &out.CCM
@@ -1332,6 +1711,11 @@ func (in *GenericAddons) DeepCopyInto(out *GenericAddons) {
 		*out = new(RegistryAddon)
 		**out = **in
 	}
+	if in.HelmChartOverrides != nil {
+		in, out := &in.HelmChartOverrides, &out.HelmChartOverrides
+		*out = make([]HelmChartOverride, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericAddons.
@@ -1347,6 +1731,7 @@ func (in *GenericAddons) DeepCopy() *GenericAddons {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericCOSI) DeepCopyInto(out *GenericCOSI) {
 	*out = *in
+	in.AddonConfig.DeepCopyInto(&out.AddonConfig)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericCOSI.
@@ -1457,6 +1842,11 @@ func (in *GenericClusterConfigSpec) DeepCopy() *GenericClusterConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericControlPlaneSpec) DeepCopyInto(out *GenericControlPlaneSpec) {
 	*out = *in
+	if in.AuditPolicy != nil {
+		in, out := &in.AuditPolicy, &out.AuditPolicy
+		*out = new(AuditPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AutoRenewCertificates != nil {
 		in, out := &in.AutoRenewCertificates, &out.AutoRenewCertificates
 		*out = new(AutoRenewCertificatesSpec)
@@ -1494,6 +1884,26 @@ func (in *GenericNodeSpec) DeepCopy() *GenericNodeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenericWorkerNodeSpec) DeepCopyInto(out *GenericWorkerNodeSpec) {
+	*out = *in
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(WorkerAutoscaling)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericWorkerNodeSpec.
+func (in *GenericWorkerNodeSpec) DeepCopy() *GenericWorkerNodeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericWorkerNodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalImageRegistryMirror) DeepCopyInto(out *GlobalImageRegistryMirror) {
 	*out = *in
@@ -1534,6 +1944,21 @@ func (in *HTTPProxy) DeepCopy() *HTTPProxy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartOverride) DeepCopyInto(out *HelmChartOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartOverride.
+func (in *HelmChartOverride) DeepCopy() *HelmChartOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -1600,6 +2025,66 @@ func (in *Ingress) DeepCopy() *Ingress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSConfiguration) DeepCopyInto(out *KMSConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(KMSPlugin)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSConfiguration.
+func (in *KMSConfiguration) DeepCopy() *KMSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSPlugin) DeepCopyInto(out *KMSPlugin) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSPlugin.
+func (in *KMSPlugin) DeepCopy() *KMSPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeepalivedHAProxyConfiguration) DeepCopyInto(out *KeepalivedHAProxyConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeepalivedHAProxyConfiguration.
+func (in *KeepalivedHAProxyConfiguration) DeepCopy() *KeepalivedHAProxyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KeepalivedHAProxyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeProxy) DeepCopyInto(out *KubeProxy) {
 	*out = *in
@@ -1854,6 +2339,7 @@ func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NFD) DeepCopyInto(out *NFD) {
 	*out = *in
+	in.AddonConfig.DeepCopyInto(&out.AddonConfig)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFD.
@@ -1923,7 +2409,7 @@ func (in *NutanixAddons) DeepCopyInto(out *NutanixAddons) {
 	if in.COSI != nil {
 		in, out := &in.COSI, &out.COSI
 		*out = new(NutanixCOSI)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.KonnectorAgent != nil {
 		in, out := &in.KonnectorAgent, &out.KonnectorAgent
@@ -1951,6 +2437,11 @@ func (in *NutanixCNI) DeepCopyInto(out *NutanixCNI) {
 		*out = new(ImagePullCredentials)
 		**out = **in
 	}
+	if in.Cilium != nil {
+		in, out := &in.Cilium, &out.Cilium
+		*out = new(CiliumConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixCNI.
@@ -1966,7 +2457,7 @@ func (in *NutanixCNI) DeepCopy() *NutanixCNI {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NutanixCOSI) DeepCopyInto(out *NutanixCOSI) {
 	*out = *in
-	out.GenericCOSI = in.GenericCOSI
+	in.GenericCOSI.DeepCopyInto(&out.GenericCOSI)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixCOSI.
@@ -2314,6 +2805,7 @@ func (in *NutanixWorkerNodeConfigSpec) DeepCopyInto(out *NutanixWorkerNodeConfig
 	}
 	in.KubeadmNodeSpec.DeepCopyInto(&out.KubeadmNodeSpec)
 	in.GenericNodeSpec.DeepCopyInto(&out.GenericNodeSpec)
+	in.GenericWorkerNodeSpec.DeepCopyInto(&out.GenericWorkerNodeSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NutanixWorkerNodeConfigSpec.
@@ -2505,6 +2997,7 @@ func (in *ServiceLoadBalancer) DeepCopyInto(out *ServiceLoadBalancer) {
 		*out = new(ServiceLoadBalancerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	in.AddonConfig.DeepCopyInto(&out.AddonConfig)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancer.
@@ -2517,6 +3010,91 @@ func (in *ServiceLoadBalancer) DeepCopy() *ServiceLoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLoadBalancerAddressPool) DeepCopyInto(out *ServiceLoadBalancerAddressPool) {
+	*out = *in
+	if in.AddressRanges != nil {
+		in, out := &in.AddressRanges, &out.AddressRanges
+		*out = make([]AddressRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoAssign != nil {
+		in, out := &in.AutoAssign, &out.AutoAssign
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancerAddressPool.
+func (in *ServiceLoadBalancerAddressPool) DeepCopy() *ServiceLoadBalancerAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLoadBalancerAddressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLoadBalancerBFDProfile) DeepCopyInto(out *ServiceLoadBalancerBFDProfile) {
+	*out = *in
+	if in.ReceiveInterval != nil {
+		in, out := &in.ReceiveInterval, &out.ReceiveInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TransmitInterval != nil {
+		in, out := &in.TransmitInterval, &out.TransmitInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DetectMultiplier != nil {
+		in, out := &in.DetectMultiplier, &out.DetectMultiplier
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PassiveMode != nil {
+		in, out := &in.PassiveMode, &out.PassiveMode
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancerBFDProfile.
+func (in *ServiceLoadBalancerBFDProfile) DeepCopy() *ServiceLoadBalancerBFDProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLoadBalancerBFDProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLoadBalancerBGPPeer) DeepCopyInto(out *ServiceLoadBalancerBGPPeer) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.BFDProfile != nil {
+		in, out := &in.BFDProfile, &out.BFDProfile
+		*out = new(ServiceLoadBalancerBFDProfile)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancerBGPPeer.
+func (in *ServiceLoadBalancerBGPPeer) DeepCopy() *ServiceLoadBalancerBGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLoadBalancerBGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceLoadBalancerConfiguration) DeepCopyInto(out *ServiceLoadBalancerConfiguration) {
 	*out = *in
@@ -2525,6 +3103,20 @@ func (in *ServiceLoadBalancerConfiguration) DeepCopyInto(out *ServiceLoadBalance
 		*out = make([]AddressRange, len(*in))
 		copy(*out, *in)
 	}
+	if in.AddressPools != nil {
+		in, out := &in.AddressPools, &out.AddressPools
+		*out = make([]ServiceLoadBalancerAddressPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BGPPeers != nil {
+		in, out := &in.BGPPeers, &out.BGPPeers
+		*out = make([]ServiceLoadBalancerBGPPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLoadBalancerConfiguration.
@@ -2682,3 +3274,18 @@ func (in *ValuesReference) DeepCopy() *ValuesReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAutoscaling) DeepCopyInto(out *WorkerAutoscaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAutoscaling.
+func (in *WorkerAutoscaling) DeepCopy() *WorkerAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
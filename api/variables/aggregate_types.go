@@ -58,6 +58,8 @@ type WorkerNodeConfigSpec struct {
 
 	carenv1.KubeadmNodeSpec `json:",inline"`
 	carenv1.GenericNodeSpec `json:",inline"`
+
+	carenv1.GenericWorkerNodeSpec `json:",inline"`
 }
 
 type Addons struct {
@@ -53,3 +53,37 @@ func KubeProxyIsDisabled(cluster *clusterv1.Cluster) (bool, error) {
 	}
 	return mode != nil && *mode == carenv1.KubeProxyModeDisabled, nil
 }
+
+// CiliumConfiguration retrieves the Cilium configuration of the CNI addon from the cluster's topology variables.
+// Returns nil if the Cilium configuration is not defined.
+func CiliumConfiguration(cluster *clusterv1.Cluster) (*carenv1.CiliumConfiguration, error) {
+	spec, err := UnmarshalClusterConfigVariable(cluster.Spec.Topology.Variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cluster variable: %w", err)
+	}
+	if spec == nil {
+		return nil, nil
+	}
+	if spec.Addons == nil || spec.Addons.CNI == nil {
+		return nil, nil
+	}
+
+	return spec.Addons.CNI.Cilium, nil
+}
+
+// HelmChartOverrides retrieves the Helm chart overrides of the addons from the cluster's topology variables.
+// Returns nil if the Helm chart overrides are not defined.
+func HelmChartOverrides(cluster *clusterv1.Cluster) ([]carenv1.HelmChartOverride, error) {
+	spec, err := UnmarshalClusterConfigVariable(cluster.Spec.Topology.Variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cluster variable: %w", err)
+	}
+	if spec == nil {
+		return nil, nil
+	}
+	if spec.Addons == nil {
+		return nil, nil
+	}
+
+	return spec.Addons.HelmChartOverrides, nil
+}
@@ -10,7 +10,6 @@ import (
 	"slices"
 
 	"github.com/spf13/pflag"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -33,8 +32,10 @@ import (
 
 	capxv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/github.com/nutanix-cloud-native/cluster-api-provider-nutanix/api/v1beta1"
 	metallbv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/go.universe.tf/metallb/api/v1beta1"
+	metallbv1beta2 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/go.universe.tf/metallb/api/v1beta2"
 	caaphv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/sigs.k8s.io/cluster-api-addon-provider-helm/api/v1alpha1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/server"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/controllers/encryptionkeyrotation"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/controllers/enforceclusterautoscalerlimits"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/controllers/failuredomainrollout"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/controllers/namespacesync"
@@ -49,6 +50,7 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/addons"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/cluster"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+	preflightaws "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/aws"
 	preflightgeneric "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/generic"
 	preflightnutanix "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/nutanix"
 )
@@ -65,6 +67,7 @@ func main() {
 	utilruntime.Must(caaphv1.AddToScheme(clientScheme))
 	utilruntime.Must(capxv1.AddToScheme(clientScheme))
 	utilruntime.Must(metallbv1.AddToScheme(clientScheme))
+	utilruntime.Must(metallbv1beta2.AddToScheme(clientScheme))
 
 	mgrOptions := &ctrl.Options{
 		Scheme: clientScheme,
@@ -123,6 +126,8 @@ func main() {
 	namespacesyncOptions := namespacesync.Options{}
 	enforceClusterAutoscalerLimitsOptions := enforceclusterautoscalerlimits.Options{}
 	failureDomainRolloutOptions := failuredomainrollout.Options{}
+	encryptionKeyRotationOptions := encryptionkeyrotation.Options{}
+	preflightOptions := preflight.Options{}
 
 	// Initialize and parse command line flags.
 	logs.AddFlags(pflag.CommandLine, logs.SkipLoggingConfigurationFlags())
@@ -136,6 +141,8 @@ func main() {
 	namespacesyncOptions.AddFlags(pflag.CommandLine)
 	enforceClusterAutoscalerLimitsOptions.AddFlags(pflag.CommandLine)
 	failureDomainRolloutOptions.AddFlags(pflag.CommandLine)
+	encryptionKeyRotationOptions.AddFlags(pflag.CommandLine)
+	preflightOptions.AddFlags(pflag.CommandLine)
 	pflag.CommandLine.SetNormalizeFunc(cliflag.WordSepNormalizeFunc)
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 
@@ -185,34 +192,9 @@ func main() {
 	}
 
 	if namespacesyncOptions.Enabled {
-		if namespacesyncOptions.SourceNamespace == "" ||
-			namespacesyncOptions.TargetNamespaceLabelSelector == "" {
-			setupLog.Error(
-				nil,
-				"Namespace Sync is enabled, but source namespace and/or target namespace label selector are not configured.",
-			)
-			os.Exit(1)
-		}
-
-		targetSelector, err := metav1.ParseToLabelSelector(namespacesyncOptions.TargetNamespaceLabelSelector)
+		sources, err := namespacesyncOptions.Sources()
 		if err != nil {
-			setupLog.Error(
-				err,
-				"unable to parse target namespace label selector",
-				"selector",
-				namespacesyncOptions.TargetNamespaceLabelSelector,
-			)
-			os.Exit(1)
-		}
-
-		targetLabelSelector, err := metav1.LabelSelectorAsSelector(targetSelector)
-		if err != nil {
-			setupLog.Error(
-				err,
-				"unable to convert label selector",
-				"selector",
-				namespacesyncOptions.TargetNamespaceLabelSelector,
-			)
+			setupLog.Error(err, "Namespace Sync is enabled, but it is not configured correctly.")
 			os.Exit(1)
 		}
 
@@ -229,10 +211,12 @@ func main() {
 		}
 
 		if err := (&namespacesync.Reconciler{
-			Client:                      mgr.GetClient(),
-			UnstructuredCachingClient:   unstructuredCachingClient,
-			SourceClusterClassNamespace: namespacesyncOptions.SourceNamespace,
-			TargetNamespaceSelector:     targetLabelSelector,
+			Client:                    mgr.GetClient(),
+			UnstructuredCachingClient: unstructuredCachingClient,
+			Sources:                   sources,
+			PruneUnmatchedNamespaces:  namespacesyncOptions.PruneUnmatchedNamespaces,
+			ResyncPeriod:              namespacesyncOptions.ResyncPeriod,
+			Recorder:                  mgr.GetEventRecorderFor("namespacesync"),
 		}).SetupWithManager(
 			mgr,
 			&controller.Options{MaxConcurrentReconciles: namespacesyncOptions.Concurrency},
@@ -249,7 +233,8 @@ func main() {
 
 	if enforceClusterAutoscalerLimitsOptions.Enabled {
 		if err := (&enforceclusterautoscalerlimits.Reconciler{
-			Client: mgr.GetClient(),
+			Client:   mgr.GetClient(),
+			Recorder: mgr.GetEventRecorderFor("enforceclusterautoscalerlimits"),
 		}).SetupWithManager(
 			mgr,
 			&controller.Options{MaxConcurrentReconciles: enforceClusterAutoscalerLimitsOptions.Concurrency},
@@ -281,6 +266,23 @@ func main() {
 		}
 	}
 
+	if encryptionKeyRotationOptions.Enabled {
+		if err := (&encryptionkeyrotation.Reconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(
+			mgr,
+			&controller.Options{MaxConcurrentReconciles: encryptionKeyRotationOptions.Concurrency},
+		); err != nil {
+			setupLog.Error(
+				err,
+				"unable to create controller",
+				"controller",
+				"encryptionkeyrotation.Reconciler",
+			)
+			os.Exit(1)
+		}
+	}
+
 	mgr.GetWebhookServer().Register("/mutate-cluster", &webhook.Admission{
 		Handler: cluster.NewDefaulter(mgr.GetClient(), admission.NewDecoder(mgr.GetScheme())),
 	})
@@ -293,11 +295,12 @@ func main() {
 	})
 
 	mgr.GetWebhookServer().Register("/preflight-cluster", &webhook.Admission{
-		Handler: preflight.New(mgr.GetClient(), admission.NewDecoder(mgr.GetScheme()),
+		Handler: preflight.NewWithOptions(mgr.GetClient(), admission.NewDecoder(mgr.GetScheme()), preflightOptions,
 			[]preflight.Checker{
 				// Add your preflight checkers here.
 				preflightgeneric.Checker,
 				preflightnutanix.Checker,
+				preflightaws.Checker,
 			}...,
 		),
 	})
@@ -0,0 +1,81 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Command preflight runs the preflight checks for a Cluster read from manifest files, and exits
+// non-zero if any check fails.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/component-base/logs"
+	logsv1 "k8s.io/component-base/logs/api/v1"
+	"k8s.io/component-base/version/verflag"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	preflightaws "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/aws"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/cli"
+	preflightgeneric "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/generic"
+	preflightnutanix "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/nutanix"
+)
+
+func main() {
+	logOptions := logs.NewOptions()
+	opts := &cli.Options{}
+
+	// Initialize and parse command line flags. The kubeconfig flag is registered by controller-runtime
+	// in the Go flag set.
+	logs.AddFlags(pflag.CommandLine, logs.SkipLoggingConfigurationFlags())
+	logsv1.AddFlags(logOptions, pflag.CommandLine)
+	opts.AddFlags(pflag.CommandLine)
+	pflag.CommandLine.SetNormalizeFunc(cliflag.WordSepNormalizeFunc)
+	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
+
+	pflag.Parse()
+
+	verflag.PrintAndExitIfRequested()
+
+	// Validates logs flags using Kubernetes component-base machinery and applies them
+	if err := logsv1.ValidateAndApply(logOptions, nil); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to apply logging configuration: %v\n", err)
+		os.Exit(cli.ExitError)
+	}
+
+	// Add the klog logger in the context.
+	ctrl.SetLogger(klog.Background())
+
+	var live ctrlclient.Reader
+	if opts.ReadFromCluster {
+		config, err := ctrl.GetConfig()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to load kubeconfig: %v\n", err)
+			os.Exit(cli.ExitError)
+		}
+		live, err = ctrlclient.New(config, ctrlclient.Options{Scheme: cli.NewScheme()})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to create client: %v\n", err)
+			os.Exit(cli.ExitError)
+		}
+	}
+
+	exitCode, err := cli.Run(
+		ctrl.SetupSignalHandler(),
+		opts,
+		os.Stdin,
+		os.Stdout,
+		live,
+		preflightgeneric.Checker,
+		preflightnutanix.Checker,
+		preflightaws.Checker,
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+	}
+	os.Exit(exitCode)
+}
@@ -59,6 +59,54 @@ type NamedAfterControlPlaneUpgrade interface {
 	AfterControlPlaneUpgrade
 }
 
+type BeforeControlPlaneUpgrade interface {
+	BeforeControlPlaneUpgrade(
+		context.Context,
+		*runtimehooksv1.BeforeControlPlaneUpgradeRequest,
+		*runtimehooksv1.BeforeControlPlaneUpgradeResponse,
+	)
+}
+type NamedBeforeControlPlaneUpgrade interface {
+	handlers.Named
+	BeforeControlPlaneUpgrade
+}
+
+type BeforeWorkersUpgrade interface {
+	BeforeWorkersUpgrade(
+		context.Context,
+		*runtimehooksv1.BeforeWorkersUpgradeRequest,
+		*runtimehooksv1.BeforeWorkersUpgradeResponse,
+	)
+}
+type NamedBeforeWorkersUpgrade interface {
+	handlers.Named
+	BeforeWorkersUpgrade
+}
+
+type AfterWorkersUpgrade interface {
+	AfterWorkersUpgrade(
+		context.Context,
+		*runtimehooksv1.AfterWorkersUpgradeRequest,
+		*runtimehooksv1.AfterWorkersUpgradeResponse,
+	)
+}
+type NamedAfterWorkersUpgrade interface {
+	handlers.Named
+	AfterWorkersUpgrade
+}
+
+type AfterClusterUpgrade interface {
+	AfterClusterUpgrade(
+		context.Context,
+		*runtimehooksv1.AfterClusterUpgradeRequest,
+		*runtimehooksv1.AfterClusterUpgradeResponse,
+	)
+}
+type NamedAfterClusterUpgrade interface {
+	handlers.Named
+	AfterClusterUpgrade
+}
+
 type BeforeClusterDelete interface {
 	BeforeClusterDelete(
 		context.Context,
@@ -70,3 +118,10 @@ type NamedBeforeClusterDelete interface {
 	handlers.Named
 	BeforeClusterDelete
 }
+
+// Dependent is implemented by lifecycle handlers that must only be run once other handlers have
+// succeeded for the same hook. Dependencies are referenced by handler name, and dependencies on
+// handlers that do not implement the hook being called are ignored.
+type Dependent interface {
+	Dependencies() []string
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// hookResultSuccess is the result label value for handlers that returned a success response.
+	hookResultSuccess = "success"
+	// hookResultFailure is the result label value for handlers that returned a failure response.
+	hookResultFailure = "failure"
+	// hookResultSkipped is the result label value for handlers that did not set a response status,
+	// which is the case when the handler is not enabled for the cluster.
+	hookResultSkipped = "skipped"
+	// hookResultBlocked is the result label value for handlers that were not run because one of
+	// their dependencies did not succeed, or asked to be retried.
+	hookResultBlocked = "blocked"
+)
+
+var (
+	hookHandlerDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "caren",
+			Subsystem: "lifecycle_hook",
+			Name:      "handler_duration_seconds",
+			Help:      "Duration of lifecycle hook handler calls, by hook, handler and result.",
+			Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+		},
+		[]string{"hook", "handler", "result"},
+	)
+	hookHandlerTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "caren",
+			Subsystem: "lifecycle_hook",
+			Name:      "handler_calls_total",
+			Help:      "Total number of lifecycle hook handler calls, by hook, handler and result.",
+		},
+		[]string{"hook", "handler", "result"},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(hookHandlerDuration, hookHandlerTotal)
+}
+
+// hookResult returns the result label value for the given handler response.
+func hookResult(resp runtimehooksv1.ResponseObject) string {
+	switch resp.GetStatus() {
+	case runtimehooksv1.ResponseStatusSuccess:
+		return hookResultSuccess
+	case runtimehooksv1.ResponseStatusFailure:
+		return hookResultFailure
+	default:
+		return hookResultSkipped
+	}
+}
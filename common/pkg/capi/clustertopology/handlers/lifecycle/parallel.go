@@ -5,58 +5,235 @@ package lifecycle
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/samber/lo"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/util"
-)
 
-// runHooksInParallel runs all the given hook functions in parallel and aggregates the responses.
-// Every hook is run regardless of whether other hooks fail or not, with results aggregated only
-// after every hook has returned.
-func runHooksInParallel[T runtimehooksv1.RequestObject, U runtimehooksv1.ResponseObject](
-	ctx context.Context, hookFuncs []func(context.Context, T, U), request T, response U,
-) {
-	responseChan := make(chan U, len(hookFuncs))
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers"
+)
 
-	responsePtrType := reflect.TypeFor[U]().Elem()
+// callHooksInParallel runs all the given hook functions in parallel and returns the individual
+// responses in the same order as the hook functions.
+func callHooksInParallel[T runtimehooksv1.RequestObject, U runtimehooksv1.ResponseObject](
+	ctx context.Context, hookFuncs []func(context.Context, T, U), request T,
+) []U {
+	individualResponses := make([]U, len(hookFuncs))
 
 	// Create a wait group to wait used to wait for all hooks to finish.
 	var wg sync.WaitGroup
 	// Add the number of hooks to the wait group.
 	wg.Add(len(hookFuncs))
 
-	// Run each hook in a new goroutine, send the response to the response channel and decrement the
-	// wait group counter when the hook finishes.
-	for _, f := range hookFuncs {
-		// Create a new instance of the response object type for each hook. Due to the use of generics,
-		// we need to use reflection to create a new instance of the response object type.
-		hookResponse := reflect.New(responsePtrType).Interface().(U)
+	// Run each hook in a new goroutine, storing the response at the same index as the hook and
+	// decrementing the wait group counter when the hook finishes.
+	for i, f := range hookFuncs {
+		hookResponse := newResponse[U]()
+		individualResponses[i] = hookResponse
 		go func() {
+			// Decrement the wait group counter.
+			defer wg.Done()
 			// Run the hook function.
 			f(ctx, request, hookResponse)
-			// Send the response to the response channel.
-			responseChan <- hookResponse
-			// Decrement the wait group counter.
-			wg.Done()
 		}()
 	}
 
-	// Wait for all hooks to finish and close the response channel. Closing the response channel
-	// signals that all hooks have finished and will end the range loop that reads from the response
-	// channel below.
-	go func() {
-		wg.Wait()
-		close(responseChan)
-	}()
-
-	// Read from the response channel and collect all individualResponses into a slice.
-	individualResponses := make([]U, 0, len(hookFuncs))
-	for r := range responseChan {
-		individualResponses = append(individualResponses, r)
+	// Wait for all hooks to finish.
+	wg.Wait()
+
+	return individualResponses
+}
+
+// newResponse creates a new instance of the response object type. Due to the use of generics, we
+// need to use reflection to create a new instance of the response object type.
+func newResponse[U runtimehooksv1.ResponseObject]() U {
+	return reflect.New(reflect.TypeFor[U]().Elem()).Interface().(U)
+}
+
+// dependentHook is a hook function along with the name of the handler providing it and the names of
+// the handlers that must have succeeded before it is run.
+type dependentHook[T runtimehooksv1.RequestObject, U runtimehooksv1.ResponseObject] struct {
+	name         string
+	dependencies []string
+	hookFunc     func(context.Context, T, U)
+}
+
+// newDependentHook creates a dependentHook for the given handler and hook function. The handler
+// name and dependencies are only set if the handler implements handlers.Named and Dependent
+// respectively.
+func newDependentHook[T runtimehooksv1.RequestObject, U runtimehooksv1.ResponseObject](
+	h any, hookFunc func(context.Context, T, U),
+) dependentHook[T, U] {
+	dh := dependentHook[T, U]{hookFunc: hookFunc}
+	if named, ok := h.(handlers.Named); ok {
+		dh.name = named.Name()
+	}
+	if dependent, ok := h.(Dependent); ok {
+		dh.dependencies = dependent.Dependencies()
+	}
+	return dh
+}
+
+// instrumented returns the hook function wrapped to record the duration and result of each call
+// in the lifecycle hook metrics.
+func (h dependentHook[T, U]) instrumented(hookName string) func(context.Context, T, U) {
+	return func(ctx context.Context, request T, response U) {
+		start := time.Now()
+		h.hookFunc(ctx, request, response)
+		result := hookResult(response)
+		hookHandlerDuration.WithLabelValues(hookName, h.name, result).Observe(time.Since(start).Seconds())
+		hookHandlerTotal.WithLabelValues(hookName, h.name, result).Inc()
+	}
+}
+
+// hookNameFor returns the name of the hook for the given request type, e.g. BeforeClusterUpgrade for
+// *runtimehooksv1.BeforeClusterUpgradeRequest.
+func hookNameFor[T runtimehooksv1.RequestObject]() string {
+	return strings.TrimSuffix(reflect.TypeFor[T]().Elem().Name(), "Request")
+}
+
+// dependencyLevels sorts the hooks topologically, returning the indices of the hooks grouped into
+// levels, where every hook only depends on hooks in previous levels, along with the indices of the
+// dependencies of each hook. Dependencies on handlers that are not in hooks are ignored. An error is
+// returned if the dependencies contain a cycle.
+func dependencyLevels[T runtimehooksv1.RequestObject, U runtimehooksv1.ResponseObject](
+	hooks []dependentHook[T, U],
+) (levels [][]int, dependencyIndices [][]int, err error) {
+	indicesByName := make(map[string][]int, len(hooks))
+	for i, h := range hooks {
+		if h.name != "" {
+			indicesByName[h.name] = append(indicesByName[h.name], i)
+		}
+	}
+
+	dependencyIndices = make([][]int, len(hooks))
+	for i, h := range hooks {
+		for _, dependency := range h.dependencies {
+			dependencyIndices[i] = append(dependencyIndices[i], indicesByName[dependency]...)
+		}
+	}
+
+	placed := make([]bool, len(hooks))
+	for remaining := len(hooks); remaining > 0; {
+		var level []int
+		for i := range hooks {
+			if placed[i] {
+				continue
+			}
+			ready := lo.EveryBy(dependencyIndices[i], func(d int) bool { return placed[d] })
+			if ready {
+				level = append(level, i)
+			}
+		}
+
+		if len(level) == 0 {
+			cyclic := lo.FilterMap(hooks, func(h dependentHook[T, U], i int) (string, bool) {
+				return h.name, !placed[i]
+			})
+			return nil, nil, fmt.Errorf(
+				"dependency cycle detected between handlers: %s", strings.Join(cyclic, ", "),
+			)
+		}
+
+		for _, i := range level {
+			placed[i] = true
+		}
+		remaining -= len(level)
+		levels = append(levels, level)
+	}
+
+	return levels, dependencyIndices, nil
+}
+
+// runHooksInDependencyOrder runs the given hooks in dependency order and aggregates the responses.
+// Hooks are grouped into levels (see dependencyLevels) and the hooks in each level are run in
+// parallel. A hook is not run if any of its dependencies failed or was itself not run, in which case
+// its response is a failure listing the dependencies that blocked it. A hook is also not run if any
+// of its dependencies asked to be retried, in which case its response asks to be retried after the
+// lowest non-zero RetryAfterSeconds of those dependencies, so that the hook runs once they complete.
+func runHooksInDependencyOrder[T runtimehooksv1.RequestObject, U runtimehooksv1.ResponseObject](
+	ctx context.Context, hooks []dependentHook[T, U], request T, response U,
+) {
+	levels, dependencyIndices, err := dependencyLevels(hooks)
+	if err != nil {
+		response.SetStatus(runtimehooksv1.ResponseStatusFailure)
+		response.SetMessage(err.Error())
+		return
+	}
+
+	hookName := hookNameFor[T]()
+	individualResponses := make([]U, len(hooks))
+	failed := make([]bool, len(hooks))
+	// retryAfterSeconds is non-zero for the hooks that have not completed, and must be retried.
+	retryAfterSeconds := make([]int32, len(hooks))
+
+	for _, level := range levels {
+		toRun := make([]int, 0, len(level))
+		for _, i := range level {
+			blockedBy := lo.Uniq(lo.FilterMap(dependencyIndices[i], func(d, _ int) (string, bool) {
+				return hooks[d].name, failed[d]
+			}))
+			if len(blockedBy) == 0 {
+				toRun = append(toRun, i)
+				continue
+			}
+
+			blockedResponse := newResponse[U]()
+			blockedResponse.SetStatus(runtimehooksv1.ResponseStatusFailure)
+			blockedResponse.SetMessage(fmt.Sprintf(
+				"%s was not run because its dependencies did not succeed: %s",
+				hooks[i].name,
+				strings.Join(blockedBy, ", "),
+			))
+			individualResponses[i] = blockedResponse
+			failed[i] = true
+			hookHandlerTotal.WithLabelValues(hookName, hooks[i].name, hookResultBlocked).Inc()
+		}
+
+		toRun = lo.Filter(toRun, func(i, _ int) bool {
+			pendingOn := lo.Uniq(lo.FilterMap(dependencyIndices[i], func(d, _ int) (string, bool) {
+				return hooks[d].name, retryAfterSeconds[d] > 0
+			}))
+			if len(pendingOn) == 0 {
+				return true
+			}
+
+			for _, d := range dependencyIndices[i] {
+				retryAfterSeconds[i] = util.LowestNonZeroInt32(retryAfterSeconds[i], retryAfterSeconds[d])
+			}
+			pendingResponse := newResponse[U]()
+			pendingResponse.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+			pendingResponse.SetMessage(fmt.Sprintf(
+				"%s was not run because its dependencies have not completed: %s",
+				hooks[i].name,
+				strings.Join(pendingOn, ", "),
+			))
+			// Only hooks with retry responses can have dependencies that ask to be retried.
+			any(pendingResponse).(runtimehooksv1.RetryResponseObject).SetRetryAfterSeconds(retryAfterSeconds[i])
+			individualResponses[i] = pendingResponse
+			hookHandlerTotal.WithLabelValues(hookName, hooks[i].name, hookResultBlocked).Inc()
+			return false
+		})
+
+		levelResponses := callHooksInParallel(
+			ctx,
+			lo.Map(toRun, func(i, _ int) func(context.Context, T, U) {
+				return hooks[i].instrumented(hookName)
+			}),
+			request,
+		)
+		for j, i := range toRun {
+			individualResponses[i] = levelResponses[j]
+			failed[i] = levelResponses[j].GetStatus() == runtimehooksv1.ResponseStatusFailure
+			if retryResp, ok := any(levelResponses[j]).(runtimehooksv1.RetryResponseObject); ok && !failed[i] {
+				retryAfterSeconds[i] = retryResp.GetRetryAfterSeconds()
+			}
+		}
 	}
 
 	// Aggregate all responses into a single response.
@@ -100,11 +277,21 @@ func aggregateResponses[T runtimehooksv1.ResponseObject](
 				)
 			}
 		// If the response status is success, append the message to the success messages slice.
+		// If the response is a RetryResponseObject, the hook has not completed, so set the retryAfterSeconds
+		// in the same way as for failures, so that the hook is retried.
 		case runtimehooksv1.ResponseStatusSuccess:
 			// Only append the message if it is not empty.
 			if resp.GetMessage() != "" {
 				successMessages = append(successMessages, resp.GetMessage())
 			}
+
+			retryResp, ok := any(resp).(runtimehooksv1.RetryResponseObject)
+			if ok {
+				retryAfterSeconds = util.LowestNonZeroInt32(
+					retryAfterSeconds,
+					retryResp.GetRetryAfterSeconds(),
+				)
+			}
 		}
 	}
 
@@ -125,9 +312,16 @@ func aggregateResponses[T runtimehooksv1.ResponseObject](
 		}
 
 	// If the aggregated response status is success, set the message to the success messages
-	// concatenated with a comma.
+	// concatenated with a comma, and set the retryAfterSeconds if it is greater than 0.
 	case runtimehooksv1.ResponseStatusSuccess:
 		aggregatedResponse.SetMessage(strings.Join(successMessages, ", "))
+
+		if retryAfterSeconds > 0 {
+			// If retryAfterSeconds is set, we can safely assume that the response is a RetryResponseObject.
+			any(aggregatedResponse).(runtimehooksv1.RetryResponseObject).SetRetryAfterSeconds(
+				retryAfterSeconds,
+			)
+		}
 	}
 }
 
@@ -142,18 +336,17 @@ func (p *parallelBCC) BeforeClusterCreate(
 	req *runtimehooksv1.BeforeClusterCreateRequest,
 	resp *runtimehooksv1.BeforeClusterCreateResponse,
 ) {
-	hookFuncs := lo.Map(
+	hooks := lo.Map(
 		p.hooks,
-		func(h BeforeClusterCreate, _ int) func(
-			context.Context,
+		func(h BeforeClusterCreate, _ int) dependentHook[
 			*runtimehooksv1.BeforeClusterCreateRequest,
 			*runtimehooksv1.BeforeClusterCreateResponse,
-		) {
-			return h.BeforeClusterCreate
+		] {
+			return newDependentHook(h, h.BeforeClusterCreate)
 		},
 	)
 
-	runHooksInParallel(ctx, hookFuncs, req, resp)
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
 }
 
 func (p *parallelBCC) Name() string {
@@ -180,18 +373,17 @@ func (p *parallelACPI) AfterControlPlaneInitialized(
 	req *runtimehooksv1.AfterControlPlaneInitializedRequest,
 	resp *runtimehooksv1.AfterControlPlaneInitializedResponse,
 ) {
-	hookFuncs := lo.Map(
+	hooks := lo.Map(
 		p.hooks,
-		func(h AfterControlPlaneInitialized, _ int) func(
-			context.Context,
+		func(h AfterControlPlaneInitialized, _ int) dependentHook[
 			*runtimehooksv1.AfterControlPlaneInitializedRequest,
 			*runtimehooksv1.AfterControlPlaneInitializedResponse,
-		) {
-			return h.AfterControlPlaneInitialized
+		] {
+			return newDependentHook(h, h.AfterControlPlaneInitialized)
 		},
 	)
 
-	runHooksInParallel(ctx, hookFuncs, req, resp)
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
 }
 
 func (p *parallelACPI) Name() string {
@@ -218,18 +410,17 @@ func (p *parallelBCU) BeforeClusterUpgrade(
 	req *runtimehooksv1.BeforeClusterUpgradeRequest,
 	resp *runtimehooksv1.BeforeClusterUpgradeResponse,
 ) {
-	hookFuncs := lo.Map(
+	hooks := lo.Map(
 		p.hooks,
-		func(h BeforeClusterUpgrade, _ int) func(
-			context.Context,
+		func(h BeforeClusterUpgrade, _ int) dependentHook[
 			*runtimehooksv1.BeforeClusterUpgradeRequest,
 			*runtimehooksv1.BeforeClusterUpgradeResponse,
-		) {
-			return h.BeforeClusterUpgrade
+		] {
+			return newDependentHook(h, h.BeforeClusterUpgrade)
 		},
 	)
 
-	runHooksInParallel(ctx, hookFuncs, req, resp)
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
 }
 
 func (p *parallelBCU) Name() string {
@@ -257,18 +448,17 @@ func (p *parallelACPU) AfterControlPlaneUpgrade(
 	req *runtimehooksv1.AfterControlPlaneUpgradeRequest,
 	resp *runtimehooksv1.AfterControlPlaneUpgradeResponse,
 ) {
-	hookFuncs := lo.Map(
+	hooks := lo.Map(
 		p.hooks,
-		func(h AfterControlPlaneUpgrade, _ int) func(
-			context.Context,
+		func(h AfterControlPlaneUpgrade, _ int) dependentHook[
 			*runtimehooksv1.AfterControlPlaneUpgradeRequest,
 			*runtimehooksv1.AfterControlPlaneUpgradeResponse,
-		) {
-			return h.AfterControlPlaneUpgrade
+		] {
+			return newDependentHook(h, h.AfterControlPlaneUpgrade)
 		},
 	)
 
-	runHooksInParallel(ctx, hookFuncs, req, resp)
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
 }
 
 func (p *parallelACPU) Name() string {
@@ -285,6 +475,158 @@ func ParallelAfterControlPlaneUpgradeHook(
 	}
 }
 
+type parallelBCPU struct {
+	name string
+
+	hooks []BeforeControlPlaneUpgrade
+}
+
+func (p *parallelBCPU) BeforeControlPlaneUpgrade(
+	ctx context.Context,
+	req *runtimehooksv1.BeforeControlPlaneUpgradeRequest,
+	resp *runtimehooksv1.BeforeControlPlaneUpgradeResponse,
+) {
+	hooks := lo.Map(
+		p.hooks,
+		func(h BeforeControlPlaneUpgrade, _ int) dependentHook[
+			*runtimehooksv1.BeforeControlPlaneUpgradeRequest,
+			*runtimehooksv1.BeforeControlPlaneUpgradeResponse,
+		] {
+			return newDependentHook(h, h.BeforeControlPlaneUpgrade)
+		},
+	)
+
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
+}
+
+func (p *parallelBCPU) Name() string {
+	return p.name
+}
+
+func ParallelBeforeControlPlaneUpgradeHook(
+	name string,
+	hooks ...BeforeControlPlaneUpgrade,
+) NamedBeforeControlPlaneUpgrade {
+	return &parallelBCPU{
+		name:  name,
+		hooks: hooks,
+	}
+}
+
+type parallelBWU struct {
+	name string
+
+	hooks []BeforeWorkersUpgrade
+}
+
+func (p *parallelBWU) BeforeWorkersUpgrade(
+	ctx context.Context,
+	req *runtimehooksv1.BeforeWorkersUpgradeRequest,
+	resp *runtimehooksv1.BeforeWorkersUpgradeResponse,
+) {
+	hooks := lo.Map(
+		p.hooks,
+		func(h BeforeWorkersUpgrade, _ int) dependentHook[
+			*runtimehooksv1.BeforeWorkersUpgradeRequest,
+			*runtimehooksv1.BeforeWorkersUpgradeResponse,
+		] {
+			return newDependentHook(h, h.BeforeWorkersUpgrade)
+		},
+	)
+
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
+}
+
+func (p *parallelBWU) Name() string {
+	return p.name
+}
+
+func ParallelBeforeWorkersUpgradeHook(
+	name string,
+	hooks ...BeforeWorkersUpgrade,
+) NamedBeforeWorkersUpgrade {
+	return &parallelBWU{
+		name:  name,
+		hooks: hooks,
+	}
+}
+
+type parallelAWU struct {
+	name string
+
+	hooks []AfterWorkersUpgrade
+}
+
+func (p *parallelAWU) AfterWorkersUpgrade(
+	ctx context.Context,
+	req *runtimehooksv1.AfterWorkersUpgradeRequest,
+	resp *runtimehooksv1.AfterWorkersUpgradeResponse,
+) {
+	hooks := lo.Map(
+		p.hooks,
+		func(h AfterWorkersUpgrade, _ int) dependentHook[
+			*runtimehooksv1.AfterWorkersUpgradeRequest,
+			*runtimehooksv1.AfterWorkersUpgradeResponse,
+		] {
+			return newDependentHook(h, h.AfterWorkersUpgrade)
+		},
+	)
+
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
+}
+
+func (p *parallelAWU) Name() string {
+	return p.name
+}
+
+func ParallelAfterWorkersUpgradeHook(
+	name string,
+	hooks ...AfterWorkersUpgrade,
+) NamedAfterWorkersUpgrade {
+	return &parallelAWU{
+		name:  name,
+		hooks: hooks,
+	}
+}
+
+type parallelACU struct {
+	name string
+
+	hooks []AfterClusterUpgrade
+}
+
+func (p *parallelACU) AfterClusterUpgrade(
+	ctx context.Context,
+	req *runtimehooksv1.AfterClusterUpgradeRequest,
+	resp *runtimehooksv1.AfterClusterUpgradeResponse,
+) {
+	hooks := lo.Map(
+		p.hooks,
+		func(h AfterClusterUpgrade, _ int) dependentHook[
+			*runtimehooksv1.AfterClusterUpgradeRequest,
+			*runtimehooksv1.AfterClusterUpgradeResponse,
+		] {
+			return newDependentHook(h, h.AfterClusterUpgrade)
+		},
+	)
+
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
+}
+
+func (p *parallelACU) Name() string {
+	return p.name
+}
+
+func ParallelAfterClusterUpgradeHook(
+	name string,
+	hooks ...AfterClusterUpgrade,
+) NamedAfterClusterUpgrade {
+	return &parallelACU{
+		name:  name,
+		hooks: hooks,
+	}
+}
+
 type parallelBCD struct {
 	name string
 
@@ -296,18 +638,17 @@ func (p *parallelBCD) BeforeClusterDelete(
 	req *runtimehooksv1.BeforeClusterDeleteRequest,
 	resp *runtimehooksv1.BeforeClusterDeleteResponse,
 ) {
-	hookFuncs := lo.Map(
+	hooks := lo.Map(
 		p.hooks,
-		func(h BeforeClusterDelete, _ int) func(
-			context.Context,
+		func(h BeforeClusterDelete, _ int) dependentHook[
 			*runtimehooksv1.BeforeClusterDeleteRequest,
 			*runtimehooksv1.BeforeClusterDeleteResponse,
-		) {
-			return h.BeforeClusterDelete
+		] {
+			return newDependentHook(h, h.BeforeClusterDelete)
 		},
 	)
 
-	runHooksInParallel(ctx, hookFuncs, req, resp)
+	runHooksInDependencyOrder(ctx, hooks, req, resp)
 }
 
 func (p *parallelBCD) Name() string {
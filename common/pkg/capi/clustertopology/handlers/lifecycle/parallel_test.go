@@ -7,13 +7,15 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 )
 
-func Test_runHooksInParallel(t *testing.T) {
+func Test_aggregateResponses(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -218,6 +220,29 @@ func Test_runHooksInParallel(t *testing.T) {
 			},
 			RetryAfterSeconds: 10,
 		},
+	}, {
+		name: "Successes with retry after",
+		individualResponses: []*runtimehooksv1.CommonRetryResponse{{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+			RetryAfterSeconds: 20,
+		}, {
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+		}, {
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+			RetryAfterSeconds: 10,
+		}},
+		expectedAggregatedResponse: &runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+			RetryAfterSeconds: 10,
+		},
 	}}
 
 	for _, tt := range tests {
@@ -226,41 +251,27 @@ func Test_runHooksInParallel(t *testing.T) {
 
 			g := gomega.NewWithT(t)
 
-			hookFuncs := make(
-				[]func(
-					context.Context,
-					*runtimehooksv1.BeforeClusterUpgradeRequest,
-					*runtimehooksv1.BeforeClusterUpgradeResponse,
-				),
-				0, len(tt.individualResponses),
+			individualResponses := make(
+				[]*runtimehooksv1.BeforeClusterUpgradeResponse, 0, len(tt.individualResponses),
 			)
 			for _, individualResponse := range tt.individualResponses {
-				hookFuncs = append(hookFuncs, func(
-					ctx context.Context,
-					_ *runtimehooksv1.BeforeClusterUpgradeRequest,
-					resp *runtimehooksv1.BeforeClusterUpgradeResponse,
-				) {
-					resp.SetStatus(individualResponse.GetStatus())
-					resp.SetMessage(individualResponse.GetMessage())
-					resp.SetRetryAfterSeconds(individualResponse.GetRetryAfterSeconds())
-				})
+				resp := &runtimehooksv1.BeforeClusterUpgradeResponse{}
+				resp.SetStatus(individualResponse.GetStatus())
+				resp.SetMessage(individualResponse.GetMessage())
+				resp.SetRetryAfterSeconds(individualResponse.GetRetryAfterSeconds())
+				individualResponses = append(individualResponses, resp)
 			}
 
 			gotResponse := &runtimehooksv1.BeforeClusterUpgradeResponse{}
-			runHooksInParallel(
-				context.Background(),
-				hookFuncs,
-				&runtimehooksv1.BeforeClusterUpgradeRequest{},
-				gotResponse,
-			)
+			aggregateResponses(gotResponse, individualResponses)
 
 			g.Expect(gotResponse.GetStatus()).
 				To(gomega.Equal(tt.expectedAggregatedResponse.GetStatus()))
 			g.Expect(gotResponse.GetRetryAfterSeconds()).
 				To(gomega.Equal(tt.expectedAggregatedResponse.GetRetryAfterSeconds()))
 
-			// As we call funcs in parallel, response messages could be in any order so let's split the string and
-			// compare using regex.
+			// Split the message, and compare using regex, so that the test does not depend on the order of
+			// the messages.
 			gotResponseMessage := gotResponse.GetMessage()
 			for expectedMessagePart := range strings.SplitSeq(tt.expectedAggregatedResponse.GetMessage(), ", ") {
 				g.Expect(gotResponseMessage).To(
@@ -272,3 +283,305 @@ func Test_runHooksInParallel(t *testing.T) {
 		})
 	}
 }
+
+type afterClusterUpgradeFunc func(
+	context.Context,
+	*runtimehooksv1.AfterClusterUpgradeRequest,
+	*runtimehooksv1.AfterClusterUpgradeResponse,
+)
+
+func (f afterClusterUpgradeFunc) AfterClusterUpgrade(
+	ctx context.Context,
+	req *runtimehooksv1.AfterClusterUpgradeRequest,
+	resp *runtimehooksv1.AfterClusterUpgradeResponse,
+) {
+	f(ctx, req, resp)
+}
+
+func TestParallelAfterClusterUpgradeHook(t *testing.T) {
+	t.Parallel()
+
+	g := gomega.NewWithT(t)
+
+	success := afterClusterUpgradeFunc(func(
+		_ context.Context,
+		req *runtimehooksv1.AfterClusterUpgradeRequest,
+		resp *runtimehooksv1.AfterClusterUpgradeResponse,
+	) {
+		resp.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+		resp.SetMessage("upgraded to " + req.KubernetesVersion)
+	})
+	failure := afterClusterUpgradeFunc(func(
+		_ context.Context,
+		_ *runtimehooksv1.AfterClusterUpgradeRequest,
+		resp *runtimehooksv1.AfterClusterUpgradeResponse,
+	) {
+		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+		resp.SetMessage("failure")
+		resp.SetRetryAfterSeconds(5)
+	})
+
+	hook := ParallelAfterClusterUpgradeHook("test", success, failure)
+	g.Expect(hook.Name()).To(gomega.Equal("test"))
+
+	resp := &runtimehooksv1.AfterClusterUpgradeResponse{}
+	hook.AfterClusterUpgrade(
+		context.Background(),
+		&runtimehooksv1.AfterClusterUpgradeRequest{KubernetesVersion: "v1.34.1"},
+		resp,
+	)
+	g.Expect(resp.GetStatus()).To(gomega.Equal(runtimehooksv1.ResponseStatusFailure))
+	g.Expect(resp.GetMessage()).To(gomega.Equal("failure"))
+	g.Expect(resp.GetRetryAfterSeconds()).To(gomega.Equal(int32(5)))
+
+	resp = &runtimehooksv1.AfterClusterUpgradeResponse{}
+	ParallelAfterClusterUpgradeHook("test", success, success).AfterClusterUpgrade(
+		context.Background(),
+		&runtimehooksv1.AfterClusterUpgradeRequest{KubernetesVersion: "v1.34.1"},
+		resp,
+	)
+	g.Expect(resp.GetStatus()).To(gomega.Equal(runtimehooksv1.ResponseStatusSuccess))
+	g.Expect(resp.GetMessage()).To(gomega.Equal("upgraded to v1.34.1, upgraded to v1.34.1"))
+}
+
+type testDependentHook struct {
+	name              string
+	dependencies      []string
+	status            runtimehooksv1.ResponseStatus
+	retryAfterSeconds int32
+	called            *atomic.Bool
+}
+
+func (h testDependentHook) Name() string {
+	return h.name
+}
+
+func (h testDependentHook) Dependencies() []string {
+	return h.dependencies
+}
+
+func (h testDependentHook) BeforeClusterUpgrade(
+	_ context.Context,
+	_ *runtimehooksv1.BeforeClusterUpgradeRequest,
+	resp *runtimehooksv1.BeforeClusterUpgradeResponse,
+) {
+	h.called.Store(true)
+	resp.SetStatus(h.status)
+	resp.SetRetryAfterSeconds(h.retryAfterSeconds)
+	if h.status == runtimehooksv1.ResponseStatusFailure {
+		resp.SetMessage(h.name + " failed")
+	}
+}
+
+func Test_dependencyLevels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		hooks          []testDependentHook
+		expectedLevels [][]int
+		expectedErr    string
+	}{{
+		name: "No dependencies",
+		hooks: []testDependentHook{
+			{name: "a"},
+			{name: "b"},
+		},
+		expectedLevels: [][]int{{0, 1}},
+	}, {
+		name: "Chained dependencies",
+		hooks: []testDependentHook{
+			{name: "c", dependencies: []string{"b"}},
+			{name: "b", dependencies: []string{"a"}},
+			{name: "a"},
+			{name: "d", dependencies: []string{"a"}},
+		},
+		expectedLevels: [][]int{{2}, {1, 3}, {0}},
+	}, {
+		name: "Unknown dependencies are ignored",
+		hooks: []testDependentHook{
+			{name: "a", dependencies: []string{"unknown"}},
+		},
+		expectedLevels: [][]int{{0}},
+	}, {
+		name: "Cycle",
+		hooks: []testDependentHook{
+			{name: "a", dependencies: []string{"b"}},
+			{name: "b", dependencies: []string{"a"}},
+			{name: "c"},
+		},
+		expectedErr: "dependency cycle detected between handlers: a, b",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := gomega.NewWithT(t)
+
+			hooks := make(
+				[]dependentHook[
+					*runtimehooksv1.BeforeClusterUpgradeRequest,
+					*runtimehooksv1.BeforeClusterUpgradeResponse,
+				],
+				0, len(tt.hooks),
+			)
+			for _, h := range tt.hooks {
+				hooks = append(hooks, newDependentHook(h, h.BeforeClusterUpgrade))
+			}
+
+			levels, _, err := dependencyLevels(hooks)
+			if tt.expectedErr != "" {
+				g.Expect(err).To(gomega.MatchError(tt.expectedErr))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(levels).To(gomega.Equal(tt.expectedLevels))
+		})
+	}
+}
+
+func TestParallelBeforeClusterUpgradeHook_Dependencies(t *testing.T) {
+	t.Parallel()
+
+	g := gomega.NewWithT(t)
+
+	cni := testDependentHook{
+		name:   "cni",
+		status: runtimehooksv1.ResponseStatusFailure,
+		called: &atomic.Bool{},
+	}
+	ccm := testDependentHook{
+		name:   "ccm",
+		status: runtimehooksv1.ResponseStatusSuccess,
+		called: &atomic.Bool{},
+	}
+	serviceLoadBalancer := testDependentHook{
+		name:         "serviceloadbalancer",
+		dependencies: []string{"cni", "ccm"},
+		status:       runtimehooksv1.ResponseStatusSuccess,
+		called:       &atomic.Bool{},
+	}
+	ingress := testDependentHook{
+		name:         "ingress",
+		dependencies: []string{"serviceloadbalancer"},
+		status:       runtimehooksv1.ResponseStatusSuccess,
+		called:       &atomic.Bool{},
+	}
+	csi := testDependentHook{
+		name:         "csi",
+		dependencies: []string{"ccm"},
+		status:       runtimehooksv1.ResponseStatusSuccess,
+		called:       &atomic.Bool{},
+	}
+
+	resp := &runtimehooksv1.BeforeClusterUpgradeResponse{}
+	ParallelBeforeClusterUpgradeHook("test", ingress, serviceLoadBalancer, csi, ccm, cni).
+		BeforeClusterUpgrade(context.Background(), &runtimehooksv1.BeforeClusterUpgradeRequest{}, resp)
+
+	g.Expect(resp.GetStatus()).To(gomega.Equal(runtimehooksv1.ResponseStatusFailure))
+	g.Expect(resp.GetMessage()).To(gomega.Equal(
+		"ingress was not run because its dependencies did not succeed: serviceloadbalancer, " +
+			"serviceloadbalancer was not run because its dependencies did not succeed: cni, " +
+			"cni failed",
+	))
+	g.Expect(cni.called.Load()).To(gomega.BeTrue())
+	g.Expect(ccm.called.Load()).To(gomega.BeTrue())
+	g.Expect(csi.called.Load()).To(gomega.BeTrue())
+	g.Expect(serviceLoadBalancer.called.Load()).To(gomega.BeFalse())
+	g.Expect(ingress.called.Load()).To(gomega.BeFalse())
+}
+
+func TestParallelBeforeClusterUpgradeHook_DependenciesRetry(t *testing.T) {
+	t.Parallel()
+
+	g := gomega.NewWithT(t)
+
+	cni := testDependentHook{
+		name:              "cni",
+		status:            runtimehooksv1.ResponseStatusSuccess,
+		retryAfterSeconds: 30,
+		called:            &atomic.Bool{},
+	}
+	ccm := testDependentHook{
+		name:              "ccm",
+		status:            runtimehooksv1.ResponseStatusSuccess,
+		retryAfterSeconds: 10,
+		called:            &atomic.Bool{},
+	}
+	nfd := testDependentHook{
+		name:   "nfd",
+		status: runtimehooksv1.ResponseStatusSuccess,
+		called: &atomic.Bool{},
+	}
+	serviceLoadBalancer := testDependentHook{
+		name:         "serviceloadbalancer",
+		dependencies: []string{"cni", "ccm", "nfd"},
+		status:       runtimehooksv1.ResponseStatusSuccess,
+		called:       &atomic.Bool{},
+	}
+	ingress := testDependentHook{
+		name:         "ingress",
+		dependencies: []string{"serviceloadbalancer"},
+		status:       runtimehooksv1.ResponseStatusSuccess,
+		called:       &atomic.Bool{},
+	}
+
+	resp := &runtimehooksv1.BeforeClusterUpgradeResponse{}
+	ParallelBeforeClusterUpgradeHook("test", ingress, serviceLoadBalancer, nfd, ccm, cni).
+		BeforeClusterUpgrade(context.Background(), &runtimehooksv1.BeforeClusterUpgradeRequest{}, resp)
+
+	g.Expect(resp.GetStatus()).To(gomega.Equal(runtimehooksv1.ResponseStatusSuccess))
+	g.Expect(resp.GetRetryAfterSeconds()).To(gomega.Equal(int32(10)))
+	g.Expect(resp.GetMessage()).To(gomega.Equal(
+		"ingress was not run because its dependencies have not completed: serviceloadbalancer, " +
+			"serviceloadbalancer was not run because its dependencies have not completed: cni, ccm",
+	))
+	g.Expect(cni.called.Load()).To(gomega.BeTrue())
+	g.Expect(ccm.called.Load()).To(gomega.BeTrue())
+	g.Expect(nfd.called.Load()).To(gomega.BeTrue())
+	g.Expect(serviceLoadBalancer.called.Load()).To(gomega.BeFalse())
+	g.Expect(ingress.called.Load()).To(gomega.BeFalse())
+}
+
+func TestParallelBeforeClusterUpgradeHook_Metrics(t *testing.T) {
+	t.Parallel()
+
+	g := gomega.NewWithT(t)
+
+	failing := testDependentHook{
+		name:   "metrics-failing",
+		status: runtimehooksv1.ResponseStatusFailure,
+		called: &atomic.Bool{},
+	}
+	succeeding := testDependentHook{
+		name:   "metrics-succeeding",
+		status: runtimehooksv1.ResponseStatusSuccess,
+		called: &atomic.Bool{},
+	}
+	blocked := testDependentHook{
+		name:         "metrics-blocked",
+		dependencies: []string{"metrics-failing"},
+		status:       runtimehooksv1.ResponseStatusSuccess,
+		called:       &atomic.Bool{},
+	}
+
+	ParallelBeforeClusterUpgradeHook("test", failing, succeeding, blocked).BeforeClusterUpgrade(
+		context.Background(),
+		&runtimehooksv1.BeforeClusterUpgradeRequest{},
+		&runtimehooksv1.BeforeClusterUpgradeResponse{},
+	)
+
+	for handler, result := range map[string]string{
+		"metrics-failing":    hookResultFailure,
+		"metrics-succeeding": hookResultSuccess,
+		"metrics-blocked":    hookResultBlocked,
+	} {
+		g.Expect(
+			testutil.ToFloat64(hookHandlerTotal.WithLabelValues("BeforeClusterUpgrade", handler, result)),
+		).To(gomega.Equal(float64(1)), handler)
+	}
+	g.Expect(
+		testutil.CollectAndCount(hookHandlerDuration, "caren_lifecycle_hook_handler_duration_seconds"),
+	).To(gomega.BeNumerically(">=", 2))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -63,22 +64,29 @@ func (mgp metaGeneratePatches) Name() string {
 	return mgp.name
 }
 
+// CreateClusterGetter returns a ClusterGetter that fetches the Cluster at most once, no matter how
+// many times it is called.
 func (mgp metaGeneratePatches) CreateClusterGetter(
 	clusterKey client.ObjectKey,
 ) func(context.Context) (*clusterv1.Cluster, error) {
+	return newClusterGetter(mgp.cl, clusterKey)
+}
+
+func newClusterGetter(reader client.Reader, clusterKey client.ObjectKey) ClusterGetter {
+	var (
+		cluster clusterv1.Cluster
+		err     error
+		once    sync.Once
+	)
 	return func(ctx context.Context) (*clusterv1.Cluster, error) {
-		var (
-			cluster clusterv1.Cluster
-			err     error
-			once    sync.Once
-		)
 		once.Do(func() {
-			err = mgp.cl.Get(ctx, clusterKey, &cluster)
+			err = reader.Get(ctx, clusterKey, &cluster)
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch cluster: %w", err)
 		}
-		return &cluster, nil
+		// Return a copy so that mutators cannot modify the Cluster seen by other mutators.
+		return cluster.DeepCopy(), nil
 	}
 }
 
@@ -88,7 +96,16 @@ func (mgp metaGeneratePatches) GeneratePatches(
 	resp *runtimehooksv1.GeneratePatchesResponse,
 ) {
 	clusterKey := handlers.ClusterKeyFromReq(req)
-	clusterGetter := mgp.CreateClusterGetter(clusterKey)
+
+	// Share the objects read by the mutators across all the templates in the request.
+	var reader client.Reader = mgp.cl
+	if mgp.cl != nil {
+		cache := newRequestCache(mgp.cl)
+		ctx = contextWithRequestCache(ctx, cache)
+		reader = cache
+	}
+	clusterGetter := newClusterGetter(reader, clusterKey)
+
 	topologymutation.WalkTemplates(
 		ctx,
 		unstructured.UnstructuredJSONScheme,
@@ -112,14 +129,17 @@ func (mgp metaGeneratePatches) GeneratePatches(
 				handlerName := fmt.Sprintf("%T", h)
 				log.V(5).Info("Running mutator", "index", i, "handler", handlerName)
 
-				if err := h.Mutate(
+				start := time.Now()
+				err := h.Mutate(
 					ctx,
 					obj.(*unstructured.Unstructured),
 					vars,
 					holderRef,
 					clusterKey,
 					clusterGetter,
-				); err != nil {
+				)
+				observeMutator(mgp.name, handlerName, time.Since(start).Seconds(), err)
+				if err != nil {
 					log.Error(err, "Mutator failed", "index", i, "handler", handlerName)
 					return err
 				}
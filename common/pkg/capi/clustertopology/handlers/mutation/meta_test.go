@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"gomodules.xyz/jsonpatch/v2"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -239,3 +240,31 @@ func jsonPatch(operations ...jsonpatch.Operation) []byte {
 	)
 	return b
 }
+
+func TestMetaGeneratePatches_Metrics(t *testing.T) {
+	t.Parallel()
+
+	g := gomega.NewWithT(t)
+
+	h := NewMetaGeneratePatchesHandler(
+		"metrics-test",
+		nil,
+		&testHandler{},
+		&testHandler{returnErr: true},
+	).(GeneratePatches)
+
+	resp := &runtimehooksv1.GeneratePatchesResponse{}
+	h.GeneratePatches(context.Background(), &runtimehooksv1.GeneratePatchesRequest{
+		Items: []runtimehooksv1.GeneratePatchesRequestItem{
+			request.NewKubeadmConfigTemplateRequestItem("kubeadm-config"),
+		},
+	}, resp)
+
+	g.Expect(resp.GetStatus()).To(gomega.Equal(runtimehooksv1.ResponseStatusFailure))
+	g.Expect(testutil.ToFloat64(
+		mutatorTotal.WithLabelValues("metrics-test", "*mutation.testHandler", mutatorResultSuccess),
+	)).To(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(
+		mutatorTotal.WithLabelValues("metrics-test", "*mutation.testHandler", mutatorResultFailure),
+	)).To(gomega.Equal(float64(1)))
+}
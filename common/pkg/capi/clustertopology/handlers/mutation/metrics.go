@@ -0,0 +1,52 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package mutation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// mutatorResultSuccess is the result label value for mutators that returned no error.
+	mutatorResultSuccess = "success"
+	// mutatorResultFailure is the result label value for mutators that returned an error.
+	mutatorResultFailure = "failure"
+)
+
+var (
+	mutatorDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "caren",
+			Subsystem: "generate_patches",
+			Name:      "mutator_duration_seconds",
+			Help:      "Duration of mutator calls for a single template, by handler, mutator and result.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+		},
+		[]string{"handler", "mutator", "result"},
+	)
+	mutatorTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "caren",
+			Subsystem: "generate_patches",
+			Name:      "mutator_calls_total",
+			Help:      "Total number of mutator calls for a single template, by handler, mutator and result.",
+		},
+		[]string{"handler", "mutator", "result"},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(mutatorDuration, mutatorTotal)
+}
+
+// observeMutator records the duration and result of a single mutator call.
+func observeMutator(handlerName, mutatorName string, seconds float64, err error) {
+	result := mutatorResultSuccess
+	if err != nil {
+		result = mutatorResultFailure
+	}
+	mutatorDuration.WithLabelValues(handlerName, mutatorName, result).Observe(seconds)
+	mutatorTotal.WithLabelValues(handlerName, mutatorName, result).Inc()
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package mutation
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type requestCacheContextKey struct{}
+
+// requestCacheKey identifies an object in the request cache. The GVK is only used to distinguish
+// unstructured objects, as typed objects are already distinguished by their type.
+type requestCacheKey struct {
+	objType reflect.Type
+	gvk     schema.GroupVersionKind
+	key     client.ObjectKey
+}
+
+// requestCache is a client.Reader that caches the objects read during a single GeneratePatches
+// request, so that mutators reading the same object, e.g. the Cluster or a credentials Secret, for
+// every template in the request only result in a single API call. Only successful reads are cached,
+// and List calls are passed through to the underlying reader.
+type requestCache struct {
+	reader client.Reader
+
+	mu      sync.Mutex
+	objects map[requestCacheKey]client.Object
+}
+
+var _ client.Reader = &requestCache{}
+
+func newRequestCache(reader client.Reader) *requestCache {
+	return &requestCache{
+		reader:  reader,
+		objects: make(map[requestCacheKey]client.Object),
+	}
+}
+
+func (c *requestCache) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	cacheKey := requestCacheKey{
+		objType: reflect.TypeOf(obj),
+		key:     key,
+	}
+	if _, ok := obj.(runtime.Unstructured); ok {
+		cacheKey.gvk = obj.GetObjectKind().GroupVersionKind()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.objects[cacheKey]; ok {
+		reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(cached.DeepCopyObject()).Elem())
+		return nil
+	}
+
+	if err := c.reader.Get(ctx, key, obj, opts...); err != nil {
+		return err
+	}
+	c.objects[cacheKey] = obj.DeepCopyObject().(client.Object)
+
+	return nil
+}
+
+func (c *requestCache) List(
+	ctx context.Context,
+	list client.ObjectList,
+	opts ...client.ListOption,
+) error {
+	return c.reader.List(ctx, list, opts...)
+}
+
+// ReaderFromContext returns the reader caching the objects read during the GeneratePatches request
+// that ctx belongs to, or fallback if ctx does not belong to a GeneratePatches request. Mutators
+// should use it for all reads of objects that are not expected to change during a request, such as
+// the Cluster and referenced Secrets and ConfigMaps.
+func ReaderFromContext(ctx context.Context, fallback client.Reader) client.Reader {
+	if c, ok := ctx.Value(requestCacheContextKey{}).(*requestCache); ok {
+		return c
+	}
+	return fallback
+}
+
+func contextWithRequestCache(ctx context.Context, c *requestCache) context.Context {
+	return context.WithValue(ctx, requestCacheContextKey{}, c)
+}
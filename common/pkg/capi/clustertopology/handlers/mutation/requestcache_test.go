@@ -0,0 +1,226 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package mutation
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/testutils/capitest/request"
+)
+
+const testSecretName = "test-credentials"
+
+// readingHandler reads the Cluster and a Secret, as mutators that inject credentials do. If
+// useRequestCache is false, it reads both directly from the client to show the cost of not sharing
+// them across mutators.
+type readingHandler struct {
+	cl              client.Reader
+	useRequestCache bool
+}
+
+var _ MetaMutator = &readingHandler{}
+
+func (h *readingHandler) Mutate(
+	ctx context.Context,
+	_ *unstructured.Unstructured,
+	_ map[string]apiextensionsv1.JSON,
+	_ runtimehooksv1.HolderReference,
+	clusterKey client.ObjectKey,
+	clusterGetter ClusterGetter,
+) error {
+	reader := h.cl
+	if h.useRequestCache {
+		reader = ReaderFromContext(ctx, h.cl)
+		if _, err := clusterGetter(ctx); err != nil {
+			return err
+		}
+	} else {
+		if err := h.cl.Get(ctx, clusterKey, &clusterv1.Cluster{}); err != nil {
+			return err
+		}
+	}
+
+	return reader.Get(
+		ctx,
+		client.ObjectKey{Namespace: clusterKey.Namespace, Name: testSecretName},
+		&corev1.Secret{},
+	)
+}
+
+// newCountingClient returns a fake client holding the test Cluster and Secret, and a counter of the
+// Get calls made against it.
+func newCountingClient(t testing.TB) (client.Client, *atomic.Int64) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := clusterv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	var gets atomic.Int64
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: request.ClusterName, Namespace: request.Namespace},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: testSecretName, Namespace: request.Namespace},
+			},
+		).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(
+				ctx context.Context,
+				cl client.WithWatch,
+				key client.ObjectKey,
+				obj client.Object,
+				opts ...client.GetOption,
+			) error {
+				gets.Add(1)
+				return cl.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	return cl, &gets
+}
+
+// newRequestWithMachineDeployments returns a GeneratePatchesRequest for the test Cluster with a
+// KubeadmConfigTemplate for each of the given number of MachineDeployments.
+func newRequestWithMachineDeployments(machineDeployments int) *runtimehooksv1.GeneratePatchesRequest {
+	clusterTemplate := &unstructured.Unstructured{}
+	clusterTemplate.SetAPIVersion("infrastructure.cluster.x-k8s.io/v1beta1")
+	clusterTemplate.SetKind("DockerClusterTemplate")
+	clusterTemplate.SetName("test-dockerclustertemplate")
+	clusterTemplate.SetNamespace(request.Namespace)
+
+	items := []runtimehooksv1.GeneratePatchesRequestItem{
+		request.NewRequestItem(
+			clusterTemplate,
+			&runtimehooksv1.HolderReference{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "Cluster",
+				Name:       request.ClusterName,
+				Namespace:  request.Namespace,
+				FieldPath:  "spec.infrastructureRef",
+			},
+			"",
+		),
+	}
+	for i := range machineDeployments {
+		items = append(
+			items,
+			request.NewKubeadmConfigTemplateRequest("", fmt.Sprintf("kubeadm-config-%d", i)),
+		)
+	}
+
+	return &runtimehooksv1.GeneratePatchesRequest{Items: items}
+}
+
+func TestRequestCache(t *testing.T) {
+	t.Parallel()
+
+	g := gomega.NewWithT(t)
+
+	cl, gets := newCountingClient(t)
+	cache := newRequestCache(cl)
+
+	key := client.ObjectKey{Namespace: request.Namespace, Name: testSecretName}
+	secret := &corev1.Secret{}
+	g.Expect(cache.Get(context.Background(), key, secret)).To(gomega.Succeed())
+	secret.Labels = map[string]string{"modified": "true"}
+
+	cached := &corev1.Secret{}
+	g.Expect(cache.Get(context.Background(), key, cached)).To(gomega.Succeed())
+	g.Expect(cached.Name).To(gomega.Equal(testSecretName))
+	g.Expect(cached.Labels).To(gomega.BeEmpty(), "modifying a returned object must not modify the cache")
+	g.Expect(gets.Load()).To(gomega.Equal(int64(1)))
+
+	// Failed reads are not cached.
+	missingKey := client.ObjectKey{Namespace: request.Namespace, Name: "missing"}
+	g.Expect(cache.Get(context.Background(), missingKey, &corev1.Secret{})).ToNot(gomega.Succeed())
+	g.Expect(cache.Get(context.Background(), missingKey, &corev1.Secret{})).ToNot(gomega.Succeed())
+	g.Expect(gets.Load()).To(gomega.Equal(int64(3)))
+}
+
+func TestReaderFromContext(t *testing.T) {
+	t.Parallel()
+
+	g := gomega.NewWithT(t)
+
+	cl, _ := newCountingClient(t)
+	g.Expect(ReaderFromContext(context.Background(), cl)).ToNot(gomega.BeAssignableToTypeOf(&requestCache{}))
+
+	cache := newRequestCache(cl)
+	ctx := contextWithRequestCache(context.Background(), cache)
+	g.Expect(ReaderFromContext(ctx, cl) == client.Reader(cache)).To(gomega.BeTrue())
+}
+
+func TestMetaGeneratePatches_SharesReadsAcrossMutators(t *testing.T) {
+	t.Parallel()
+
+	g := gomega.NewWithT(t)
+
+	cl, gets := newCountingClient(t)
+	h := NewMetaGeneratePatchesHandler(
+		"request-cache-test",
+		cl,
+		&readingHandler{cl: cl, useRequestCache: true},
+		&readingHandler{cl: cl, useRequestCache: true},
+	).(GeneratePatches)
+
+	resp := &runtimehooksv1.GeneratePatchesResponse{}
+	h.GeneratePatches(context.Background(), newRequestWithMachineDeployments(10), resp)
+
+	g.Expect(resp.GetStatus()).To(gomega.Equal(runtimehooksv1.ResponseStatusSuccess), resp.GetMessage())
+	// One read of the Cluster and one of the Secret for the whole request.
+	g.Expect(gets.Load()).To(gomega.Equal(int64(2)))
+}
+
+func BenchmarkMetaGeneratePatches_APICalls(b *testing.B) {
+	for _, machineDeployments := range []int{1, 10, 50} {
+		for _, useRequestCache := range []bool{false, true} {
+			name := fmt.Sprintf("machineDeployments=%d/requestCache=%t", machineDeployments, useRequestCache)
+			b.Run(name, func(b *testing.B) {
+				cl, gets := newCountingClient(b)
+				h := NewMetaGeneratePatchesHandler(
+					"request-cache-benchmark",
+					cl,
+					&readingHandler{cl: cl, useRequestCache: useRequestCache},
+					&readingHandler{cl: cl, useRequestCache: useRequestCache},
+					&readingHandler{cl: cl, useRequestCache: useRequestCache},
+				).(GeneratePatches)
+				req := newRequestWithMachineDeployments(machineDeployments)
+
+				b.ResetTimer()
+				for range b.N {
+					resp := &runtimehooksv1.GeneratePatchesResponse{}
+					h.GeneratePatches(context.Background(), req, resp)
+					if resp.GetStatus() != runtimehooksv1.ResponseStatusSuccess {
+						b.Fatal(resp.GetMessage())
+					}
+				}
+				b.ReportMetric(float64(gets.Load())/float64(b.N), "gets/op")
+			})
+		}
+	}
+}
@@ -105,6 +105,46 @@ func AddHandlers(s webhook.Server, hooks ...handlers.Named) error {
 			}
 		}
 
+		if t, ok := h.(lifecycle.BeforeControlPlaneUpgrade); ok {
+			if err := rs.AddExtensionHandler(runtimeserver.ExtensionHandler{
+				Hook:        runtimehooksv1.BeforeControlPlaneUpgrade,
+				Name:        strings.ToLower(h.Name()) + "-bcpu",
+				HandlerFunc: t.BeforeControlPlaneUpgrade,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if t, ok := h.(lifecycle.BeforeWorkersUpgrade); ok {
+			if err := rs.AddExtensionHandler(runtimeserver.ExtensionHandler{
+				Hook:        runtimehooksv1.BeforeWorkersUpgrade,
+				Name:        strings.ToLower(h.Name()) + "-bwu",
+				HandlerFunc: t.BeforeWorkersUpgrade,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if t, ok := h.(lifecycle.AfterWorkersUpgrade); ok {
+			if err := rs.AddExtensionHandler(runtimeserver.ExtensionHandler{
+				Hook:        runtimehooksv1.AfterWorkersUpgrade,
+				Name:        strings.ToLower(h.Name()) + "-awu",
+				HandlerFunc: t.AfterWorkersUpgrade,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if t, ok := h.(lifecycle.AfterClusterUpgrade); ok {
+			if err := rs.AddExtensionHandler(runtimeserver.ExtensionHandler{
+				Hook:        runtimehooksv1.AfterClusterUpgrade,
+				Name:        strings.ToLower(h.Name()) + "-acu",
+				HandlerFunc: t.AfterClusterUpgrade,
+			}); err != nil {
+				return err
+			}
+		}
+
 		if t, ok := h.(lifecycle.BeforeClusterDelete); ok {
 			if err := rs.AddExtensionHandler(runtimeserver.ExtensionHandler{
 				Hook:        runtimehooksv1.BeforeClusterDelete,
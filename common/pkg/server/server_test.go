@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -33,6 +34,38 @@ type namedStub struct{}
 
 func (namedStub) Name() string { return "stub" }
 
+type upgradeHooksStub struct {
+	namedStub
+}
+
+func (upgradeHooksStub) BeforeControlPlaneUpgrade(
+	context.Context,
+	*runtimehooksv1.BeforeControlPlaneUpgradeRequest,
+	*runtimehooksv1.BeforeControlPlaneUpgradeResponse,
+) {
+}
+
+func (upgradeHooksStub) BeforeWorkersUpgrade(
+	context.Context,
+	*runtimehooksv1.BeforeWorkersUpgradeRequest,
+	*runtimehooksv1.BeforeWorkersUpgradeResponse,
+) {
+}
+
+func (upgradeHooksStub) AfterWorkersUpgrade(
+	context.Context,
+	*runtimehooksv1.AfterWorkersUpgradeRequest,
+	*runtimehooksv1.AfterWorkersUpgradeResponse,
+) {
+}
+
+func (upgradeHooksStub) AfterClusterUpgrade(
+	context.Context,
+	*runtimehooksv1.AfterClusterUpgradeRequest,
+	*runtimehooksv1.AfterClusterUpgradeResponse,
+) {
+}
+
 func TestNewWebhookServer_ImplementsWebhookServer(t *testing.T) {
 	t.Parallel()
 	opts := NewServerOptions()
@@ -45,6 +78,18 @@ func TestNewWebhookServer_ImplementsWebhookServer(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestAddHandlers_UpgradeHooks(t *testing.T) {
+	t.Parallel()
+	opts := NewServerOptions()
+	opts.webhookPort = 0
+	opts.webhookCertDir = t.TempDir()
+	ws, err := NewWebhookServer(opts)
+	require.NoError(t, err)
+	require.NoError(t, AddHandlers(ws, upgradeHooksStub{}))
+	// Registering the same handlers twice must fail, proving they were registered the first time.
+	require.Error(t, AddHandlers(ws, upgradeHooksStub{}))
+}
+
 func TestAddHandlersAndAdmissionShareListener(t *testing.T) {
 	t.Parallel()
 
@@ -274,3 +274,16 @@ func NewWorkerDockerMachineTemplateRequestItem(
 		uid,
 	)
 }
+
+// NewValidateTopologyRequestItem returns a ValidateTopologyRequestItem for the object, holder reference and
+// variables of the given GeneratePatchesRequestItem. This allows the request item builders above to be reused
+// when testing ValidateTopology handlers.
+func NewValidateTopologyRequestItem(
+	item runtimehooksv1.GeneratePatchesRequestItem,
+) *runtimehooksv1.ValidateTopologyRequestItem {
+	return &runtimehooksv1.ValidateTopologyRequestItem{
+		HolderReference: item.HolderReference,
+		Object:          item.Object,
+		Variables:       item.Variables,
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package capitest
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/mutation"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/testutils/capitest/request"
+)
+
+type ValidateTopologyTestDef struct {
+	Name         string
+	Vars         []runtimehooksv1.Variable
+	RequestItems []runtimehooksv1.GeneratePatchesRequestItem
+	// ExpectedFailure is true if the topology is expected to be rejected.
+	ExpectedFailure bool
+	// MessageMatcher, if set, is used to match the response message.
+	MessageMatcher gomegatypes.GomegaMatcher
+}
+
+func AssertValidateTopology[T mutation.ValidateTopology](
+	t GinkgoTInterface,
+	handlerCreator func() T,
+	tt *ValidateTopologyTestDef,
+) {
+	t.Helper()
+
+	g := gomega.NewWithT(t)
+	h := handlerCreator()
+	req := &runtimehooksv1.ValidateTopologyRequest{
+		Variables: tt.Vars,
+		Items:     make([]*runtimehooksv1.ValidateTopologyRequestItem, 0, len(tt.RequestItems)),
+	}
+	for i := range tt.RequestItems {
+		req.Items = append(req.Items, request.NewValidateTopologyRequestItem(tt.RequestItems[i]))
+	}
+	resp := &runtimehooksv1.ValidateTopologyResponse{}
+	h.ValidateTopology(context.Background(), req, resp)
+
+	expectedStatus := runtimehooksv1.ResponseStatusSuccess
+	if tt.ExpectedFailure {
+		expectedStatus = runtimehooksv1.ResponseStatusFailure
+	}
+	g.Expect(resp.Status).
+		To(gomega.Equal(expectedStatus), fmt.Sprintf("Message: %s", resp.Message))
+
+	if tt.MessageMatcher != nil {
+		g.Expect(resp.Message).To(tt.MessageMatcher)
+	}
+}
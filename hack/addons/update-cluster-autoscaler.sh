@@ -35,6 +35,7 @@ Cluster:
     caren.nutanix.com/cluster-uuid: tmpl-clusteruuid-tmpl
   Labels:
     cluster.x-k8s.io/provider: tmpl-capiprovider-tmpl
+ExtraArgs: []
 EOF
 gomplate -f "${GIT_REPO_ROOT}/charts/cluster-api-runtime-extensions-nutanix/addons/cluster-autoscaler/values-template.yaml" \
   --context .="${ASSETS_DIR}/gomplate-context.yaml" \
@@ -47,6 +48,10 @@ sed -i -e "s/\([a-z-]*\)tmpl-clustername\([^-]*\)-tmpl\([a-z-]*\)/\1{{ \`{{ .Clu
   -e "s/\([a-z-]*\)tmpl-capiprovider-tmpl\([a-z-]*\)/\1{{ \`{{ index .Cluster.Labels \"cluster.x-k8s.io\/provider\" }}\` }}\2/g" \
   "${ASSETS_DIR}/${FILE_NAME}"
 
+# Render the cluster-autoscaler arguments set in the ClusterAutoscaler addon variable after the static arguments.
+sed -i -e "s/^\( *\)- --enforce-node-group-min-size=true$/&\n\1{{ \`{{- range .ExtraArgs }}\` }}\n\1- --{{ \`{{ .Name }}\` }}={{ \`{{ .Value }}\` }}\n\1{{ \`{{- end }}\` }}/" \
+  "${ASSETS_DIR}/${FILE_NAME}"
+
 kubectl create configmap "{{ .Values.hooks.clusterAutoscaler.crsStrategy.defaultInstallationConfigMap.name }}" --dry-run=client --output yaml \
   --from-file "${ASSETS_DIR}/${FILE_NAME}" \
   >"${ASSETS_DIR}/cluster-autoscaler-configmap.yaml"
@@ -235,4 +235,6 @@ list-images:
 	  -chart-directory=$(PWD)/charts/cluster-api-runtime-extensions-nutanix/ \
 	  -helm-chart-configmap=$(PWD)/charts/cluster-api-runtime-extensions-nutanix/templates/helm-config.yaml \
 	  -caren-version=$(CAREN_VERSION) \
-	  -additional-yaml-files=$(PWD)/charts/cluster-api-runtime-extensions-nutanix/defaultclusterclasses/nutanix-cluster-class.yaml
+	  -additional-yaml-files=$(PWD)/charts/cluster-api-runtime-extensions-nutanix/defaultclusterclasses/nutanix-cluster-class.yaml \
+	  -additional-yaml-files=$(PWD)/pkg/handlers/generic/mutation/kubeadm/controlplanevirtualip/providers/templates/keepalived.yaml \
+	  -additional-yaml-files=$(PWD)/pkg/handlers/generic/mutation/kubeadm/controlplanevirtualip/providers/templates/haproxy.yaml
@@ -0,0 +1,525 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionkeyrotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/generic/mutation/kubeadm/encryptionatrest"
+)
+
+const (
+	// The state of the rotation is stored in annotations on the encryption configuration Secret, so that
+	// the rotation resumes from the current phase if a step fails.
+	annotationPrefix = v1alpha1.APIGroup + "/encryption-key-rotation-"
+
+	// triggerAnnotation is the trigger of the rotation in progress, or of the last rotation.
+	triggerAnnotation = annotationPrefix + "trigger"
+	// phaseAnnotation is the current phase of the rotation.
+	phaseAnnotation = annotationPrefix + "phase"
+	// keyAnnotation is the name of the key that the rotation adds.
+	keyAnnotation = annotationPrefix + "key"
+	// rolloutAfterAnnotation is the time after which the control plane machines must have been created
+	// for the current phase to complete. It is set when the configuration of the phase is written.
+	rolloutAfterAnnotation = annotationPrefix + "rollout-after"
+
+	kubeadmControlPlaneKind = "KubeadmControlPlane"
+
+	rolloutRequeueAfter = time.Minute
+)
+
+type phase string
+
+const (
+	phaseAddKey     phase = "AddKey"
+	phasePromoteKey phase = "PromoteKey"
+	phaseReEncrypt  phase = "ReEncrypt"
+	phaseRetireKey  phase = "RetireKey"
+	phaseCompleted  phase = "Completed"
+)
+
+type Reconciler struct {
+	client.Client
+
+	// ClusterClientGetter returns a client for the workload cluster. Defaults to remote.NewClusterClient.
+	ClusterClientGetter remote.ClusterClientGetter
+
+	// KeyGenerator generates the new keys. Defaults to encryptionatrest.RandomTokenGenerator.
+	KeyGenerator encryptionatrest.TokenGenerator
+
+	// clock is used to record the time of the control plane rollouts. Defaults to the real clock.
+	clock clock.PassiveClock
+}
+
+func (r *Reconciler) SetupWithManager(
+	mgr ctrl.Manager,
+	options *controller.Options,
+) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&clusterv1.Cluster{}).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &clusterv1.Cluster{}),
+		).
+		WithOptions(*options).
+		Complete(r)
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx).WithValues("cluster", req.NamespacedName)
+	ctx = ctrl.LoggerInto(ctx, logger)
+
+	var cluster clusterv1.Cluster
+	if err := r.Get(ctx, req.NamespacedName, &cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(5).Info("Cluster not found, skipping reconciliation")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get Cluster %s: %w", req.NamespacedName, err)
+	}
+
+	if !cluster.DeletionTimestamp.IsZero() || annotations.IsPaused(&cluster, &cluster) {
+		logger.V(5).Info("Cluster is being deleted or is paused, skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	if !cluster.Spec.Topology.IsDefined() || cluster.Spec.ControlPlaneRef.Kind != kubeadmControlPlaneKind {
+		logger.V(5).Info("Cluster does not use topology with a KubeadmControlPlane, skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	encryptionVariable, err := variables.Get[v1alpha1.EncryptionAtRest](
+		variables.ClusterVariablesToVariablesMap(cluster.Spec.Topology.Variables),
+		v1alpha1.ClusterConfigVariableName,
+		encryptionatrest.VariableName,
+	)
+	if err != nil {
+		if variables.IsNotFoundError(err) {
+			logger.V(5).Info("Encryption at rest is not enabled, skipping reconciliation")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to read encryption at rest variable: %w", err)
+	}
+
+	secretKey := types.NamespacedName{
+		Namespace: cluster.Namespace,
+		Name:      encryptionatrest.DefaultEncryptionSecretName(cluster.Name),
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, secretKey, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(5).Info("Encryption configuration Secret not found, skipping reconciliation")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get encryption configuration Secret %s: %w", secretKey, err)
+	}
+
+	currentPhase := phase(secret.Annotations[phaseAnnotation])
+	rotating := currentPhase != "" && currentPhase != phaseCompleted
+	trigger := requestedTrigger(&cluster, encryptionVariable)
+	rotationRequested := rotating || (trigger != "" && trigger != secret.Annotations[triggerAnnotation])
+	_, configurationUpdated := secret.Annotations[encryptionatrest.ConfigurationUpdatedAnnotation]
+	if !rotationRequested && !configurationUpdated {
+		return ctrl.Result{}, nil
+	}
+
+	kcpKey := types.NamespacedName{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Spec.ControlPlaneRef.Name,
+	}
+	kcp := &controlplanev1.KubeadmControlPlane{}
+	if err := r.Get(ctx, kcpKey, kcp); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(5).Info("KubeadmControlPlane not found, skipping reconciliation")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get KubeadmControlPlane %s: %w", kcpKey, err)
+	}
+	if !kcp.DeletionTimestamp.IsZero() || annotations.IsPaused(&cluster, kcp) {
+		logger.V(5).Info("KubeadmControlPlane is being deleted or is paused, skipping reconciliation")
+		return ctrl.Result{}, nil
+	}
+
+	// A rotation in progress rolls out the control plane with the updated configuration.
+	if configurationUpdated && !rotating {
+		complete, err := r.reconcileConfigurationUpdate(ctx, &cluster, kcp, secret)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !complete {
+			logger.V(5).Info("Waiting for the control plane to roll out the updated encryption configuration")
+			return ctrl.Result{RequeueAfter: rolloutRequeueAfter}, nil
+		}
+		if !rotationRequested {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	result, err := r.reconcileRotation(ctx, &cluster, encryptionVariable, kcp, secret)
+	if err != nil {
+		r.setCondition(ctx, &cluster, metav1.Condition{
+			Type:    v1alpha1.EncryptionKeysRotatedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.EncryptionKeysRotationFailedReason,
+			Message: fmt.Sprintf("Phase %s failed: %v", secret.Annotations[phaseAnnotation], err),
+		})
+		return ctrl.Result{}, err
+	}
+	return result, nil
+}
+
+// requestedTrigger returns the trigger of the requested rotation. The trigger of the encryptionAtRest
+// variable takes precedence over the annotation on the Cluster.
+func requestedTrigger(cluster *clusterv1.Cluster, encryptionVariable v1alpha1.EncryptionAtRest) string {
+	if encryptionVariable.Rotation != nil {
+		return encryptionVariable.Rotation.Trigger
+	}
+	return cluster.Annotations[v1alpha1.EncryptionAtRestRotationAnnotationKey]
+}
+
+// reconcileRotation runs the phases of the rotation, one after the other, until a phase waits for the
+// control plane to roll out, or the rotation completes.
+func (r *Reconciler) reconcileRotation(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	encryptionVariable v1alpha1.EncryptionAtRest,
+	kcp *controlplanev1.KubeadmControlPlane,
+	secret *corev1.Secret,
+) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	for {
+		currentPhase := phase(secret.Annotations[phaseAnnotation])
+		if currentPhase != "" && currentPhase != phaseCompleted {
+			r.setCondition(ctx, cluster, metav1.Condition{
+				Type:    v1alpha1.EncryptionKeysRotatedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1alpha1.EncryptionKeysRotationInProgressReason,
+				Message: fmt.Sprintf("Rotating encryption keys, phase %s", currentPhase),
+			})
+		}
+
+		var (
+			next    phase
+			waiting bool
+			err     error
+		)
+		switch currentPhase {
+		case "", phaseCompleted:
+			trigger := requestedTrigger(cluster, encryptionVariable)
+			if trigger == "" || trigger == secret.Annotations[triggerAnnotation] {
+				r.setCondition(ctx, cluster, metav1.Condition{
+					Type:    v1alpha1.EncryptionKeysRotatedCondition,
+					Status:  metav1.ConditionTrue,
+					Reason:  v1alpha1.EncryptionKeysRotatedReason,
+					Message: fmt.Sprintf("Rotated encryption keys for trigger %q", secret.Annotations[triggerAnnotation]),
+				})
+				return ctrl.Result{}, nil
+			}
+			// Start the rotation only when the control plane is not rolling out.
+			stable, err := r.rolloutComplete(ctx, cluster, kcp, time.Time{})
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if !stable {
+				logger.V(5).Info("Control plane is rolling out, waiting to start the encryption key rotation")
+				return ctrl.Result{RequeueAfter: rolloutRequeueAfter}, nil
+			}
+			logger.Info("Starting encryption key rotation", "trigger", trigger)
+			if err := r.startRotation(ctx, secret, trigger); err != nil {
+				return ctrl.Result{}, err
+			}
+			continue
+		case phaseAddKey:
+			next, waiting, err = r.reconcileKeyPhase(ctx, cluster, kcp, secret, phasePromoteKey,
+				func(config *apiserverv1.EncryptionConfiguration, key string) error {
+					return addKey(config, key, r.keyGenerator())
+				})
+		case phasePromoteKey:
+			next, waiting, err = r.reconcileKeyPhase(ctx, cluster, kcp, secret, phaseReEncrypt, promoteKey)
+		case phaseReEncrypt:
+			next, err = phaseRetireKey, r.reEncrypt(ctx, cluster, secret)
+		case phaseRetireKey:
+			next, waiting, err = r.reconcileKeyPhase(ctx, cluster, kcp, secret, phaseCompleted, retireKeys)
+		default:
+			return ctrl.Result{}, fmt.Errorf("unknown encryption key rotation phase %q", currentPhase)
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if waiting {
+			logger.V(5).Info("Waiting for the control plane to roll out", "phase", currentPhase)
+			return ctrl.Result{RequeueAfter: rolloutRequeueAfter}, nil
+		}
+
+		logger.Info("Completed encryption key rotation phase", "phase", currentPhase, "nextPhase", next)
+		if err := r.setPhase(ctx, secret, next); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+}
+
+func (r *Reconciler) now() time.Time {
+	if r.clock != nil {
+		return r.clock.Now()
+	}
+	return time.Now()
+}
+
+func (r *Reconciler) keyGenerator() encryptionatrest.TokenGenerator {
+	if r.KeyGenerator != nil {
+		return r.KeyGenerator
+	}
+	return encryptionatrest.RandomTokenGenerator
+}
+
+// startRotation records a new rotation for the trigger. If the configuration does not use keys, there is
+// nothing to rotate, and the rotation completes immediately.
+func (r *Reconciler) startRotation(ctx context.Context, secret *corev1.Secret, trigger string) error {
+	config, err := readConfiguration(secret)
+	if err != nil {
+		return err
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[triggerAnnotation] = trigger
+	secret.Annotations[keyAnnotation] = nextKeyName(config)
+	secret.Annotations[phaseAnnotation] = string(phaseAddKey)
+	if !hasKeys(config) {
+		secret.Annotations[phaseAnnotation] = string(phaseCompleted)
+	}
+	delete(secret.Annotations, rolloutAfterAnnotation)
+
+	if err := r.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update encryption configuration Secret: %w", err)
+	}
+	return nil
+}
+
+// setPhase records the next phase of the rotation.
+func (r *Reconciler) setPhase(ctx context.Context, secret *corev1.Secret, next phase) error {
+	secret.Annotations[phaseAnnotation] = string(next)
+	delete(secret.Annotations, rolloutAfterAnnotation)
+	if err := r.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update encryption configuration Secret: %w", err)
+	}
+	return nil
+}
+
+// reconcileKeyPhase changes the keys of the encryption configuration, rolls out the control plane, so
+// that the API servers read the new configuration, and returns the next phase when the rollout completes.
+// The configuration is changed, and the time of the rollout recorded, in a single update of the Secret,
+// so that the configuration is changed exactly once, even if a later step fails.
+func (r *Reconciler) reconcileKeyPhase(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	kcp *controlplanev1.KubeadmControlPlane,
+	secret *corev1.Secret,
+	next phase,
+	changeKeys func(config *apiserverv1.EncryptionConfiguration, key string) error,
+) (phase, bool, error) {
+	if _, ok := secret.Annotations[rolloutAfterAnnotation]; !ok {
+		config, err := readConfiguration(secret)
+		if err != nil {
+			return "", false, err
+		}
+		if err := changeKeys(config, secret.Annotations[keyAnnotation]); err != nil {
+			return "", false, err
+		}
+		if err := writeConfiguration(secret, config); err != nil {
+			return "", false, err
+		}
+		secret.Annotations[rolloutAfterAnnotation] = r.now().UTC().Format(time.RFC3339)
+		if err := r.Update(ctx, secret); err != nil {
+			return "", false, fmt.Errorf("failed to update encryption configuration Secret: %w", err)
+		}
+	}
+
+	rolloutAfter, err := time.Parse(time.RFC3339, secret.Annotations[rolloutAfterAnnotation])
+	if err != nil {
+		return "", false, fmt.Errorf("failed to parse annotation %s: %w", rolloutAfterAnnotation, err)
+	}
+
+	if kcp.Spec.Rollout.After.Time.Before(rolloutAfter) {
+		kcp.Spec.Rollout.After = metav1.NewTime(rolloutAfter)
+		if err := r.Update(ctx, kcp); err != nil {
+			return "", false, fmt.Errorf(
+				"failed to update KubeadmControlPlane %s: %w",
+				client.ObjectKeyFromObject(kcp),
+				err,
+			)
+		}
+		ctrl.LoggerFrom(ctx).Info(
+			"Triggered KubeadmControlPlane rollout for encryption key rotation",
+			"phase", secret.Annotations[phaseAnnotation],
+			"rolloutAfter", rolloutAfter.Format(time.RFC3339),
+		)
+		return "", true, nil
+	}
+
+	complete, err := r.rolloutComplete(ctx, cluster, kcp, rolloutAfter)
+	if err != nil {
+		return "", false, err
+	}
+	return next, !complete, nil
+}
+
+// reconcileConfigurationUpdate rolls out the control plane when the encryption configuration of an existing
+// cluster was updated, so that the API servers read the updated configuration, and returns true when the
+// rollout completes.
+func (r *Reconciler) reconcileConfigurationUpdate(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	kcp *controlplanev1.KubeadmControlPlane,
+	secret *corev1.Secret,
+) (bool, error) {
+	updated, err := time.Parse(time.RFC3339, secret.Annotations[encryptionatrest.ConfigurationUpdatedAnnotation])
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to parse annotation %s: %w",
+			encryptionatrest.ConfigurationUpdatedAnnotation,
+			err,
+		)
+	}
+
+	if kcp.Spec.Rollout.After.Time.Before(updated) {
+		kcp.Spec.Rollout.After = metav1.NewTime(updated)
+		if err := r.Update(ctx, kcp); err != nil {
+			return false, fmt.Errorf(
+				"failed to update KubeadmControlPlane %s: %w",
+				client.ObjectKeyFromObject(kcp),
+				err,
+			)
+		}
+		ctrl.LoggerFrom(ctx).Info(
+			"Triggered KubeadmControlPlane rollout for updated encryption configuration",
+			"rolloutAfter", updated.Format(time.RFC3339),
+		)
+		return false, nil
+	}
+
+	complete, err := r.rolloutComplete(ctx, cluster, kcp, updated)
+	if err != nil || !complete {
+		return false, err
+	}
+
+	// The update fails with a conflict if the configuration was updated again, and the rollout is retried.
+	delete(secret.Annotations, encryptionatrest.ConfigurationUpdatedAnnotation)
+	if err := r.Update(ctx, secret); err != nil {
+		return false, fmt.Errorf("failed to update encryption configuration Secret: %w", err)
+	}
+	return true, nil
+}
+
+// rolloutComplete returns true if all control plane machines were created at, or after, the given time,
+// and are up to date and ready.
+func (r *Reconciler) rolloutComplete(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	kcp *controlplanev1.KubeadmControlPlane,
+	after time.Time,
+) (bool, error) {
+	if kcp.Status.ObservedGeneration < kcp.Generation || kcp.Spec.Rollout.After.Time.Before(after) {
+		return false, nil
+	}
+
+	replicas := ptr.Deref(kcp.Spec.Replicas, 1)
+	if ptr.Deref(kcp.Status.Replicas, 0) != replicas ||
+		ptr.Deref(kcp.Status.UpToDateReplicas, 0) != replicas ||
+		ptr.Deref(kcp.Status.ReadyReplicas, 0) != replicas {
+		return false, nil
+	}
+
+	var machines clusterv1.MachineList
+	if err := r.List(ctx, &machines, client.InNamespace(cluster.Namespace), client.MatchingLabels{
+		clusterv1.ClusterNameLabel:         cluster.Name,
+		clusterv1.MachineControlPlaneLabel: "",
+	}); err != nil {
+		return false, fmt.Errorf("failed to list control plane machines: %w", err)
+	}
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if !machine.DeletionTimestamp.IsZero() || machine.CreationTimestamp.Time.Before(after) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// reEncrypt re-encrypts the encrypted resources in the workload cluster with the key that is used to
+// encrypt.
+func (r *Reconciler) reEncrypt(ctx context.Context, cluster *clusterv1.Cluster, secret *corev1.Secret) error {
+	config, err := readConfiguration(secret)
+	if err != nil {
+		return err
+	}
+
+	clusterClientGetter := r.ClusterClientGetter
+	if clusterClientGetter == nil {
+		clusterClientGetter = remote.NewClusterClient
+	}
+	remoteClient, err := clusterClientGetter(ctx, "", r.Client, client.ObjectKeyFromObject(cluster))
+	if err != nil {
+		return fmt.Errorf("failed to get client for workload cluster: %w", err)
+	}
+
+	if err := reEncrypt(ctx, remoteClient, config); err != nil {
+		return fmt.Errorf("failed to re-encrypt resources: %w", err)
+	}
+	return nil
+}
+
+// setCondition sets the condition on the Cluster. Failures are logged, because the condition only
+// reports the progress of the rotation.
+func (r *Reconciler) setCondition(ctx context.Context, cluster *clusterv1.Cluster, condition metav1.Condition) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &clusterv1.Cluster{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		original := latest.DeepCopy()
+
+		condition.ObservedGeneration = latest.GetGeneration()
+		if !meta.SetStatusCondition(&latest.Status.Conditions, condition) {
+			return nil
+		}
+
+		return r.Status().Patch(
+			ctx,
+			latest,
+			client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}),
+		)
+	})
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Error(
+			err,
+			"failed to set encryption key rotation condition on cluster",
+			"condition", condition.Type,
+		)
+	}
+}
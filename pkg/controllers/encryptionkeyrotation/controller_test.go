@@ -0,0 +1,440 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionkeyrotation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/generic/mutation/kubeadm/encryptionatrest"
+)
+
+const (
+	testNamespace = "test-namespace"
+	testCluster   = "test-cluster"
+)
+
+var testStart = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, clusterv1.AddToScheme(scheme))
+	require.NoError(t, controlplanev1.AddToScheme(scheme))
+	return scheme
+}
+
+func newCluster(t *testing.T, encryption *v1alpha1.EncryptionAtRest) *clusterv1.Cluster {
+	t.Helper()
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testCluster,
+			Namespace: testNamespace,
+		},
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneRef: clusterv1.ContractVersionedObjectReference{
+				APIGroup: controlplanev1.GroupVersion.Group,
+				Kind:     kubeadmControlPlaneKind,
+				Name:     "test-kcp",
+			},
+			Topology: clusterv1.Topology{
+				ClassRef: clusterv1.ClusterClassRef{Name: "test-class"},
+				Version:  "v1.33.0",
+			},
+		},
+	}
+	if encryption != nil {
+		raw, err := json.Marshal(map[string]any{encryptionatrest.VariableName: encryption})
+		require.NoError(t, err)
+		cluster.Spec.Topology.Variables = []clusterv1.ClusterVariable{{
+			Name:  v1alpha1.ClusterConfigVariableName,
+			Value: apiextensionsv1.JSON{Raw: raw},
+		}}
+	}
+	return cluster
+}
+
+func newEncryptionSecret(t *testing.T) *corev1.Secret {
+	t.Helper()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      encryptionatrest.DefaultEncryptionSecretName(testCluster),
+			Namespace: testNamespace,
+		},
+	}
+	require.NoError(t, writeConfiguration(secret, testConfiguration()))
+	return secret
+}
+
+func newKCP() *controlplanev1.KubeadmControlPlane {
+	return &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-kcp",
+			Namespace: testNamespace,
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Replicas: ptr.To[int32](1),
+		},
+		Status: controlplanev1.KubeadmControlPlaneStatus{
+			Replicas:         ptr.To[int32](1),
+			UpToDateReplicas: ptr.To[int32](1),
+			ReadyReplicas:    ptr.To[int32](1),
+		},
+	}
+}
+
+func newMachine(created time.Time) *clusterv1.Machine {
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-machine",
+			Namespace:         testNamespace,
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel:         testCluster,
+				clusterv1.MachineControlPlaneLabel: "",
+			},
+		},
+	}
+}
+
+type testEnv struct {
+	t          *testing.T
+	client     ctrlclient.Client
+	clock      *clocktesting.FakePassiveClock
+	reconciler *Reconciler
+	reEncrypts *[]string
+}
+
+func newTestEnv(t *testing.T, objects ...ctrlclient.Object) *testEnv {
+	t.Helper()
+	c := fake.NewClientBuilder().
+		WithScheme(newScheme(t)).
+		WithObjects(objects...).
+		WithStatusSubresource(&clusterv1.Cluster{}, &controlplanev1.KubeadmControlPlane{}).
+		Build()
+
+	var reEncrypts []string
+	workloadClient := newWorkloadClusterClient(&reEncrypts, nil,
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "workload-secret", Namespace: "default"}},
+	)
+
+	fakeClock := clocktesting.NewFakePassiveClock(testStart)
+	return &testEnv{
+		t:      t,
+		client: c,
+		clock:  fakeClock,
+		reconciler: &Reconciler{
+			Client: c,
+			ClusterClientGetter: func(
+				context.Context, string, ctrlclient.Client, ctrlclient.ObjectKey,
+			) (ctrlclient.Client, error) {
+				return workloadClient, nil
+			},
+			KeyGenerator: testTokenGenerator,
+			clock:        fakeClock,
+		},
+		reEncrypts: &reEncrypts,
+	}
+}
+
+func (e *testEnv) reconcile() ctrl.Result {
+	e.t.Helper()
+	result, err := e.reconciler.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: ctrlclient.ObjectKey{Namespace: testNamespace, Name: testCluster},
+	})
+	require.NoError(e.t, err)
+	return result
+}
+
+func (e *testEnv) secret() *corev1.Secret {
+	e.t.Helper()
+	secret := &corev1.Secret{}
+	require.NoError(e.t, e.client.Get(context.Background(), ctrlclient.ObjectKey{
+		Namespace: testNamespace,
+		Name:      encryptionatrest.DefaultEncryptionSecretName(testCluster),
+	}, secret))
+	return secret
+}
+
+func (e *testEnv) aescbcKeys() []string {
+	e.t.Helper()
+	config, err := readConfiguration(e.secret())
+	require.NoError(e.t, err)
+	return keyNames(config.Resources[0].Providers[0].AESCBC.Keys)
+}
+
+func (e *testEnv) kcp() *controlplanev1.KubeadmControlPlane {
+	e.t.Helper()
+	kcp := &controlplanev1.KubeadmControlPlane{}
+	require.NoError(e.t, e.client.Get(context.Background(), ctrlclient.ObjectKey{
+		Namespace: testNamespace,
+		Name:      "test-kcp",
+	}, kcp))
+	return kcp
+}
+
+func (e *testEnv) condition() *metav1.Condition {
+	e.t.Helper()
+	cluster := &clusterv1.Cluster{}
+	require.NoError(e.t, e.client.Get(context.Background(), ctrlclient.ObjectKey{
+		Namespace: testNamespace,
+		Name:      testCluster,
+	}, cluster))
+	return meta.FindStatusCondition(cluster.Status.Conditions, v1alpha1.EncryptionKeysRotatedCondition)
+}
+
+// rollOut replaces the control plane machine with a machine created at the given time.
+func (e *testEnv) rollOut(created time.Time) {
+	e.t.Helper()
+	ctx := context.Background()
+
+	kcp := e.kcp()
+	kcp.Status.ObservedGeneration = kcp.Generation
+	require.NoError(e.t, e.client.Status().Update(ctx, kcp))
+
+	require.NoError(e.t, e.client.Delete(ctx, newMachine(time.Time{})))
+	require.NoError(e.t, e.client.Create(ctx, newMachine(created)))
+}
+
+func TestReconcile_RotatesKeys(t *testing.T) {
+	cluster := newCluster(t, &v1alpha1.EncryptionAtRest{
+		Providers: []v1alpha1.EncryptionProviders{{AESCBC: &v1alpha1.AESConfiguration{}}},
+		Rotation:  &v1alpha1.EncryptionAtRestRotation{Trigger: "2026-01-01"},
+	})
+	env := newTestEnv(t, cluster, newEncryptionSecret(t), newKCP(), newMachine(testStart.Add(-time.Hour)))
+
+	// The new key is added after the existing key, and the control plane is rolled out.
+	result := env.reconcile()
+	assert.Equal(t, rolloutRequeueAfter, result.RequeueAfter)
+	assert.Equal(t, []string{"key1", "key2"}, env.aescbcKeys())
+	assert.Equal(t, string(phaseAddKey), env.secret().Annotations[phaseAnnotation])
+	assert.Equal(t, "2026-01-01", env.secret().Annotations[triggerAnnotation])
+	assert.Equal(t, testStart, env.kcp().Spec.Rollout.After.Time.UTC())
+	condition := env.condition()
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1alpha1.EncryptionKeysRotationInProgressReason, condition.Reason)
+
+	// The phase waits until the control plane machines are replaced.
+	env.clock.SetTime(testStart.Add(time.Minute))
+	result = env.reconcile()
+	assert.Equal(t, rolloutRequeueAfter, result.RequeueAfter)
+	assert.Equal(t, string(phaseAddKey), env.secret().Annotations[phaseAnnotation])
+
+	// The new key is promoted, and the control plane is rolled out.
+	env.rollOut(testStart.Add(time.Minute))
+	env.clock.SetTime(testStart.Add(2 * time.Minute))
+	result = env.reconcile()
+	assert.Equal(t, rolloutRequeueAfter, result.RequeueAfter)
+	assert.Equal(t, []string{"key2", "key1"}, env.aescbcKeys())
+	assert.Equal(t, string(phasePromoteKey), env.secret().Annotations[phaseAnnotation])
+	assert.Equal(t, testStart.Add(2*time.Minute), env.kcp().Spec.Rollout.After.Time.UTC())
+	assert.Empty(t, *env.reEncrypts)
+
+	// The resources are re-encrypted, the old key is retired, and the control plane is rolled out.
+	env.rollOut(testStart.Add(3 * time.Minute))
+	env.clock.SetTime(testStart.Add(4 * time.Minute))
+	result = env.reconcile()
+	assert.Equal(t, rolloutRequeueAfter, result.RequeueAfter)
+	assert.Equal(t, []string{"Secret/workload-secret"}, *env.reEncrypts)
+	assert.Equal(t, []string{"key2"}, env.aescbcKeys())
+	assert.Equal(t, string(phaseRetireKey), env.secret().Annotations[phaseAnnotation])
+	assert.Equal(t, testStart.Add(4*time.Minute), env.kcp().Spec.Rollout.After.Time.UTC())
+
+	// The rotation completes.
+	env.rollOut(testStart.Add(5 * time.Minute))
+	env.clock.SetTime(testStart.Add(6 * time.Minute))
+	result = env.reconcile()
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.Equal(t, []string{"key2"}, env.aescbcKeys())
+	assert.Equal(t, string(phaseCompleted), env.secret().Annotations[phaseAnnotation])
+	condition = env.condition()
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1alpha1.EncryptionKeysRotatedReason, condition.Reason)
+
+	// The rotation is not repeated for the same trigger.
+	env.clock.SetTime(testStart.Add(7 * time.Minute))
+	result = env.reconcile()
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.Equal(t, testStart.Add(4*time.Minute), env.kcp().Spec.Rollout.After.Time.UTC())
+}
+
+func TestReconcile_AnnotationTrigger(t *testing.T) {
+	cluster := newCluster(t, &v1alpha1.EncryptionAtRest{
+		Providers: []v1alpha1.EncryptionProviders{{AESCBC: &v1alpha1.AESConfiguration{}}},
+	})
+	secret := newEncryptionSecret(t)
+	secret.Annotations = map[string]string{
+		triggerAnnotation: "previous",
+		phaseAnnotation:   string(phaseCompleted),
+	}
+	env := newTestEnv(t, cluster, secret, newKCP(), newMachine(testStart.Add(-time.Hour)))
+
+	// Nothing is requested.
+	assert.Equal(t, ctrl.Result{}, env.reconcile())
+	assert.Equal(t, []string{"key1"}, env.aescbcKeys())
+
+	// The annotation requests the last completed rotation.
+	cluster = &clusterv1.Cluster{}
+	require.NoError(t, env.client.Get(context.Background(), ctrlclient.ObjectKeyFromObject(newCluster(t, nil)), cluster))
+	cluster.Annotations = map[string]string{v1alpha1.EncryptionAtRestRotationAnnotationKey: "previous"}
+	require.NoError(t, env.client.Update(context.Background(), cluster))
+	assert.Equal(t, ctrl.Result{}, env.reconcile())
+	assert.Equal(t, []string{"key1"}, env.aescbcKeys())
+
+	// The annotation requests a new rotation.
+	cluster.Annotations[v1alpha1.EncryptionAtRestRotationAnnotationKey] = "next"
+	require.NoError(t, env.client.Update(context.Background(), cluster))
+	assert.Equal(t, rolloutRequeueAfter, env.reconcile().RequeueAfter)
+	assert.Equal(t, []string{"key1", "key2"}, env.aescbcKeys())
+	assert.Equal(t, "next", env.secret().Annotations[triggerAnnotation])
+}
+
+func TestReconcile_WaitsForControlPlaneBeforeStarting(t *testing.T) {
+	cluster := newCluster(t, &v1alpha1.EncryptionAtRest{
+		Providers: []v1alpha1.EncryptionProviders{{AESCBC: &v1alpha1.AESConfiguration{}}},
+		Rotation:  &v1alpha1.EncryptionAtRestRotation{Trigger: "rotate"},
+	})
+	kcp := newKCP()
+	kcp.Status.UpToDateReplicas = ptr.To[int32](0)
+	env := newTestEnv(t, cluster, newEncryptionSecret(t), kcp, newMachine(testStart.Add(-time.Hour)))
+
+	assert.Equal(t, rolloutRequeueAfter, env.reconcile().RequeueAfter)
+	assert.Equal(t, []string{"key1"}, env.aescbcKeys())
+	assert.Empty(t, env.secret().Annotations[phaseAnnotation])
+}
+
+func TestReconcile_ResumesPhase(t *testing.T) {
+	cluster := newCluster(t, &v1alpha1.EncryptionAtRest{
+		Providers: []v1alpha1.EncryptionProviders{{AESCBC: &v1alpha1.AESConfiguration{}}},
+		Rotation:  &v1alpha1.EncryptionAtRestRotation{Trigger: "rotate"},
+	})
+	// The configuration was changed, but the control plane rollout was not triggered.
+	secret := newEncryptionSecret(t)
+	config := testConfiguration()
+	require.NoError(t, addKey(config, "key2", testTokenGenerator))
+	require.NoError(t, writeConfiguration(secret, config))
+	secret.Annotations = map[string]string{
+		triggerAnnotation:      "rotate",
+		phaseAnnotation:        string(phaseAddKey),
+		keyAnnotation:          "key2",
+		rolloutAfterAnnotation: testStart.Format(time.RFC3339),
+	}
+	env := newTestEnv(t, cluster, secret, newKCP(), newMachine(testStart.Add(-time.Hour)))
+	env.clock.SetTime(testStart.Add(time.Minute))
+
+	assert.Equal(t, rolloutRequeueAfter, env.reconcile().RequeueAfter)
+	assert.Equal(t, []string{"key1", "key2"}, env.aescbcKeys())
+	assert.Equal(t, testStart, env.kcp().Spec.Rollout.After.Time.UTC())
+}
+
+func TestReconcile_NoKeys(t *testing.T) {
+	cluster := newCluster(t, &v1alpha1.EncryptionAtRest{
+		Providers: []v1alpha1.EncryptionProviders{{AESCBC: &v1alpha1.AESConfiguration{}}},
+		Rotation:  &v1alpha1.EncryptionAtRestRotation{Trigger: "rotate"},
+	})
+	secret := newEncryptionSecret(t)
+	require.NoError(t, writeConfiguration(secret, &apiserverv1.EncryptionConfiguration{
+		Resources: []apiserverv1.ResourceConfiguration{{
+			Resources: []string{"secrets"},
+			Providers: []apiserverv1.ProviderConfiguration{{Identity: &apiserverv1.IdentityConfiguration{}}},
+		}},
+	}))
+	env := newTestEnv(t, cluster, secret, newKCP(), newMachine(testStart.Add(-time.Hour)))
+
+	assert.Equal(t, ctrl.Result{}, env.reconcile())
+	assert.Equal(t, string(phaseCompleted), env.secret().Annotations[phaseAnnotation])
+	assert.True(t, env.kcp().Spec.Rollout.After.IsZero())
+}
+
+func TestReconcile_RollsOutUpdatedConfiguration(t *testing.T) {
+	cluster := newCluster(t, &v1alpha1.EncryptionAtRest{
+		Providers: []v1alpha1.EncryptionProviders{{AESCBC: &v1alpha1.AESConfiguration{}}},
+	})
+	secret := newEncryptionSecret(t)
+	secret.Annotations = map[string]string{
+		encryptionatrest.ConfigurationUpdatedAnnotation: testStart.Format(time.RFC3339),
+	}
+	env := newTestEnv(t, cluster, secret, newKCP(), newMachine(testStart.Add(-time.Hour)))
+
+	// The control plane is rolled out.
+	assert.Equal(t, rolloutRequeueAfter, env.reconcile().RequeueAfter)
+	assert.Equal(t, testStart, env.kcp().Spec.Rollout.After.Time.UTC())
+
+	// The annotation is kept until the control plane machines are replaced.
+	assert.Equal(t, rolloutRequeueAfter, env.reconcile().RequeueAfter)
+	assert.Contains(t, env.secret().Annotations, encryptionatrest.ConfigurationUpdatedAnnotation)
+
+	env.rollOut(testStart.Add(time.Minute))
+	assert.Equal(t, ctrl.Result{}, env.reconcile())
+	assert.NotContains(t, env.secret().Annotations, encryptionatrest.ConfigurationUpdatedAnnotation)
+	assert.Equal(t, []string{"key1"}, env.aescbcKeys())
+	assert.Nil(t, env.condition())
+}
+
+func TestReconcile_Skips(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *clusterv1.Cluster
+	}{{
+		name:    "encryption at rest not enabled",
+		cluster: newCluster(t, nil),
+	}, {
+		name: "cluster paused",
+		cluster: func() *clusterv1.Cluster {
+			cluster := newCluster(t, &v1alpha1.EncryptionAtRest{
+				Rotation: &v1alpha1.EncryptionAtRestRotation{Trigger: "rotate"},
+			})
+			cluster.Spec.Paused = ptr.To(true)
+			return cluster
+		}(),
+	}, {
+		name: "cluster without topology",
+		cluster: func() *clusterv1.Cluster {
+			cluster := newCluster(t, nil)
+			cluster.Spec.Topology = clusterv1.Topology{}
+			cluster.Annotations = map[string]string{v1alpha1.EncryptionAtRestRotationAnnotationKey: "rotate"}
+			return cluster
+		}(),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t, tt.cluster, newEncryptionSecret(t), newKCP(), newMachine(testStart.Add(-time.Hour)))
+			assert.Equal(t, ctrl.Result{}, env.reconcile())
+			assert.Equal(t, []string{"key1"}, env.aescbcKeys())
+			assert.Nil(t, env.condition())
+		})
+	}
+}
+
+func TestOptions_AddFlags(t *testing.T) {
+	opts := &Options{}
+	opts.AddFlags(pflag.CommandLine)
+
+	assert.True(t, opts.Enabled)
+	assert.Equal(t, 10, opts.Concurrency)
+}
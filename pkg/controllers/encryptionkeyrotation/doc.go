@@ -0,0 +1,25 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package encryptionkeyrotation provides a controller that rotates the encryption at rest keys of a
+// cluster when a rotation is requested, either by the rotation trigger of the encryptionAtRest variable,
+// or by the encryption at rest rotation annotation on the Cluster.
+//
+// The keys are rotated in phases, without downtime:
+// - AddKey adds a new key after the existing keys, and rolls out the control plane
+// - PromoteKey makes the new key the key used to encrypt, and rolls out the control plane
+// - ReEncrypt rewrites the encrypted resources in the workload cluster, so that they are encrypted with the new key
+// - RetireKey removes the old keys, and rolls out the control plane
+//
+// The controller also rolls out the control plane when the encryption configuration of an existing cluster is
+// updated, for example, when resources are added to the encrypted resources.
+//
+// The state of the rotation is stored in annotations on the encryption configuration Secret, so that a
+// failed phase is retried, and the rotation resumes, without changing the keys again.
+//
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters/status,verbs=get;patch
+// +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=kubeadmcontrolplanes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;update;patch
+package encryptionkeyrotation
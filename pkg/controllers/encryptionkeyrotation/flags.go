@@ -0,0 +1,30 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionkeyrotation
+
+import (
+	"github.com/spf13/pflag"
+)
+
+type Options struct {
+	Enabled     bool
+	Concurrency int
+}
+
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	pflag.CommandLine.BoolVar(
+		&o.Enabled,
+		"encryption-key-rotation-enabled",
+		true,
+		"Enable encryption key rotation controller to rotate the encryption at rest keys of clusters when "+
+			"a rotation is requested.",
+	)
+
+	pflag.CommandLine.IntVar(
+		&o.Concurrency,
+		"encryption-key-rotation-concurrency",
+		10,
+		"Number of Clusters to handle concurrently for encryption key rotation.",
+	)
+}
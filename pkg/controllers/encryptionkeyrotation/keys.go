@@ -0,0 +1,155 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionkeyrotation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/generic/mutation/kubeadm/encryptionatrest"
+)
+
+const keyNamePrefix = "key"
+
+// readConfiguration returns the encryption configuration stored in the Secret.
+func readConfiguration(secret *corev1.Secret) (*apiserverv1.EncryptionConfiguration, error) {
+	data, ok := secret.Data[encryptionatrest.SecretKeyForEtcdEncryption]
+	if !ok {
+		return nil, fmt.Errorf(
+			"secret %s/%s does not have key %q",
+			secret.Namespace,
+			secret.Name,
+			encryptionatrest.SecretKeyForEtcdEncryption,
+		)
+	}
+	config := &apiserverv1.EncryptionConfiguration{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal encryption configuration: %w", err)
+	}
+	return config, nil
+}
+
+// writeConfiguration stores the encryption configuration in the Secret.
+func writeConfiguration(secret *corev1.Secret, config *apiserverv1.EncryptionConfiguration) error {
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("unable to marshal encryption configuration to YAML: %w", err)
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[encryptionatrest.SecretKeyForEtcdEncryption] = []byte(strings.TrimSpace(string(data)))
+	return nil
+}
+
+// forEachKeyList calls fn with the keys of every provider that uses keys stored in the configuration.
+// Providers that do not use keys, for example, identity, are skipped.
+func forEachKeyList(config *apiserverv1.EncryptionConfiguration, fn func(keys *[]apiserverv1.Key)) {
+	for i := range config.Resources {
+		for j := range config.Resources[i].Providers {
+			provider := &config.Resources[i].Providers[j]
+			if provider.AESCBC != nil {
+				fn(&provider.AESCBC.Keys)
+			}
+			if provider.Secretbox != nil {
+				fn(&provider.Secretbox.Keys)
+			}
+		}
+	}
+}
+
+// hasKeys returns true if any provider of the configuration uses keys.
+func hasKeys(config *apiserverv1.EncryptionConfiguration) bool {
+	found := false
+	forEachKeyList(config, func(keys *[]apiserverv1.Key) {
+		if len(*keys) > 0 {
+			found = true
+		}
+	})
+	return found
+}
+
+// nextKeyName returns the name of the key that follows the keys of the configuration, for example,
+// "key2" if the configuration has "key1".
+func nextKeyName(config *apiserverv1.EncryptionConfiguration) string {
+	last := 0
+	forEachKeyList(config, func(keys *[]apiserverv1.Key) {
+		for _, key := range *keys {
+			n, err := strconv.Atoi(strings.TrimPrefix(key.Name, keyNamePrefix))
+			if err == nil && strings.HasPrefix(key.Name, keyNamePrefix) && n > last {
+				last = n
+			}
+		}
+	})
+	return keyNamePrefix + strconv.Itoa(last+1)
+}
+
+func keyIndex(keys []apiserverv1.Key, name string) int {
+	return slices.IndexFunc(keys, func(key apiserverv1.Key) bool {
+		return key.Name == name
+	})
+}
+
+// addKey adds a new key with the given name to every provider that uses keys, after the existing keys,
+// so that the key can decrypt, but is not yet used to encrypt. Providers that already have the key are
+// not changed.
+func addKey(
+	config *apiserverv1.EncryptionConfiguration,
+	name string,
+	keyGenerator encryptionatrest.TokenGenerator,
+) error {
+	var err error
+	forEachKeyList(config, func(keys *[]apiserverv1.Key) {
+		if err != nil || keyIndex(*keys, name) >= 0 {
+			return
+		}
+		var token []byte
+		token, err = keyGenerator()
+		if err != nil {
+			err = fmt.Errorf("could not create random encryption token: %w", err)
+			return
+		}
+		*keys = append(*keys, apiserverv1.Key{
+			Name:   name,
+			Secret: base64.StdEncoding.EncodeToString(token),
+		})
+	})
+	return err
+}
+
+// promoteKey moves the key with the given name first, so that it is used to encrypt.
+func promoteKey(config *apiserverv1.EncryptionConfiguration, name string) error {
+	var err error
+	forEachKeyList(config, func(keys *[]apiserverv1.Key) {
+		i := keyIndex(*keys, name)
+		if i < 0 {
+			err = fmt.Errorf("key %q not found in encryption configuration", name)
+			return
+		}
+		key := (*keys)[i]
+		*keys = slices.Insert(slices.Delete(*keys, i, i+1), 0, key)
+	})
+	return err
+}
+
+// retireKeys removes all keys except the key with the given name.
+func retireKeys(config *apiserverv1.EncryptionConfiguration, name string) error {
+	var err error
+	forEachKeyList(config, func(keys *[]apiserverv1.Key) {
+		i := keyIndex(*keys, name)
+		if i < 0 {
+			err = fmt.Errorf("key %q not found in encryption configuration", name)
+			return
+		}
+		*keys = []apiserverv1.Key{(*keys)[i]}
+	})
+	return err
+}
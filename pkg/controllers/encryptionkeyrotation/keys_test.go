@@ -0,0 +1,104 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionkeyrotation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+)
+
+func testTokenGenerator() ([]byte, error) {
+	return []byte("testtoken"), nil
+}
+
+func testConfiguration() *apiserverv1.EncryptionConfiguration {
+	return &apiserverv1.EncryptionConfiguration{
+		Resources: []apiserverv1.ResourceConfiguration{{
+			Resources: []string{"secrets", "configmaps"},
+			Providers: []apiserverv1.ProviderConfiguration{{
+				AESCBC: &apiserverv1.AESConfiguration{
+					Keys: []apiserverv1.Key{{Name: "key1", Secret: "a2V5MQ=="}},
+				},
+				Secretbox: &apiserverv1.SecretboxConfiguration{
+					Keys: []apiserverv1.Key{{Name: "key1", Secret: "a2V5MQ=="}},
+				},
+			}, {
+				Identity: &apiserverv1.IdentityConfiguration{},
+			}},
+		}},
+	}
+}
+
+func keyNames(keys []apiserverv1.Key) []string {
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		names = append(names, key.Name)
+	}
+	return names
+}
+
+func TestReadWriteConfiguration(t *testing.T) {
+	secret := &corev1.Secret{}
+	require.NoError(t, writeConfiguration(secret, testConfiguration()))
+
+	config, err := readConfiguration(secret)
+	require.NoError(t, err)
+	assert.Equal(t, testConfiguration(), config)
+
+	_, err = readConfiguration(&corev1.Secret{})
+	assert.ErrorContains(t, err, `does not have key "config"`)
+}
+
+func TestNextKeyName(t *testing.T) {
+	config := testConfiguration()
+	assert.Equal(t, "key2", nextKeyName(config))
+
+	config.Resources[0].Providers[0].AESCBC.Keys = []apiserverv1.Key{{Name: "key9"}, {Name: "custom"}}
+	assert.Equal(t, "key10", nextKeyName(config))
+
+	assert.Equal(t, "key1", nextKeyName(&apiserverv1.EncryptionConfiguration{}))
+}
+
+func TestHasKeys(t *testing.T) {
+	assert.True(t, hasKeys(testConfiguration()))
+	assert.False(t, hasKeys(&apiserverv1.EncryptionConfiguration{
+		Resources: []apiserverv1.ResourceConfiguration{{
+			Resources: []string{"secrets"},
+			Providers: []apiserverv1.ProviderConfiguration{{Identity: &apiserverv1.IdentityConfiguration{}}},
+		}},
+	}))
+}
+
+func TestRotateKeys(t *testing.T) {
+	config := testConfiguration()
+	providers := config.Resources[0].Providers
+
+	require.NoError(t, addKey(config, "key2", testTokenGenerator))
+	assert.Equal(t, []string{"key1", "key2"}, keyNames(providers[0].AESCBC.Keys))
+	assert.Equal(t, []string{"key1", "key2"}, keyNames(providers[0].Secretbox.Keys))
+	assert.Equal(t, "dGVzdHRva2Vu", providers[0].AESCBC.Keys[1].Secret)
+	assert.Equal(t, &apiserverv1.IdentityConfiguration{}, providers[1].Identity)
+
+	// Adding the key again does not change the configuration.
+	require.NoError(t, addKey(config, "key2", testTokenGenerator))
+	assert.Equal(t, []string{"key1", "key2"}, keyNames(providers[0].AESCBC.Keys))
+
+	require.NoError(t, promoteKey(config, "key2"))
+	assert.Equal(t, []string{"key2", "key1"}, keyNames(providers[0].AESCBC.Keys))
+	assert.Equal(t, []string{"key2", "key1"}, keyNames(providers[0].Secretbox.Keys))
+
+	require.NoError(t, retireKeys(config, "key2"))
+	assert.Equal(t, []string{"key2"}, keyNames(providers[0].AESCBC.Keys))
+	assert.Equal(t, []string{"key2"}, keyNames(providers[0].Secretbox.Keys))
+	assert.Equal(t, "dGVzdHRva2Vu", providers[0].AESCBC.Keys[0].Secret)
+}
+
+func TestRotateKeysMissingKey(t *testing.T) {
+	assert.ErrorContains(t, promoteKey(testConfiguration(), "key2"), `key "key2" not found`)
+	assert.ErrorContains(t, retireKeys(testConfiguration(), "key2"), `key "key2" not found`)
+}
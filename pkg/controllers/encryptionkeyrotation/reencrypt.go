@@ -0,0 +1,105 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionkeyrotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reEncryptPageSize is the number of objects listed at a time when re-encrypting.
+const reEncryptPageSize = 500
+
+// encryptedResources returns the resources of the configuration, without duplicates, in the order they
+// are configured. Resources are in the "<resource>.<group>" format, for example, "deployments.apps", or
+// "<resource>" for the core group.
+func encryptedResources(config *apiserverv1.EncryptionConfiguration) []schema.GroupResource {
+	var resources []schema.GroupResource
+	seen := map[schema.GroupResource]struct{}{}
+	for _, resourceConfig := range config.Resources {
+		for _, resource := range resourceConfig.Resources {
+			gr := schema.ParseGroupResource(resource)
+			if _, ok := seen[gr]; ok {
+				continue
+			}
+			seen[gr] = struct{}{}
+			resources = append(resources, gr)
+		}
+	}
+	return resources
+}
+
+// reEncrypt rewrites every object of the encrypted resources in the workload cluster, so that the API
+// server stores the objects encrypted with the key that is currently used to encrypt.
+func reEncrypt(
+	ctx context.Context,
+	c ctrlclient.Client,
+	config *apiserverv1.EncryptionConfiguration,
+) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	var errs []error
+	for _, gr := range encryptedResources(config) {
+		if strings.Contains(gr.String(), "*") {
+			// The API server encrypts resources matched by wildcards, but the resources cannot be listed
+			// without discovering them first.
+			log.Info("Skipping re-encryption of resources matched by wildcard", "resource", gr.String())
+			continue
+		}
+		if err := reEncryptResource(ctx, c, gr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func reEncryptResource(ctx context.Context, c ctrlclient.Client, gr schema.GroupResource) error {
+	gvk, err := c.RESTMapper().KindFor(gr.WithVersion(""))
+	if err != nil {
+		return fmt.Errorf("failed to find the kind of resource %s: %w", gr, err)
+	}
+
+	continueToken := ""
+	for {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.List(
+			ctx,
+			list,
+			ctrlclient.Limit(reEncryptPageSize),
+			ctrlclient.Continue(continueToken),
+		); err != nil {
+			return fmt.Errorf("failed to list %s: %w", gr, err)
+		}
+		for i := range list.Items {
+			// Updating the object without changes stores it again, encrypted with the current key.
+			err := c.Update(ctx, &list.Items[i])
+			// The object was written since it was listed, so it is already encrypted with the current key.
+			if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf(
+					"failed to re-encrypt %s %s: %w",
+					gr,
+					ctrlclient.ObjectKeyFromObject(&list.Items[i]),
+					err,
+				)
+			}
+		}
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionkeyrotation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func newWorkloadClusterClient(
+	updated *[]string,
+	updateErrs map[string]error,
+	objects ...ctrlclient.Object,
+) ctrlclient.Client {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+
+	return fake.NewClientBuilder().
+		WithScheme(clientgoscheme.Scheme).
+		WithRESTMapper(mapper).
+		WithObjects(objects...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(
+				ctx context.Context,
+				c ctrlclient.WithWatch,
+				obj ctrlclient.Object,
+				opts ...ctrlclient.UpdateOption,
+			) error {
+				name := obj.GetObjectKind().GroupVersionKind().Kind + "/" + obj.GetName()
+				if err, ok := updateErrs[name]; ok {
+					return err
+				}
+				*updated = append(*updated, name)
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+}
+
+func TestEncryptedResources(t *testing.T) {
+	config := &apiserverv1.EncryptionConfiguration{
+		Resources: []apiserverv1.ResourceConfiguration{{
+			Resources: []string{"secrets", "deployments.apps"},
+		}, {
+			Resources: []string{"secrets", "*.batch"},
+		}},
+	}
+	assert.Equal(t, []schema.GroupResource{
+		{Resource: "secrets"},
+		{Group: "apps", Resource: "deployments"},
+		{Group: "batch", Resource: "*"},
+	}, encryptedResources(config))
+}
+
+func TestReEncrypt(t *testing.T) {
+	objects := []ctrlclient.Object{
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "ns1"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "s2", Namespace: "ns2"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "c1", Namespace: "ns1"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "c2", Namespace: "ns1"}},
+	}
+	config := &apiserverv1.EncryptionConfiguration{
+		Resources: []apiserverv1.ResourceConfiguration{{
+			Resources: []string{"secrets", "configmaps", "*.apps"},
+		}},
+	}
+
+	t.Run("updates all objects of the encrypted resources", func(t *testing.T) {
+		var updated []string
+		c := newWorkloadClusterClient(&updated, nil, objects...)
+		require.NoError(t, reEncrypt(context.Background(), c, config))
+		assert.ElementsMatch(t, []string{"Secret/s1", "Secret/s2", "ConfigMap/c1", "ConfigMap/c2"}, updated)
+	})
+
+	t.Run("ignores objects that changed since they were listed", func(t *testing.T) {
+		var updated []string
+		c := newWorkloadClusterClient(&updated, map[string]error{
+			"Secret/s1": apierrors.NewConflict(schema.GroupResource{Resource: "secrets"}, "s1", nil),
+			"Secret/s2": apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "s2"),
+		}, objects...)
+		require.NoError(t, reEncrypt(context.Background(), c, config))
+		assert.ElementsMatch(t, []string{"ConfigMap/c1", "ConfigMap/c2"}, updated)
+	})
+
+	t.Run("returns errors and continues with other resources", func(t *testing.T) {
+		var updated []string
+		c := newWorkloadClusterClient(&updated, map[string]error{
+			"Secret/s1": apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "s1", nil),
+		}, objects...)
+		err := reEncrypt(context.Background(), c, config)
+		assert.ErrorContains(t, err, "failed to re-encrypt secrets ns1/s1")
+		assert.ElementsMatch(t, []string{"ConfigMap/c1", "ConfigMap/c2"}, updated)
+	})
+
+	t.Run("returns an error for unknown resources", func(t *testing.T) {
+		var updated []string
+		c := newWorkloadClusterClient(&updated, nil, objects...)
+		err := reEncrypt(context.Background(), c, &apiserverv1.EncryptionConfiguration{
+			Resources: []apiserverv1.ResourceConfiguration{{Resources: []string{"widgets.example.com"}}},
+		})
+		assert.ErrorContains(t, err, "failed to find the kind of resource widgets.example.com")
+	})
+}
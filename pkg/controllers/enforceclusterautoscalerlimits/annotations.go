@@ -0,0 +1,227 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package enforceclusterautoscalerlimits
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// The annotations that describe the capacity of the nodes of a node group, used by the Cluster Autoscaler to scale
+// the node group from zero when the infrastructure provider does not report the capacity.
+const (
+	capacityAnnotationPrefix = "capacity.cluster-autoscaler.kubernetes.io/"
+
+	capacityCPUAnnotation           = capacityAnnotationPrefix + "cpu"
+	capacityMemoryAnnotation        = capacityAnnotationPrefix + "memory"
+	capacityEphemeralDiskAnnotation = capacityAnnotationPrefix + "ephemeral-disk"
+	capacityMaxPodsAnnotation       = capacityAnnotationPrefix + "maxPods"
+	capacityGPUCountAnnotation      = capacityAnnotationPrefix + "gpu-count"
+	capacityGPUTypeAnnotation       = capacityAnnotationPrefix + "gpu-type"
+	capacityLabelsAnnotation        = capacityAnnotationPrefix + "labels"
+	capacityTaintsAnnotation        = capacityAnnotationPrefix + "taints"
+)
+
+var errMinGreaterThanMax = errors.New("min size is greater than max size")
+
+// limits are the minimum and maximum number of replicas of a node group.
+type limits struct {
+	min int32
+	max int32
+}
+
+// clamp returns the number of replicas nearest to replicas within the limits.
+func (l limits) clamp(replicas int32) int32 {
+	return min(max(replicas, l.min), l.max)
+}
+
+// hasAutoscalerAnnotations returns true if any of the annotations configures the Cluster Autoscaler.
+func hasAutoscalerAnnotations(annotations map[string]string) bool {
+	for key := range annotations {
+		if key == clusterv1.AutoscalerMinSizeAnnotation ||
+			key == clusterv1.AutoscalerMaxSizeAnnotation ||
+			strings.HasPrefix(key, capacityAnnotationPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// limitsFromAnnotations returns the limits encoded in the min and max size annotations. It returns nil if neither
+// annotation exists, and an error if only one exists, if either is not a non-negative integer, or if the min size
+// is greater than the max size.
+func limitsFromAnnotations(annotations map[string]string) (*limits, error) {
+	minReplicas, minErr := minReplicasFromAnnotations(annotations)
+	maxReplicas, maxErr := maxReplicasFromAnnotations(annotations)
+	switch {
+	case errors.Is(minErr, errMissingMinAnnotation) && errors.Is(maxErr, errMissingMaxAnnotation):
+		return nil, nil
+	case minErr != nil:
+		return nil, minErr
+	case maxErr != nil:
+		return nil, maxErr
+	case minReplicas > maxReplicas:
+		return nil, fmt.Errorf("%w: %d > %d", errMinGreaterThanMax, minReplicas, maxReplicas)
+	}
+	return &limits{min: minReplicas, max: maxReplicas}, nil
+}
+
+// validateCapacityAnnotations returns an error for each capacity annotation with a value that the Cluster
+// Autoscaler cannot parse. Invalid capacity annotations do not affect the limits, but prevent the Cluster
+// Autoscaler from scaling the node group from zero.
+func validateCapacityAnnotations(annotations map[string]string) []error {
+	var errs []error
+	for _, key := range []string{
+		capacityCPUAnnotation,
+		capacityMemoryAnnotation,
+		capacityEphemeralDiskAnnotation,
+		capacityGPUCountAnnotation,
+	} {
+		if val, found := annotations[key]; found {
+			if _, err := resource.ParseQuantity(val); err != nil {
+				errs = append(errs, fmt.Errorf("invalid %s annotation: %w", key, err))
+			}
+		}
+	}
+
+	if val, found := annotations[capacityMaxPodsAnnotation]; found {
+		if i, err := strconv.ParseInt(val, 10, 32); err != nil || i < 0 {
+			errs = append(errs, fmt.Errorf(
+				"invalid %s annotation: %q is not a non-negative integer",
+				capacityMaxPodsAnnotation,
+				val,
+			))
+		}
+	}
+
+	if val, found := annotations[capacityGPUTypeAnnotation]; found && val == "" {
+		errs = append(errs, fmt.Errorf("invalid %s annotation: must not be empty", capacityGPUTypeAnnotation))
+	}
+
+	if val, found := annotations[capacityLabelsAnnotation]; found {
+		for _, label := range splitList(val) {
+			key, value, _ := strings.Cut(label, "=")
+			if msgs := append(
+				validation.IsQualifiedName(key),
+				validation.IsValidLabelValue(value)...,
+			); len(msgs) > 0 {
+				errs = append(errs, fmt.Errorf(
+					"invalid %s annotation: label %q: %s",
+					capacityLabelsAnnotation,
+					label,
+					strings.Join(msgs, "; "),
+				))
+			}
+		}
+	}
+
+	if val, found := annotations[capacityTaintsAnnotation]; found {
+		for _, taint := range splitList(val) {
+			if err := validateTaint(taint); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"invalid %s annotation: taint %q: %w",
+					capacityTaintsAnnotation,
+					taint,
+					err,
+				))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateTaint validates a taint in the key=value:effect format used by the Cluster Autoscaler.
+func validateTaint(taint string) error {
+	keyValue, effect, found := strings.Cut(taint, ":")
+	if !found {
+		return errors.New("must be in the key=value:effect format")
+	}
+	switch corev1.TaintEffect(effect) {
+	case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+	default:
+		return fmt.Errorf("unsupported effect %q", effect)
+	}
+	key, value, _ := strings.Cut(keyValue, "=")
+	if msgs := append(validation.IsQualifiedName(key), validation.IsValidLabelValue(value)...); len(msgs) > 0 {
+		return errors.New(strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// splitList splits a comma-separated list, ignoring empty items.
+func splitList(val string) []string {
+	var items []string
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+var (
+	// errMissingMinAnnotation is the error returned when a
+	// machine set does not have an annotation keyed by
+	// nodeGroupMinSizeAnnotationKey.
+	errMissingMinAnnotation = errors.New("missing min annotation")
+
+	// errMissingMaxAnnotation is the error returned when a
+	// machine set does not have an annotation keyed by
+	// nodeGroupMaxSizeAnnotationKey.
+	errMissingMaxAnnotation = errors.New("missing max annotation")
+
+	// errInvalidMinAnnotationValue is the error returned when a
+	// machine set has a non-integral min annotation value.
+	errInvalidMinAnnotation = errors.New("invalid min annotation")
+
+	// errInvalidMaxAnnotationValue is the error returned when a
+	// machine set has a non-integral max annotation value.
+	errInvalidMaxAnnotation = errors.New("invalid max annotation")
+)
+
+// minReplicasFromAnnotations returns the minimum value encoded in the annotations keyed
+// by "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size".
+// Returns errMissingMinAnnotation if the annotation doesn't exist or
+// errInvalidMinAnnotation if the value is not a non-negative int.
+func minReplicasFromAnnotations(annotations map[string]string) (int32, error) {
+	val, found := annotations[clusterv1.AutoscalerMinSizeAnnotation]
+	if !found {
+		return 0, errMissingMinAnnotation
+	}
+	i, err := strconv.ParseInt(val, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errInvalidMinAnnotation, err)
+	}
+	if i < 0 {
+		return 0, fmt.Errorf("%w: %d is negative", errInvalidMinAnnotation, i)
+	}
+	return int32(i), nil
+}
+
+// maxReplicasFromAnnotations returns the maximum value encoded in the annotations keyed
+// by "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size".
+// Returns errMissingMaxAnnotation if the annotation doesn't exist or
+// errInvalidMaxAnnotation if the value is not a non-negative int.
+func maxReplicasFromAnnotations(annotations map[string]string) (int32, error) {
+	val, found := annotations[clusterv1.AutoscalerMaxSizeAnnotation]
+	if !found {
+		return 0, errMissingMaxAnnotation
+	}
+	i, err := strconv.ParseInt(val, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errInvalidMaxAnnotation, err)
+	}
+	if i < 0 {
+		return 0, fmt.Errorf("%w: %d is negative", errInvalidMaxAnnotation, i)
+	}
+	return int32(i), nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package enforceclusterautoscalerlimits
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestLimitsFromAnnotations(t *testing.T) {
+	testCases := []struct {
+		name         string
+		annotations  map[string]string
+		expectLimits *limits
+		expectError  error
+	}{
+		{
+			name: "no annotations",
+		},
+		{
+			name: "min and max",
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "0",
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "3",
+			},
+			expectLimits: &limits{min: 0, max: 3},
+		},
+		{
+			name: "min only",
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "1",
+			},
+			expectError: errMissingMaxAnnotation,
+		},
+		{
+			name: "max only",
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "3",
+			},
+			expectError: errMissingMinAnnotation,
+		},
+		{
+			name: "malformed min",
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "one",
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "3",
+			},
+			expectError: errInvalidMinAnnotation,
+		},
+		{
+			name: "negative max",
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "0",
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "-1",
+			},
+			expectError: errInvalidMaxAnnotation,
+		},
+		{
+			name: "min greater than max",
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "7",
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "3",
+			},
+			expectError: errMinGreaterThanMax,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			l, err := limitsFromAnnotations(tc.annotations)
+			if tc.expectError != nil {
+				g.Expect(err).To(MatchError(tc.expectError))
+				g.Expect(l).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(l).To(Equal(tc.expectLimits))
+		})
+	}
+}
+
+func TestLimitsClamp(t *testing.T) {
+	g := NewWithT(t)
+
+	l := limits{min: 2, max: 5}
+	g.Expect(l.clamp(0)).To(Equal(int32(2)))
+	g.Expect(l.clamp(3)).To(Equal(int32(3)))
+	g.Expect(l.clamp(8)).To(Equal(int32(5)))
+}
+
+func TestValidateCapacityAnnotations(t *testing.T) {
+	testCases := []struct {
+		name         string
+		annotations  map[string]string
+		expectErrors []string
+	}{
+		{
+			name: "valid",
+			annotations: map[string]string{
+				capacityCPUAnnotation:           "8",
+				capacityMemoryAnnotation:        "8112564Ki",
+				capacityEphemeralDiskAnnotation: "100Gi",
+				capacityMaxPodsAnnotation:       "110",
+				capacityGPUCountAnnotation:      "1",
+				capacityGPUTypeAnnotation:       "nvidia.com/gpu",
+				capacityLabelsAnnotation:        "node-restriction.kubernetes.io/my-app=,tier=gpu",
+				capacityTaintsAnnotation:        "mytaint=tainted:NoSchedule,dedicated:NoExecute",
+			},
+		},
+		{
+			name: "invalid",
+			annotations: map[string]string{
+				capacityCPUAnnotation:     "eight",
+				capacityMemoryAnnotation:  "8GB",
+				capacityMaxPodsAnnotation: "-1",
+				capacityGPUTypeAnnotation: "",
+				capacityLabelsAnnotation:  "tier=gpu,-invalid=",
+				capacityTaintsAnnotation:  "mytaint=tainted,other=tainted:Sometimes",
+			},
+			expectErrors: []string{
+				"invalid capacity.cluster-autoscaler.kubernetes.io/cpu annotation",
+				"invalid capacity.cluster-autoscaler.kubernetes.io/memory annotation",
+				"invalid capacity.cluster-autoscaler.kubernetes.io/maxPods annotation",
+				"invalid capacity.cluster-autoscaler.kubernetes.io/gpu-type annotation",
+				`invalid capacity.cluster-autoscaler.kubernetes.io/labels annotation: label "-invalid="`,
+				`invalid capacity.cluster-autoscaler.kubernetes.io/taints annotation: taint "mytaint=tainted"`,
+				`invalid capacity.cluster-autoscaler.kubernetes.io/taints annotation: taint "other=tainted:Sometimes"`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs := validateCapacityAnnotations(tc.annotations)
+			g.Expect(errs).To(HaveLen(len(tc.expectErrors)))
+			for i := range tc.expectErrors {
+				g.Expect(errs[i]).To(MatchError(ContainSubstring(tc.expectErrors[i])))
+			}
+		})
+	}
+}
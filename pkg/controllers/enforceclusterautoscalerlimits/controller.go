@@ -6,28 +6,71 @@ package enforceclusterautoscalerlimits
 import (
 	context "context"
 	fmt "fmt"
-	"strconv"
+	"strings"
 
-	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+// Reasons of the Events recorded on MachineDeployments, MachinePools and Clusters.
+const (
+	reasonReplicasClamped    = "ReplicasClamped"
+	reasonInvalidAnnotations = "InvalidClusterAutoscalerAnnotations"
 )
 
 type Reconciler struct {
 	client.Client
+
+	// Recorder records Events for clamped replicas and invalid annotations.
+	Recorder record.EventRecorder
+}
+
+// conditionsObject is an object with conditions in its status.
+type conditionsObject interface {
+	client.Object
+	GetConditions() []metav1.Condition
+	SetConditions([]metav1.Condition)
 }
 
 func (r *Reconciler) SetupWithManager(
 	mgr ctrl.Manager,
 	options *controller.Options,
 ) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	if r.Recorder == nil {
+		return fmt.Errorf("Recorder must be defined to use controller")
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&clusterv1.MachineDeployment{}).
 		WithOptions(*options).
-		Complete(r)
+		Complete(r); err != nil {
+		return err
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&clusterv1.MachinePool{}).
+		Named("enforceclusterautoscalerlimits-machinepool").
+		WithOptions(*options).
+		Complete(reconcile.Func(r.reconcileMachinePool)); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&clusterv1.Cluster{}).
+		Named("enforceclusterautoscalerlimits-cluster").
+		WithOptions(*options).
+		Complete(reconcile.Func(r.reconcileCluster))
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -43,106 +86,221 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, fmt.Errorf("failed to get MachineDeployment %s: %w", req.NamespacedName, err)
 	}
 
-	// If replicas is not set, we don't need to do anything.
-	if md.Spec.Replicas == nil {
-		logger.V(5).Info("MachineDeployment has no replicas set, skipping reconciliation")
-		return ctrl.Result{}, nil
+	if err := r.enforceLimits(ctrl.LoggerInto(ctx, logger), &md, md.Spec.Replicas); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to enforce limits on MachineDeployment %s: %w", req.NamespacedName, err)
 	}
 
-	minReplicas, err := minReplicasFromAnnotations(md.Annotations)
-	if err != nil {
-		// Do nothing if the minSize annotation is missing.
-		if errors.Is(err, errMissingMinAnnotation) {
-			logger.V(5).Info("MachineDeployment has no min size annotation, skipping reconciliation")
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) reconcileMachinePool(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx).WithValues("machinePool", req.NamespacedName)
+
+	var mp clusterv1.MachinePool
+	if err := r.Get(ctx, req.NamespacedName, &mp); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(5).Info("MachinePool not found, skipping reconciliation")
 			return ctrl.Result{}, nil
 		}
 
-		return ctrl.Result{}, fmt.Errorf("failed to get min size: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to get MachinePool %s: %w", req.NamespacedName, err)
 	}
 
-	maxReplicas, err := maxReplicasFromAnnotations(md.Annotations)
-	if err != nil {
-		// Do nothing if the maxSize annotation is missing.
-		if errors.Is(err, errMissingMaxAnnotation) {
-			logger.V(5).Info("MachineDeployment has no max size annotation, skipping reconciliation")
+	if err := r.enforceLimits(ctrl.LoggerInto(ctx, logger), &mp, mp.Spec.Replicas); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to enforce limits on MachinePool %s: %w", req.NamespacedName, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// enforceLimits reports the validity of the Cluster Autoscaler annotations of the MachineDeployment or MachinePool,
+// and scales its replicas to the nearest limit if they are outside the limits.
+func (r *Reconciler) enforceLimits(ctx context.Context, obj conditionsObject, replicas *int32) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	from, clamped, errs := clampReplicas(obj.GetAnnotations(), replicas)
+	if clamped {
+		if err := r.Update(ctx, obj); err != nil {
+			return err
+		}
+		logger.Info("Scaled replicas to respect the Cluster Autoscaler limits", "from", from, "to", *replicas)
+		r.Recorder.Eventf(
+			obj,
+			corev1.EventTypeNormal,
+			reasonReplicasClamped,
+			"Scaled from %d to %d replicas to respect the Cluster Autoscaler limits",
+			from,
+			*replicas,
+		)
+	}
+
+	r.reportAnnotations(ctx, obj, hasAutoscalerAnnotations(obj.GetAnnotations()), errs)
+	return nil
+}
+
+func (r *Reconciler) reconcileCluster(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx).WithValues("cluster", req.NamespacedName)
+	ctx = ctrl.LoggerInto(ctx, logger)
+
+	var cluster clusterv1.Cluster
+	if err := r.Get(ctx, req.NamespacedName, &cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(5).Info("Cluster not found, skipping reconciliation")
 			return ctrl.Result{}, nil
 		}
 
-		return ctrl.Result{}, fmt.Errorf("failed to get max size: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to get Cluster %s: %w", req.NamespacedName, err)
 	}
 
-	if minReplicas > maxReplicas {
-		logger.WithValues("minReplicas", minReplicas, "maxReplicas", maxReplicas).
-			Info("Min replicas is greater than max replicas - skipping reconciliation")
+	if !cluster.Spec.Topology.IsDefined() {
+		logger.V(5).Info("Cluster has no topology, skipping reconciliation")
 		return ctrl.Result{}, nil
 	}
 
-	// If the current replicas are within the bounds, do nothing.
-	if int(*md.Spec.Replicas) >= minReplicas && int(*md.Spec.Replicas) <= maxReplicas {
-		return ctrl.Result{}, nil
+	var (
+		hasAnnotations bool
+		errs           []error
+		events         []string
+	)
+	enforce := func(kind, name string, annotations map[string]string, replicas *int32) {
+		hasAnnotations = hasAnnotations || hasAutoscalerAnnotations(annotations)
+		from, clamped, topologyErrs := clampReplicas(annotations, replicas)
+		for _, err := range topologyErrs {
+			errs = append(errs, fmt.Errorf("%s %s: %w", kind, name, err))
+		}
+		if clamped {
+			events = append(events, fmt.Sprintf(
+				"Scaled %s %s from %d to %d replicas to respect the Cluster Autoscaler limits",
+				kind,
+				name,
+				from,
+				*replicas,
+			))
+		}
 	}
 
-	// Otherwise set replicas to nil and depend on CAPI MachineDeployment defaulting to handle
-	// the scaling correctly.
-	// See https://github.com/kubernetes-sigs/cluster-api/blob/v1.10.3/internal/webhooks/machinedeployment.go#L365
-	// for more details.
-	md.Spec.Replicas = nil
+	workers := &cluster.Spec.Topology.Workers
+	for i := range workers.MachineDeployments {
+		md := &workers.MachineDeployments[i]
+		enforce("MachineDeployment topology", md.Name, md.Metadata.Annotations, md.Replicas)
+	}
+	for i := range workers.MachinePools {
+		mp := &workers.MachinePools[i]
+		enforce("MachinePool topology", mp.Name, mp.Metadata.Annotations, mp.Replicas)
+	}
 
-	if err := r.Update(ctx, &md); err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to update MachineDeployment %s: %w", req.NamespacedName, err)
+	if len(events) > 0 {
+		if err := r.Update(ctx, &cluster); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update Cluster %s: %w", req.NamespacedName, err)
+		}
+		for _, event := range events {
+			logger.Info(event)
+			r.Recorder.Event(&cluster, corev1.EventTypeNormal, reasonReplicasClamped, event)
+		}
 	}
 
+	r.reportAnnotations(ctx, &cluster, hasAnnotations, errs)
 	return ctrl.Result{}, nil
 }
 
-var (
-	// errMissingMinAnnotation is the error returned when a
-	// machine set does not have an annotation keyed by
-	// nodeGroupMinSizeAnnotationKey.
-	errMissingMinAnnotation = errors.New("missing min annotation")
+// clampReplicas validates the Cluster Autoscaler annotations and, if the limits are valid, scales the replicas to the
+// nearest limit. It returns the replicas before scaling, whether they were scaled, and the errors of the invalid
+// annotations. Replicas that are not set are managed by the Cluster Autoscaler and are not scaled.
+func clampReplicas(annotations map[string]string, replicas *int32) (int32, bool, []error) {
+	errs := validateCapacityAnnotations(annotations)
+	l, err := limitsFromAnnotations(annotations)
+	if err != nil {
+		errs = append([]error{err}, errs...)
+	}
+	if l == nil || replicas == nil {
+		return 0, false, errs
+	}
 
-	// errMissingMaxAnnotation is the error returned when a
-	// machine set does not have an annotation keyed by
-	// nodeGroupMaxSizeAnnotationKey.
-	errMissingMaxAnnotation = errors.New("missing max annotation")
+	from := *replicas
+	*replicas = l.clamp(from)
+	return from, *replicas != from, errs
+}
 
-	// errInvalidMinAnnotationValue is the error returned when a
-	// machine set has a non-integral min annotation value.
-	errInvalidMinAnnotation = errors.New("invalid min annotation")
+// reportAnnotations sets the condition that reports the validity of the Cluster Autoscaler annotations, and records
+// a Warning Event when the annotations become invalid or the errors change. The condition is removed if there are
+// no Cluster Autoscaler annotations.
+func (r *Reconciler) reportAnnotations(ctx context.Context, obj conditionsObject, hasAnnotations bool, errs []error) {
+	if !hasAnnotations {
+		r.setCondition(ctx, obj, nil)
+		return
+	}
 
-	// errInvalidMaxAnnotationValue is the error returned when a
-	// machine set has a non-integral max annotation value.
-	errInvalidMaxAnnotation = errors.New("invalid max annotation")
-)
+	if len(errs) == 0 {
+		r.setCondition(ctx, obj, &metav1.Condition{
+			Type:   v1alpha1.ClusterAutoscalerAnnotationsValidCondition,
+			Status: metav1.ConditionTrue,
+			Reason: v1alpha1.ClusterAutoscalerAnnotationsValidReason,
+		})
+		return
+	}
 
-// minReplicasFromAnnotations returns the minimum value encoded in the annotations keyed
-// by "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size".
-// Returns errMissingMinAnnotation if the annotation doesn't exist or
-// errInvalidMinAnnotation if the value is not of type int.
-func minReplicasFromAnnotations(annotations map[string]string) (int, error) {
-	val, found := annotations[clusterv1.AutoscalerMinSizeAnnotation]
-	if !found {
-		return 0, errMissingMinAnnotation
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
 	}
-	i, err := strconv.Atoi(val)
-	if err != nil {
-		return 0, fmt.Errorf("%w: %v", errInvalidMinAnnotation, err)
+	message := strings.Join(msgs, "; ")
+
+	existing := meta.FindStatusCondition(obj.GetConditions(), v1alpha1.ClusterAutoscalerAnnotationsValidCondition)
+	if existing == nil || existing.Status != metav1.ConditionFalse || existing.Message != message {
+		r.Recorder.Eventf(
+			obj,
+			corev1.EventTypeWarning,
+			reasonInvalidAnnotations,
+			"Invalid Cluster Autoscaler annotations: %s",
+			message,
+		)
 	}
-	return i, nil
+
+	r.setCondition(ctx, obj, &metav1.Condition{
+		Type:    v1alpha1.ClusterAutoscalerAnnotationsValidCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.ClusterAutoscalerAnnotationsInvalidReason,
+		Message: message,
+	})
 }
 
-// maxReplicasFromAnnotations returns the maximum value encoded in the annotations keyed
-// by "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size".
-// Returns errMissingMaxAnnotation if the annotation doesn't exist or
-// errInvalidMaxAnnotation if the value is not of type int.
-func maxReplicasFromAnnotations(annotations map[string]string) (int, error) {
-	val, found := annotations[clusterv1.AutoscalerMaxSizeAnnotation]
-	if !found {
-		return 0, errMissingMaxAnnotation
-	}
-	i, err := strconv.Atoi(val)
+// setCondition sets the condition on the object, or removes the Cluster Autoscaler annotations condition if condition
+// is nil. Failures are logged, because the condition is set again on the next reconciliation.
+func (r *Reconciler) setCondition(ctx context.Context, obj conditionsObject, condition *metav1.Condition) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, ok := obj.DeepCopyObject().(conditionsObject)
+		if !ok {
+			return fmt.Errorf("unexpected type %T", obj)
+		}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+			return err
+		}
+		original, _ := latest.DeepCopyObject().(client.Object)
+
+		conditions := latest.GetConditions()
+		var changed bool
+		if condition == nil {
+			changed = meta.RemoveStatusCondition(&conditions, v1alpha1.ClusterAutoscalerAnnotationsValidCondition)
+		} else {
+			condition.ObservedGeneration = latest.GetGeneration()
+			changed = meta.SetStatusCondition(&conditions, *condition)
+		}
+		if !changed {
+			return nil
+		}
+		latest.SetConditions(conditions)
+
+		return r.Status().Patch(
+			ctx,
+			latest,
+			client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}),
+		)
+	})
 	if err != nil {
-		return 0, fmt.Errorf("%w: %v", errInvalidMaxAnnotation, err)
+		ctrl.LoggerFrom(ctx).Error(
+			err,
+			"failed to set Cluster Autoscaler annotations condition",
+			"kind", fmt.Sprintf("%T", obj),
+		)
 	}
-	return i, nil
 }
@@ -283,7 +283,7 @@ func TestReconcileMachineDeploymentWithReplicasLessThanMinBound(t *testing.T) {
 		return verifyMachineDeploymentReplicas(
 			env.Client,
 			client.ObjectKeyFromObject(sourceMachineDeployment),
-			nil,
+			ptr.To[int32](7),
 		)
 	},
 		timeout,
@@ -322,7 +322,7 @@ func TestReconcileMachineDeploymentWithReplicasMoreThanMaxBound(t *testing.T) {
 		return verifyMachineDeploymentReplicas(
 			env.Client,
 			client.ObjectKeyFromObject(sourceMachineDeployment),
-			nil,
+			ptr.To[int32](12),
 		)
 	},
 		timeout,
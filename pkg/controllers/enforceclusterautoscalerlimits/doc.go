@@ -2,7 +2,15 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // Package enforceclusterautoscalerlimits provides a controller that enforces Cluster Autoscaler
-// limits on MachineDeployments.
+// limits on MachineDeployments, MachinePools, and the MachineDeployments and MachinePools of
+// Cluster topologies.
 //
-// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments,verbs=get;list;watch;update;patch
+// Replicas outside the limits set by the min and max size annotations are scaled to the nearest
+// limit. The validity of the Cluster Autoscaler annotations, including the capacity annotations
+// used to scale from zero, is reported by the ClusterAutoscalerAnnotationsValid condition and by
+// Events.
+//
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments;machinepools;clusters,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments/status;machinepools/status;clusters/status,verbs=get;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 package enforceclusterautoscalerlimits
@@ -17,13 +17,13 @@ func (o *Options) AddFlags(flags *pflag.FlagSet) {
 		&o.Enabled,
 		"enforce-clusterautoscaler-limits-enabled",
 		false,
-		"Enable enforcing cluster-autoscaler limits on MachineDeployments.",
+		"Enable enforcing cluster-autoscaler limits on MachineDeployments, MachinePools and Cluster topologies.",
 	)
 
 	pflag.CommandLine.IntVar(
 		&o.Concurrency,
 		"enforce-clusterautoscaler-limits-concurrency",
 		10,
-		"Number of MachineDeployments to handle concurrently.",
+		"Number of MachineDeployments, MachinePools and Clusters to handle concurrently.",
 	)
 }
@@ -0,0 +1,222 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package enforceclusterautoscalerlimits
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func newFakeReconciler(g *WithT, objs ...client.Object) (*Reconciler, *record.FakeRecorder) {
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1beta2.AddToScheme(scheme)).To(Succeed())
+	recorder := record.NewFakeRecorder(10)
+	return &Reconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(objs...).
+			Build(),
+		Recorder: recorder,
+	}, recorder
+}
+
+func TestReconcileMachinePool(t *testing.T) {
+	testCases := []struct {
+		name             string
+		replicas         *int32
+		annotations      map[string]string
+		expectReplicas   *int32
+		expectCondition  *metav1.ConditionStatus
+		expectEventCount int
+	}{
+		{
+			name:     "no annotations",
+			replicas: ptr.To[int32](5),
+			annotations: map[string]string{
+				"other": "",
+			},
+			expectReplicas: ptr.To[int32](5),
+		},
+		{
+			name:     "replicas less than min",
+			replicas: ptr.To[int32](1),
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "3",
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "5",
+			},
+			expectReplicas:   ptr.To[int32](3),
+			expectCondition:  ptr.To(metav1.ConditionTrue),
+			expectEventCount: 1,
+		},
+		{
+			name: "replicas not set",
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "3",
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "5",
+			},
+			expectCondition: ptr.To(metav1.ConditionTrue),
+		},
+		{
+			name:     "min greater than max",
+			replicas: ptr.To[int32](1),
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "5",
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "3",
+			},
+			expectReplicas:   ptr.To[int32](1),
+			expectCondition:  ptr.To(metav1.ConditionFalse),
+			expectEventCount: 1,
+		},
+		{
+			name:     "invalid capacity annotation",
+			replicas: ptr.To[int32](8),
+			annotations: map[string]string{
+				clusterv1beta2.AutoscalerMinSizeAnnotation: "0",
+				clusterv1beta2.AutoscalerMaxSizeAnnotation: "5",
+				capacityMemoryAnnotation:                   "8GB",
+			},
+			expectReplicas:   ptr.To[int32](5),
+			expectCondition:  ptr.To(metav1.ConditionFalse),
+			expectEventCount: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			mp := &clusterv1beta2.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   metav1.NamespaceDefault,
+					Name:        "test-mp",
+					Annotations: tc.annotations,
+				},
+				Spec: clusterv1beta2.MachinePoolSpec{
+					Replicas: tc.replicas,
+				},
+			}
+			r, recorder := newFakeReconciler(g, mp)
+
+			_, err := r.reconcileMachinePool(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mp)})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(r.Get(ctx, client.ObjectKeyFromObject(mp), mp)).To(Succeed())
+			g.Expect(mp.Spec.Replicas).To(Equal(tc.expectReplicas))
+
+			condition := meta.FindStatusCondition(
+				mp.GetConditions(),
+				v1alpha1.ClusterAutoscalerAnnotationsValidCondition,
+			)
+			if tc.expectCondition == nil {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Status).To(Equal(*tc.expectCondition))
+			}
+			g.Expect(recorder.Events).To(HaveLen(tc.expectEventCount))
+
+			// A second reconciliation does not change anything, nor record the same Events again.
+			_, err = r.reconcileMachinePool(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(mp)})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(recorder.Events).To(HaveLen(tc.expectEventCount))
+		})
+	}
+}
+
+func TestReconcileClusterTopology(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	cluster := &clusterv1beta2.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "test",
+		},
+		Spec: clusterv1beta2.ClusterSpec{
+			Topology: clusterv1beta2.Topology{
+				ClassRef: clusterv1beta2.ClusterClassRef{Name: "test"},
+				Version:  "v1.33.0",
+				Workers: clusterv1beta2.WorkersTopology{
+					MachineDeployments: []clusterv1beta2.MachineDeploymentTopology{
+						{
+							Name:     "md-above-max",
+							Replicas: ptr.To[int32](10),
+							Metadata: clusterv1beta2.ObjectMeta{
+								Annotations: map[string]string{
+									clusterv1beta2.AutoscalerMinSizeAnnotation: "1",
+									clusterv1beta2.AutoscalerMaxSizeAnnotation: "3",
+								},
+							},
+						},
+						{
+							Name: "md-autoscaled",
+							Metadata: clusterv1beta2.ObjectMeta{
+								Annotations: map[string]string{
+									clusterv1beta2.AutoscalerMinSizeAnnotation: "0",
+									clusterv1beta2.AutoscalerMaxSizeAnnotation: "3",
+								},
+							},
+						},
+						{
+							Name:     "md-unmanaged",
+							Replicas: ptr.To[int32](10),
+						},
+					},
+					MachinePools: []clusterv1beta2.MachinePoolTopology{
+						{
+							Name:     "mp-invalid",
+							Replicas: ptr.To[int32](10),
+							Metadata: clusterv1beta2.ObjectMeta{
+								Annotations: map[string]string{
+									clusterv1beta2.AutoscalerMinSizeAnnotation: "1",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	r, recorder := newFakeReconciler(g, cluster)
+
+	_, err := r.reconcileCluster(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cluster)})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(r.Get(ctx, client.ObjectKeyFromObject(cluster), cluster)).To(Succeed())
+	workers := cluster.Spec.Topology.Workers
+	g.Expect(workers.MachineDeployments[0].Replicas).To(Equal(ptr.To[int32](3)))
+	g.Expect(workers.MachineDeployments[1].Replicas).To(BeNil())
+	g.Expect(workers.MachineDeployments[2].Replicas).To(Equal(ptr.To[int32](10)))
+	g.Expect(workers.MachinePools[0].Replicas).To(Equal(ptr.To[int32](10)))
+
+	condition := meta.FindStatusCondition(
+		cluster.GetConditions(),
+		v1alpha1.ClusterAutoscalerAnnotationsValidCondition,
+	)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Message).To(ContainSubstring("MachinePool topology mp-invalid: missing max annotation"))
+
+	g.Expect(recorder.Events).To(HaveLen(2))
+	g.Expect(<-recorder.Events).To(Equal(
+		"Normal ReplicasClamped Scaled MachineDeployment topology md-above-max from 10 to 3 replicas " +
+			"to respect the Cluster Autoscaler limits",
+	))
+	g.Expect(<-recorder.Events).To(HavePrefix("Warning InvalidClusterAutoscalerAnnotations"))
+}
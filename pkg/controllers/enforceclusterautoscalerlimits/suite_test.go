@@ -38,7 +38,8 @@ func TestMain(m *testing.M) {
 
 	setupReconcilers := func(ctx context.Context, mgr ctrl.Manager) {
 		if err := (&Reconciler{
-			Client: mgr.GetClient(),
+			Client:   mgr.GetClient(),
+			Recorder: mgr.GetEventRecorderFor("enforceclusterautoscalerlimits"),
 		}).SetupWithManager(mgr, &controller.Options{MaxConcurrentReconciles: 1}); err != nil {
 			panic(fmt.Sprintf("unable to create reconciler: %v", err))
 		}
@@ -5,10 +5,18 @@ package namespacesync
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -17,6 +25,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 )
 
 type Reconciler struct {
@@ -26,20 +36,39 @@ type Reconciler struct {
 	// optimizing reads of provider-specific resources.
 	UnstructuredCachingClient client.Client
 
-	// SourceClusterClassNamespace is the namespace from which ClusterClasses are copied.
-	SourceClusterClassNamespace string
+	// Sources are the namespaces from which ClusterClasses are copied, and the namespaces to which they are
+	// copied. A namespace that is a target of multiple sources receives copies from all of them.
+	Sources []Source
+
+	// PruneUnmatchedNamespaces enables deleting the copies of ClusterClasses and Templates from namespaces
+	// that no longer match the TargetNamespaceSelector of any source.
+	PruneUnmatchedNamespaces bool
+
+	// ResyncPeriod is the interval at which namespaces are reconciled again, to propagate changes to the
+	// Templates in the source namespace, which are not watched. Zero disables periodic reconciles.
+	ResyncPeriod time.Duration
 
-	// TargetNamespaceSelector is a label selector to determine which namespaces should receive
-	// copies of ClusterClasses and Templates from the source namespace.
-	TargetNamespaceSelector labels.Selector
+	// Recorder records Events on target namespaces and source ClusterClasses.
+	Recorder record.EventRecorder
 }
 
+// maxParallelCopies is the maximum number of ClusterClasses copied in parallel to a namespace.
+const maxParallelCopies = 5
+
 func (r *Reconciler) SetupWithManager(
 	mgr ctrl.Manager,
 	options *controller.Options,
 ) error {
-	if r.TargetNamespaceSelector == nil {
-		return fmt.Errorf("TargetNamespaceSelector must be defined to use controller")
+	if len(r.Sources) == 0 {
+		return fmt.Errorf("Sources must be defined to use controller")
+	}
+	for i := range r.Sources {
+		if r.Sources[i].TargetNamespaceSelector == nil {
+			return fmt.Errorf("TargetNamespaceSelector of every source must be defined to use controller")
+		}
+	}
+	if r.Recorder == nil {
+		return fmt.Errorf("Recorder must be defined to use controller")
 	}
 
 	err := ctrl.NewControllerManagedBy(mgr).
@@ -53,7 +82,7 @@ func (r *Reconciler) SetupWithManager(
 						if !ok {
 							return false
 						}
-						return r.TargetNamespaceSelector.Matches(labels.Set(ns.GetLabels()))
+						return r.isTarget(ns)
 					},
 					UpdateFunc: func(e event.UpdateEvent) bool {
 						// Called when an object is already in the cache, and it is either updated,
@@ -67,10 +96,23 @@ func (r *Reconciler) SetupWithManager(
 							return false
 						}
 						// Only reconcile the namespace if the answer to the question "Is this a
-						// target namespace?" changed from no to yes.
-						matchesOld := r.TargetNamespaceSelector.Matches(labels.Set(nsOld.GetLabels()))
-						matchesNew := r.TargetNamespaceSelector.Matches(labels.Set(nsNew.GetLabels()))
-						return !matchesOld && matchesNew
+						// target namespace?" changed from no to yes, or from yes to no if the copies
+						// are pruned from namespaces that no longer match, or if the sources or the
+						// ClusterClasses that the target namespace receives changed.
+						matchesOld := r.isTarget(nsOld)
+						matchesNew := r.isTarget(nsNew)
+						if r.PruneUnmatchedNamespaces && matchesOld && !matchesNew {
+							return true
+						}
+						if !matchesNew {
+							return false
+						}
+						if !matchesOld {
+							return true
+						}
+						return !slices.Equal(r.sourcesFor(nsOld), r.sourcesFor(nsNew)) ||
+							nsOld.Annotations[v1alpha1.NamespaceSyncClusterClassesAnnotationKey] !=
+								nsNew.Annotations[v1alpha1.NamespaceSyncClusterClassesAnnotationKey]
 					},
 					DeleteFunc: func(e event.DeleteEvent) bool {
 						// Ignore deletes.
@@ -95,29 +137,62 @@ func (r *Reconciler) SetupWithManager(
 	return nil
 }
 
+// clusterClassToNamespaces returns the target namespaces of the sources in the namespace of the ClusterClass.
 func (r *Reconciler) clusterClassToNamespaces(ctx context.Context, o client.Object) []ctrl.Request {
-	namespaceList := &corev1.NamespaceList{}
-	err := r.Client.List(ctx, namespaceList, &client.ListOptions{
-		LabelSelector: r.TargetNamespaceSelector,
-	})
-	if err != nil {
-		// TODO Log the error, and record an Event.
-		return nil
+	namespaces := sets.New[string]()
+	for i := range r.Sources {
+		source := &r.Sources[i]
+		if source.Namespace != o.GetNamespace() {
+			continue
+		}
+
+		namespaceList := &corev1.NamespaceList{}
+		err := r.Client.List(ctx, namespaceList, &client.ListOptions{
+			LabelSelector: source.TargetNamespaceSelector,
+		})
+		if err != nil {
+			ctrl.LoggerFrom(ctx).Error(
+				err,
+				"failed to list target namespaces",
+				"clusterClass",
+				client.ObjectKeyFromObject(o),
+			)
+			r.Recorder.Eventf(o, corev1.EventTypeWarning, reasonSyncFailed, "Failed to list target namespaces: %v", err)
+			continue
+		}
+
+		for j := range namespaceList.Items {
+			namespaces.Insert(namespaceList.Items[j].Name)
+		}
 	}
 
-	// Pre-allocate slice with exact capacity since we're using label selector
-	rs := make([]ctrl.Request, 0, len(namespaceList.Items))
-	for i := range namespaceList.Items {
-		ns := &namespaceList.Items[i]
+	rs := make([]ctrl.Request, 0, namespaces.Len())
+	for _, namespace := range sets.List(namespaces) {
 		rs = append(rs,
 			ctrl.Request{
-				NamespacedName: client.ObjectKeyFromObject(ns),
+				NamespacedName: client.ObjectKey{Name: namespace},
 			},
 		)
 	}
 	return rs
 }
 
+// isTarget returns true if the namespace is a target namespace of any source.
+func (r *Reconciler) isTarget(ns *corev1.Namespace) bool {
+	return len(r.sourcesFor(ns)) > 0
+}
+
+// sourcesFor returns the indexes of the sources of which the namespace is a target namespace.
+func (r *Reconciler) sourcesFor(ns *corev1.Namespace) []int {
+	var indexes []int
+	for i := range r.Sources {
+		if r.Sources[i].matchesTarget(ns.GetLabels()) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
 func (r *Reconciler) Reconcile(
 	ctx context.Context,
 	req ctrl.Request,
@@ -127,16 +202,176 @@ func (r *Reconciler) Reconcile(
 ) {
 	namespace := req.Name
 
-	sccs, err := r.listSourceClusterClasses(ctx)
+	ns := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, req.NamespacedName, ns); err != nil {
+		// The copies are deleted with the namespace.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !ns.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	sources := r.sourcesFor(ns)
+	if len(sources) == 0 {
+		if !r.PruneUnmatchedNamespaces {
+			return ctrl.Result{}, nil
+		}
+		return r.reconcileUnmatchedNamespace(ctx, ns)
+	}
+
+	// Continue past failures, so that one ClusterClass that fails to copy does not block the others.
+	sccs, conflicts, errs := r.selectSourceClusterClasses(ctx, ns, sources)
+
+	results, err := r.copyClusterClasses(ctx, sccs, namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	results = append(results, conflicts...)
+
+	synced := make([]*clusterv1.ClusterClass, 0, len(results))
+	for i := range results {
+		result := &results[i]
+		if errors.Is(result.err, errNotACopy) || errors.Is(result.err, errSourceConflict) {
+			// Copying again does not resolve the conflict, so it is only reported, and does not block pruning.
+			r.Recorder.Eventf(
+				ns,
+				corev1.EventTypeWarning,
+				reasonConflict,
+				"Did not copy ClusterClass %s: %v",
+				client.ObjectKeyFromObject(result.source),
+				result.err,
+			)
+			r.Recorder.Eventf(
+				result.source,
+				corev1.EventTypeWarning,
+				reasonConflict,
+				"Not copied to namespace %s: %v",
+				namespace,
+				result.err,
+			)
+			continue
+		}
+		if result.err != nil {
+			r.Recorder.Eventf(
+				ns,
+				corev1.EventTypeWarning,
+				reasonSyncFailed,
+				"Failed to copy ClusterClass %s: %v",
+				client.ObjectKeyFromObject(result.source),
+				result.err,
+			)
+			r.Recorder.Eventf(
+				result.source,
+				corev1.EventTypeWarning,
+				reasonSyncFailed,
+				"Failed to copy to namespace %s: %v",
+				namespace,
+				result.err,
+			)
+			errs = append(errs, fmt.Errorf(
+				"failed to copy source ClusterClass %s or its referenced Templates to namespace %s: %w",
+				client.ObjectKeyFromObject(result.source),
+				namespace,
+				result.err,
+			))
+			continue
+		}
+		synced = append(synced, result.target)
+	}
+
+	// Do not prune the copies if any ClusterClass failed to copy, because their copies are not known to be up to
+	// date, and would be deleted.
+	pruned := false
+	if len(errs) == 0 {
+		var err error
+		_, pruned, err = pruneCopies(ctx, r.UnstructuredCachingClient, r.Client, namespace, synced)
+		if err != nil {
+			r.Recorder.Eventf(ns, corev1.EventTypeWarning, reasonPruneFailed, "Failed to delete stale copies: %v", err)
+			errs = append(errs, fmt.Errorf("failed to prune copies from namespace %s: %w", namespace, err))
+		}
+	}
+
+	// Only record the status if the sync changed or failed, or if the results differ from the recorded status, so
+	// that periodic resyncs of an up-to-date namespace do not update it.
+	changed := pruned || slices.ContainsFunc(results, func(result copyResult) bool { return result.changed })
+	failed := len(errs) > 0 || slices.ContainsFunc(results, func(result copyResult) bool { return result.err != nil })
+	status := newSyncStatus(ns, results, metav1.Now())
+	previous, ok := readSyncStatus(ns)
+	if changed || failed || !ok || !status.sameResults(&previous) {
+		if err := writeSyncStatus(ctx, r.Client, ns, &status); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return ctrl.Result{}, kerrors.NewAggregate(errs)
+	}
+
+	if !changed {
+		return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
+	}
+	r.Recorder.Eventf(
+		ns,
+		corev1.EventTypeNormal,
+		reasonSynced,
+		"Synced %d ClusterClasses from namespaces %s",
+		len(synced),
+		strings.Join(r.sourceNamespaces(sources), ", "),
+	)
+	return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
+}
+
+// reconcileUnmatchedNamespace deletes the copies from a namespace that no longer matches the
+// TargetNamespaceSelector of any source.
+func (r *Reconciler) reconcileUnmatchedNamespace(ctx context.Context, ns *corev1.Namespace) (ctrl.Result, error) {
+	inUse, pruned, err := pruneCopies(ctx, r.UnstructuredCachingClient, r.Client, ns.Name, nil)
 	if err != nil {
-		// TODO Record an Event.
-		return ctrl.Result{}, fmt.Errorf("failed to list source ClusterClasses: %w", err)
+		r.Recorder.Eventf(ns, corev1.EventTypeWarning, reasonPruneFailed, "Failed to delete copies: %v", err)
+		return ctrl.Result{}, fmt.Errorf("failed to prune copies from namespace %s: %w", ns.Name, err)
 	}
+	if inUse {
+		// Copies of ClusterClasses used by Clusters are kept, so try again later.
+		if !pruned {
+			return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
+		}
+		r.Recorder.Event(
+			ns,
+			corev1.EventTypeNormal,
+			reasonPruned,
+			"Deleted copies that are not used by Clusters, and kept the copies of ClusterClasses used by Clusters",
+		)
+		return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
+	}
+
+	if err := writeSyncStatus(ctx, r.Client, ns, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Event(ns, corev1.EventTypeNormal, reasonPruned, "Deleted all copies")
+	return ctrl.Result{}, nil
+}
 
-	// TODO Consider running in parallel.
-	for i := range sccs {
+// copyResult is the result of copying a source ClusterClass and its referenced Templates to a target namespace.
+type copyResult struct {
+	source *clusterv1.ClusterClass
+	target *clusterv1.ClusterClass
+	// changed is true if the copy of the ClusterClass or any of its Templates was created or updated.
+	changed bool
+	err     error
+}
+
+// copyClusterClasses copies the source ClusterClasses to the namespace in parallel, and returns the result of each
+// copy, in the order of the source ClusterClasses. It only returns an error if the context is done before all
+// ClusterClasses are copied.
+func (r *Reconciler) copyClusterClasses(
+	ctx context.Context,
+	sccs []clusterv1.ClusterClass,
+	namespace string,
+) ([]copyResult, error) {
+	results := make([]copyResult, len(sccs))
+	workqueue.ParallelizeUntil(ctx, maxParallelCopies, len(sccs), func(i int) {
 		scc := sccs[i].DeepCopy()
-		err := copyClusterClassAndTemplates(
+		target, changed, err := copyClusterClassAndTemplates(
 			ctx,
 			r.Client,
 			r.UnstructuredCachingClient,
@@ -144,37 +379,108 @@ func (r *Reconciler) Reconcile(
 			scc,
 			namespace,
 		)
+		results[i] = copyResult{source: scc, target: target, changed: changed, err: err}
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to copy source ClusterClasses to namespace %s: %w", namespace, err)
+	}
+	return results, nil
+}
+
+// errSourceConflict is the error of a source ClusterClass that is not copied, because a ClusterClass with the same
+// name in another source is copied.
+var errSourceConflict = errors.New("a ClusterClass with the same name from another source is copied")
+
+// selectSourceClusterClasses returns the ClusterClasses of the sources that are copied to the namespace: those
+// that match the ClusterClassSelector of their source, and that the namespace allows. ClusterClasses with the same
+// name in multiple sources would be copied to the same ClusterClass, so only the first is copied, and the others are
+// returned as conflicts.
+func (r *Reconciler) selectSourceClusterClasses(
+	ctx context.Context,
+	ns *corev1.Namespace,
+	sources []int,
+) (
+	selected []clusterv1.ClusterClass,
+	conflicts []copyResult,
+	errs []error,
+) {
+	allowed, restricted := allowedClusterClassNames(ns)
+
+	selectedFrom := map[string]string{}
+	for _, i := range sources {
+		source := &r.Sources[i]
+		sccs, err := r.listSourceClusterClasses(ctx, source)
 		if err != nil {
-			// TODO Record an Event.
-			return ctrl.Result{}, fmt.Errorf(
-				"failed to copy source ClusterClass %s or its referenced Templates to namespace %s: %w",
-				client.ObjectKeyFromObject(scc),
-				namespace,
+			r.Recorder.Eventf(
+				ns,
+				corev1.EventTypeWarning,
+				reasonSyncFailed,
+				"Failed to list source ClusterClasses in namespace %s: %v",
+				source.Namespace,
 				err,
 			)
+			errs = append(errs, fmt.Errorf(
+				"failed to list source ClusterClasses in namespace %s: %w",
+				source.Namespace,
+				err,
+			))
+			continue
+		}
+
+		for j := range sccs {
+			scc := &sccs[j]
+			if restricted && !allowed.Has(scc.Name) {
+				continue
+			}
+			if namespace, ok := selectedFrom[scc.Name]; ok {
+				conflicts = append(conflicts, copyResult{
+					source: scc.DeepCopy(),
+					err:    fmt.Errorf("%w: %s/%s", errSourceConflict, namespace, scc.Name),
+				})
+				continue
+			}
+			selectedFrom[scc.Name] = scc.Namespace
+			selected = append(selected, *scc)
 		}
 	}
+	return selected, conflicts, errs
+}
 
-	// TODO Record an Event.
-	return ctrl.Result{}, nil
+// sourceNamespaces returns the namespaces of the sources.
+func (r *Reconciler) sourceNamespaces(sources []int) []string {
+	namespaces := make([]string, 0, len(sources))
+	for _, i := range sources {
+		namespaces = append(namespaces, r.Sources[i].Namespace)
+	}
+	return namespaces
 }
 
+// listSourceClusterClasses returns the ClusterClasses in the source namespace that match the ClusterClassSelector
+// of the source.
 func (r *Reconciler) listSourceClusterClasses(
 	ctx context.Context,
+	source *Source,
 ) (
 	[]clusterv1.ClusterClass,
 	error,
 ) {
 	// Handle the empty string explicitly, because listing resources with an empty
 	// string namespace returns resources in all namespaces.
-	if r.SourceClusterClassNamespace == "" {
+	if source.Namespace == "" {
 		return nil, nil
 	}
 
 	ccl := &clusterv1.ClusterClassList{}
-	err := r.Client.List(ctx, ccl, client.InNamespace(r.SourceClusterClassNamespace))
+	err := r.Client.List(ctx, ccl, client.InNamespace(source.Namespace))
 	if err != nil {
 		return nil, err
 	}
-	return ccl.Items, nil
+
+	sccs := make([]clusterv1.ClusterClass, 0, len(ccl.Items))
+	for i := range ccl.Items {
+		if source.matchesClusterClass(&ccl.Items[i]) {
+			sccs = append(sccs, ccl.Items[i])
+		}
+	}
+	return sccs, nil
 }
@@ -139,11 +139,10 @@ func TestSourceClusterClassNamespaceEmpty(t *testing.T) {
 	// This test initializes its own reconciler, instead of using the one created
 	// in suite_test.go, in order to configure the source namespace.
 	r := Reconciler{
-		Client:                      env.Client,
-		SourceClusterClassNamespace: "",
+		Client: env.Client,
 	}
 
-	ns, err := r.listSourceClusterClasses(ctx)
+	ns, err := r.listSourceClusterClasses(ctx, &Source{Namespace: ""})
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(ns).To(BeEmpty())
 }
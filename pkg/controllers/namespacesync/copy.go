@@ -5,18 +5,43 @@ package namespacesync
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 
-	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+const (
+	// managedLabelKey is the key of the label on the copies of ClusterClasses and Templates in target namespaces.
+	// Only labelled copies are deleted when they are no longer needed.
+	managedLabelKey = v1alpha1.APIGroup + "/namespacesync-managed"
+
+	// sourceHashAnnotationKey is the key of the annotation on the copies of ClusterClasses and Templates in target
+	// namespaces that records the hash of their content, used to detect that their source has changed.
+	sourceHashAnnotationKey = v1alpha1.APIGroup + "/namespacesync-source-hash"
+
+	// versionedNameHashLength is the length of the prefix of the content hash used to name new versions of copies
+	// of Templates.
+	versionedNameHashLength = 10
 )
 
+// errNotACopy is returned when a ClusterClass that is not a copy of the source exists in the target namespace under
+// the name of the source. It is not overwritten.
+var errNotACopy = errors.New("is not a copy of the source ClusterClass")
+
+// copyClusterClassAndTemplates copies the source ClusterClass and its referenced Templates to the namespace, or
+// updates the existing copies if the source has changed since they were copied. It returns the copy of the
+// ClusterClass, and whether the ClusterClass or any of its Templates was created or updated.
 func copyClusterClassAndTemplates(
 	ctx context.Context,
 	w client.Writer,
@@ -24,71 +49,236 @@ func copyClusterClassAndTemplates(
 	scheme *runtime.Scheme,
 	source *clusterv1.ClusterClass,
 	namespace string,
-) error {
+) (*clusterv1.ClusterClass, bool, error) {
 	target := copyObjectForCreate(source, source.Name, namespace)
+	key := client.ObjectKeyFromObject(target)
 
-	// Use source (not target) for walking references so we fetch templates from the source namespace.
-	if err := walkReferences(ctx, source, func(ctx context.Context, ref *corev1.ObjectReference) error {
+	// Check that an existing ClusterClass can be overwritten before copying the Templates, which would otherwise be
+	// deleted as unreferenced copies.
+	sourceHash, err := contentHash(target.Spec)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to hash ClusterClass %s: %w", key, err)
+	}
+	existing := &clusterv1.ClusterClass{}
+	err = templateReader.Get(ctx, key, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, false, fmt.Errorf("failed to check if ClusterClass %s exists: %w", key, err)
+	}
+	if err == nil {
+		if err := checkIsCopy(existing, sourceHash); err != nil {
+			return nil, false, err
+		}
+	}
+
+	changed := false
+
+	// Walk the references of the target, so that they can be pointed to the copies of the Templates, but fetch the
+	// Templates from the source namespace.
+	if err := walkTemplateReferences(target, func(ref *clusterv1.ClusterClassTemplateReference) error {
 		// Get referenced Template
-		sourceTemplate, err := getReference(ctx, templateReader, scheme, ref)
+		sourceTemplate, err := getReference(ctx, templateReader, scheme, ref.ToObjectReference(source.Namespace))
 		if err != nil {
 			return fmt.Errorf("failed to get reference: %w", err)
 		}
 
-		// Copy Template to target namespace, if it does not exist there.
-		targetTemplate := copyObjectForCreate(sourceTemplate, sourceTemplate.GetName(), namespace)
-		if err = createIfNotExists(ctx, templateReader, w, scheme, targetTemplate); err != nil {
+		// Copy Template to target namespace, if an up-to-date copy does not exist there.
+		targetTemplateName, created, err := copyTemplate(ctx, templateReader, w, sourceTemplate, namespace)
+		if err != nil {
 			return fmt.Errorf("failed to create template: %w", err)
 		}
+		changed = changed || created
 
-		// Update reference to point to newly created Template
-		ref.UID = targetTemplate.GetUID()
-		ref.Namespace = targetTemplate.GetNamespace()
+		// Update reference to point to the copy of the Template.
+		ref.Name = targetTemplateName
 
 		return nil
 	}); err != nil {
-		return fmt.Errorf("error processing references: %w", err)
+		return nil, false, fmt.Errorf("error processing references: %w", err)
 	}
 
-	// Copy ClusterClass to target namespace, if it does not exist there.
-	if err := createIfNotExists(ctx, templateReader, w, scheme, target); err != nil {
-		return fmt.Errorf("failed to create cluster class: %w", err)
+	// Copy ClusterClass to target namespace, or update it if it is not up to date.
+	updated, err := createOrUpdateClusterClass(ctx, templateReader, w, target)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create cluster class: %w", err)
 	}
-	return nil
+	return target, changed || updated, nil
 }
 
-func createIfNotExists(
+// copyTemplate copies the source Template to the namespace, and returns the name of the copy, and whether it was
+// created. Templates are immutable, so if a copy of another version of the source Template exists under the name of
+// the source, the Template is copied under a name suffixed with the hash of its content.
+func copyTemplate(
 	ctx context.Context,
 	r client.Reader,
 	w client.Writer,
-	scheme *runtime.Scheme,
-	obj client.Object,
-) error {
-	key := client.ObjectKeyFromObject(obj)
-	gvk, err := apiutil.GVKForObject(obj, scheme)
+	source *unstructured.Unstructured,
+	namespace string,
+) (string, bool, error) {
+	gvk := source.GroupVersionKind()
+	hash, err := contentHash(source.Object["spec"])
 	if err != nil {
-		return errors.Wrapf(err, "failed to get GVK for object %s/%s", obj.GetNamespace(), obj.GetName())
+		return "", false, fmt.Errorf("failed to hash %s %s: %w", gvk, client.ObjectKeyFromObject(source), err)
+	}
+
+	names := []string{
+		source.GetName(),
+		fmt.Sprintf("%s-%s", source.GetName(), hash[:versionedNameHashLength]),
+	}
+	for _, name := range names {
+		key := client.ObjectKey{Namespace: namespace, Name: name}
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(gvk)
+		err := r.Get(ctx, key, existing)
+		if apierrors.IsNotFound(err) {
+			// The resource does not exist, so create it.
+			target := copyObjectForCreate(source, name, namespace)
+			setCopyMetadata(target, hash)
+			if err := w.Create(ctx, target); err != nil {
+				// ClusterClasses are copied concurrently, so the copy of a Template that they share may have just
+				// been created from the same source.
+				if apierrors.IsAlreadyExists(err) {
+					return name, false, nil
+				}
+				return "", false, fmt.Errorf("failed to create %s %s: %w", gvk, key, err)
+			}
+			return name, true, nil
+		}
+		if err != nil {
+			return "", false, fmt.Errorf("failed to check if %s %s exists: %w", gvk, key, err)
+		}
+
+		upToDate, err := isUpToDate(existing, existing.Object["spec"], hash)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to hash %s %s: %w", gvk, key, err)
+		}
+		if upToDate {
+			return name, false, nil
+		}
 	}
 
-	// Check if the resource exists.
-	// We do not need the object itself, just the metadata, so we use PartialObjectMetadata.
-	partial := &metav1.PartialObjectMetadata{}
-	partial.SetGroupVersionKind(gvk)
-	err = r.Get(ctx, key, partial)
+	return "", false, fmt.Errorf(
+		"%s %s exist in namespace %s, but do not match the source %s",
+		gvk,
+		names,
+		namespace,
+		client.ObjectKeyFromObject(source),
+	)
+}
+
+// createOrUpdateClusterClass creates the copy of the ClusterClass, or updates the existing copy if it is not up to
+// date. Unlike Templates, ClusterClasses are mutable, so they are updated in place. An existing ClusterClass that is not
+// labelled as a copy is only adopted if it matches the copy. It returns whether the copy was created or updated.
+func createOrUpdateClusterClass(
+	ctx context.Context,
+	r client.Reader,
+	w client.Writer,
+	target *clusterv1.ClusterClass,
+) (bool, error) {
+	key := client.ObjectKeyFromObject(target)
+	hash, err := contentHash(target.Spec)
+	if err != nil {
+		return false, fmt.Errorf("failed to hash ClusterClass %s: %w", key, err)
+	}
 
+	existing := &clusterv1.ClusterClass{}
+	err = r.Get(ctx, key, existing)
 	if apierrors.IsNotFound(err) {
 		// The resource does not exist, so create it.
-		if err := w.Create(ctx, obj); err != nil {
-			return fmt.Errorf("failed to create %s %s: %w", gvk, key, err)
+		setCopyMetadata(target, hash)
+		if err := w.Create(ctx, target); err != nil {
+			return false, fmt.Errorf("failed to create ClusterClass %s: %w", key, err)
 		}
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check if ClusterClass %s exists: %w", key, err)
+	}
+
+	if err := checkIsCopy(existing, hash); err != nil {
+		return false, err
+	}
+
+	upToDate, err := isUpToDate(existing, existing.Spec, hash)
+	if err != nil {
+		return false, fmt.Errorf("failed to hash ClusterClass %s: %w", key, err)
+	}
+	if upToDate && isCopy(existing) {
+		return false, nil
+	}
+
+	existing.Spec = target.Spec
+	setCopyMetadata(existing, hash)
+	if err := w.Update(ctx, existing); err != nil {
+		return false, fmt.Errorf("failed to update ClusterClass %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// isUpToDate returns true if the content of the existing copy has the hash. The hash is read from the annotation of
+// the copy, or computed from its content for copies created before the annotation was introduced.
+func isUpToDate(existing client.Object, content any, hash string) (bool, error) {
+	if existingHash, ok := existing.GetAnnotations()[sourceHashAnnotationKey]; ok {
+		return existingHash == hash, nil
+	}
+	existingHash, err := contentHash(content)
+	if err != nil {
+		return false, err
+	}
+	return existingHash == hash, nil
+}
+
+// isCopy returns true if the object is labelled or annotated as a copy.
+func isCopy(obj client.Object) bool {
+	if obj.GetLabels()[managedLabelKey] == "true" {
+		return true
+	}
+	_, ok := obj.GetAnnotations()[sourceHashAnnotationKey]
+	return ok
+}
+
+// checkIsCopy returns errNotACopy if the existing ClusterClass is not a copy, and does not have the hash. ClusterClasses
+// copied before copies were labelled are adopted if they still match their source.
+func checkIsCopy(existing *clusterv1.ClusterClass, hash string) error {
+	if isCopy(existing) {
 		return nil
 	}
+	existingHash, err := contentHash(existing.Spec)
 	if err != nil {
-		return fmt.Errorf("failed to check if %s %s exists: %w", gvk, key, err)
+		return fmt.Errorf("failed to hash ClusterClass %s: %w", client.ObjectKeyFromObject(existing), err)
+	}
+	if existingHash != hash {
+		return fmt.Errorf("ClusterClass %s %w", client.ObjectKeyFromObject(existing), errNotACopy)
 	}
 	return nil
 }
 
+// setCopyMetadata labels the object as a copy, and records the hash of its content.
+func setCopyMetadata(obj client.Object, hash string) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[managedLabelKey] = "true"
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[sourceHashAnnotationKey] = hash
+	obj.SetAnnotations(annotations)
+}
+
+// contentHash returns the hex-encoded SHA-256 hash of the JSON encoding of the content.
+func contentHash(content any) (string, error) {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // copyObjectForCreate copies the object, updating the name and namespace,
 // and preserving only labels and annotations metadata.
 func copyObjectForCreate[T client.Object](src T, name, namespace string) T {
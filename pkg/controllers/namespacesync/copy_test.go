@@ -11,6 +11,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/storage/names"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -78,7 +79,7 @@ func TestCopyClusterClassAndTemplates(t *testing.T) {
 			name:             "should fail if getting the clusterclass fails",
 			kindThatFailsGet: "ClusterClass",
 			expectErr:        errFakeGet,
-			expectNumCopies:  5, // All 5 templates are created before ClusterClass.
+			expectNumCopies:  0, // The ClusterClass is checked before templates are created.
 		},
 	}
 
@@ -107,7 +108,7 @@ func TestCopyClusterClassAndTemplates(t *testing.T) {
 				WithRuntimeObjects(initObjs...).
 				Build()
 
-			err := copyClusterClassAndTemplates(
+			_, _, err := copyClusterClassAndTemplates(
 				ctx,
 				fakeClient,
 				fakeClient,
@@ -201,3 +202,197 @@ func newTestClusterClassAndTemplates(
 
 	return clusterClass, templates
 }
+
+func TestCopyClusterClassAndTemplatesUpdatesCopies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	sourceClusterClass, sourceTemplates := newTestClusterClassAndTemplates(
+		sourceNamespace,
+		names.SimpleNameGenerator.GenerateName("test-cc-"),
+	)
+	initObjs := []runtime.Object{sourceClusterClass}
+	for _, template := range sourceTemplates {
+		initObjs = append(initObjs, template)
+	}
+	fakeClient := fake.
+		NewClientBuilder().
+		WithScheme(newCopyTestScheme()).
+		WithRuntimeObjects(initObjs...).
+		Build()
+
+	_, changed, err := copyClusterClassAndTemplates(
+		ctx,
+		fakeClient,
+		fakeClient,
+		fakeClient.Scheme(),
+		sourceClusterClass,
+		targetNamespace,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeTrue())
+
+	targetClusterClass := &clusterv1beta2.ClusterClass{}
+	g.Expect(fakeClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: targetNamespace, Name: sourceClusterClass.Name},
+		targetClusterClass,
+	)).To(Succeed())
+	g.Expect(targetClusterClass.Labels).To(HaveKeyWithValue(managedLabelKey, "true"))
+	g.Expect(targetClusterClass.Annotations).To(HaveKey(sourceHashAnnotationKey))
+	infraClusterTemplateName := targetClusterClass.Spec.Infrastructure.TemplateRef.Name
+	g.Expect(infraClusterTemplateName).To(Equal(sourceClusterClass.Spec.Infrastructure.TemplateRef.Name))
+
+	// Copying an unchanged source does not change the copies.
+	_, changed, err = copyClusterClassAndTemplates(
+		ctx,
+		fakeClient,
+		fakeClient,
+		fakeClient.Scheme(),
+		sourceClusterClass,
+		targetNamespace,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeFalse())
+	unchangedClusterClass := &clusterv1beta2.ClusterClass{}
+	g.Expect(fakeClient.Get(
+		ctx,
+		client.ObjectKeyFromObject(targetClusterClass),
+		unchangedClusterClass,
+	)).To(Succeed())
+	g.Expect(unchangedClusterClass.ResourceVersion).To(Equal(targetClusterClass.ResourceVersion))
+
+	// Change the source infrastructure cluster Template, and the source ClusterClass.
+	infraClusterTemplate := &unstructured.Unstructured{}
+	infraClusterTemplate.SetGroupVersionKind(sourceClusterClass.Spec.Infrastructure.TemplateRef.GroupVersionKind())
+	g.Expect(fakeClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: sourceNamespace, Name: infraClusterTemplateName},
+		infraClusterTemplate,
+	)).To(Succeed())
+	g.Expect(unstructured.SetNestedField(
+		infraClusterTemplate.Object, "changed", "spec", "template", "spec", "field",
+	)).To(Succeed())
+	g.Expect(fakeClient.Update(ctx, infraClusterTemplate)).To(Succeed())
+	sourceClusterClass.Spec.KubernetesVersions = []string{"v1.33.0"}
+
+	target, changed, err := copyClusterClassAndTemplates(
+		ctx,
+		fakeClient,
+		fakeClient,
+		fakeClient.Scheme(),
+		sourceClusterClass,
+		targetNamespace,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).To(BeTrue())
+
+	// The changed Template is copied under a new name, and the ClusterClass is updated to point to it.
+	updatedClusterClass := &clusterv1beta2.ClusterClass{}
+	g.Expect(fakeClient.Get(
+		ctx,
+		client.ObjectKeyFromObject(targetClusterClass),
+		updatedClusterClass,
+	)).To(Succeed())
+	g.Expect(updatedClusterClass.Spec).To(Equal(target.Spec))
+	g.Expect(updatedClusterClass.Spec.KubernetesVersions).To(ConsistOf("v1.33.0"))
+	g.Expect(updatedClusterClass.Annotations[sourceHashAnnotationKey]).
+		ToNot(Equal(targetClusterClass.Annotations[sourceHashAnnotationKey]))
+
+	updatedInfraClusterTemplateName := updatedClusterClass.Spec.Infrastructure.TemplateRef.Name
+	g.Expect(updatedInfraClusterTemplateName).To(HavePrefix(infraClusterTemplateName + "-"))
+	updatedInfraClusterTemplate := &unstructured.Unstructured{}
+	updatedInfraClusterTemplate.SetGroupVersionKind(infraClusterTemplate.GroupVersionKind())
+	g.Expect(fakeClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: targetNamespace, Name: updatedInfraClusterTemplateName},
+		updatedInfraClusterTemplate,
+	)).To(Succeed())
+	g.Expect(updatedInfraClusterTemplate.Object["spec"]).To(Equal(infraClusterTemplate.Object["spec"]))
+
+	// Templates that did not change are still the original copies.
+	g.Expect(updatedClusterClass.Spec.ControlPlane.TemplateRef.Name).
+		To(Equal(sourceClusterClass.Spec.ControlPlane.TemplateRef.Name))
+}
+
+func TestCopyClusterClassAndTemplatesDoesNotOverwriteClusterClasses(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name      string
+		modify    func(*clusterv1beta2.ClusterClass)
+		expectErr error
+	}{
+		{
+			name: "adopts an unlabelled copy that matches the source",
+		},
+		{
+			name: "does not overwrite a ClusterClass that does not match the source",
+			modify: func(cc *clusterv1beta2.ClusterClass) {
+				cc.Spec.KubernetesVersions = []string{"v1.33.0"}
+			},
+			expectErr: errNotACopy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			sourceClusterClass, sourceTemplates := newTestClusterClassAndTemplates(
+				sourceNamespace,
+				names.SimpleNameGenerator.GenerateName("test-cc-"),
+			)
+			initObjs := []runtime.Object{sourceClusterClass}
+			for _, template := range sourceTemplates {
+				initObjs = append(initObjs, template)
+			}
+
+			// An unlabelled ClusterClass exists in the target namespace, with Templates that match the source.
+			existingClusterClass := copyObjectForCreate(sourceClusterClass, sourceClusterClass.Name, targetNamespace)
+			if tc.modify != nil {
+				tc.modify(existingClusterClass)
+			}
+			initObjs = append(initObjs, existingClusterClass)
+			for _, template := range sourceTemplates {
+				initObjs = append(initObjs, copyObjectForCreate(template, template.GetName(), targetNamespace))
+			}
+
+			createdObjs := []client.Object{}
+			fakeClient := fake.
+				NewClientBuilder().
+				WithScheme(newCopyTestScheme()).
+				WithInterceptorFuncs(interceptors(&createdObjs, "", "")).
+				WithRuntimeObjects(initObjs...).
+				Build()
+
+			_, _, err := copyClusterClassAndTemplates(
+				ctx,
+				fakeClient,
+				fakeClient,
+				fakeClient.Scheme(),
+				sourceClusterClass,
+				targetNamespace,
+			)
+			g.Expect(createdObjs).To(BeEmpty())
+
+			targetClusterClass := &clusterv1beta2.ClusterClass{}
+			g.Expect(fakeClient.Get(
+				ctx,
+				client.ObjectKeyFromObject(existingClusterClass),
+				targetClusterClass,
+			)).To(Succeed())
+			g.Expect(targetClusterClass.Spec).To(Equal(existingClusterClass.Spec))
+
+			if tc.expectErr != nil {
+				g.Expect(err).To(MatchError(tc.expectErr))
+				g.Expect(targetClusterClass.Labels).ToNot(HaveKey(managedLabelKey))
+				g.Expect(targetClusterClass.Annotations).ToNot(HaveKey(sourceHashAnnotationKey))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(targetClusterClass.Labels).To(HaveKeyWithValue(managedLabelKey, "true"))
+			g.Expect(targetClusterClass.Annotations).To(HaveKey(sourceHashAnnotationKey))
+		})
+	}
+}
@@ -7,10 +7,26 @@
 // For every ClusterClass in the source namespace, the controller creates a copy of it, and all its
 // referenced Templates, in the target namespace.
 //
-// Resources in target namespaces are not updated, even if they are updated or deleted in the source
-// namespace.
+// There may be multiple source namespaces, each with its own target namespace selector, and an optional
+// ClusterClass selector that restricts the ClusterClasses copied from it. A target namespace can further
+// restrict the ClusterClasses copied to it by listing their names in an annotation.
 //
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create
-// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclasses,verbs=get;list;watch;create
-// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// Copies record the hash of their content in an annotation. When the source changes, the copy of the
+// ClusterClass is updated in place, while Templates, which are immutable, are copied under a new name
+// suffixed with the hash of their content, and the copy of the ClusterClass is pointed to them.
+//
+// Copies of ClusterClasses whose source was deleted are deleted, unless a Cluster uses them. Copies of
+// Templates are deleted once no ClusterClass in the target namespace references them. Optionally, copies
+// are also deleted from namespaces that no longer match the target namespace selector.
+//
+// A failure to copy one ClusterClass does not prevent the others from being copied. The result of the last
+// sync of every ClusterClass is recorded in an annotation on the target namespace, and failures are
+// reported as Events on the target namespace and the source ClusterClass. The annotation is only updated,
+// and a ClusterClassesSynced Event recorded, when a sync copies or deletes a copy, or fails.
+//
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusterclasses,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 package namespacesync
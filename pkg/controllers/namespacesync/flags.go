@@ -4,6 +4,9 @@
 package namespacesync
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
@@ -12,6 +15,10 @@ type Options struct {
 	Concurrency                  int
 	SourceNamespace              string
 	TargetNamespaceLabelSelector string
+	ClusterClassLabelSelector    string
+	ConfigFile                   string
+	PruneUnmatchedNamespaces     bool
+	ResyncPeriod                 time.Duration
 }
 
 func (o *Options) AddFlags(flags *pflag.FlagSet) {
@@ -52,4 +59,52 @@ func (o *Options) AddFlags(flags *pflag.FlagSet) {
 		"",
 		"Label selector to determine target namespaces. Namespaces matching this selector will receive copies of ClusterClasses and Templates from the source namespace. Example: 'environment=production' or 'team in (platform,infrastructure)'.", //nolint:lll // Output will be wrapped.
 	)
+
+	flags.StringVar(
+		&o.ClusterClassLabelSelector,
+		"namespacesync-clusterclass-label-selector",
+		"",
+		"Label selector to determine the ClusterClasses that are copied from the source namespace. If empty, all ClusterClasses are copied.", //nolint:lll // Output will be wrapped.
+	)
+
+	flags.StringVar(
+		&o.ConfigFile,
+		"namespacesync-config-file",
+		"",
+		"Path to a file that configures one or more source namespaces, each with its own target namespace label selector and ClusterClass label selector. Mutually exclusive with the source namespace, target namespace label selector, and ClusterClass label selector flags.", //nolint:lll // Output will be wrapped.
+	)
+
+	flags.BoolVar(
+		&o.PruneUnmatchedNamespaces,
+		"namespacesync-prune-unmatched-namespaces",
+		false,
+		"Delete the copies of ClusterClasses and Templates from namespaces that no longer match the target namespace label selector. Copies of ClusterClasses that are used by Clusters are kept until they are no longer used.", //nolint:lll // Output will be wrapped.
+	)
+
+	flags.DurationVar(
+		&o.ResyncPeriod,
+		"namespacesync-resync-period",
+		10*time.Minute,
+		"Interval at which target namespaces are synced again, to propagate changes to the Templates in the source namespace. Set to 0 to disable.", //nolint:lll // Output will be wrapped.
+	)
+}
+
+// Sources returns the sources configured by the configuration file, or by the flags if no configuration file is
+// configured.
+func (o *Options) Sources() ([]Source, error) {
+	if o.ConfigFile != "" {
+		if o.SourceNamespace != "" || o.TargetNamespaceLabelSelector != "" || o.ClusterClassLabelSelector != "" {
+			return nil, fmt.Errorf(
+				"the configuration file is mutually exclusive with the source namespace, " +
+					"target namespace label selector, and ClusterClass label selector",
+			)
+		}
+		return readSourcesConfigFile(o.ConfigFile)
+	}
+
+	source, err := newSource(o.SourceNamespace, o.TargetNamespaceLabelSelector, o.ClusterClassLabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return []Source{source}, nil
 }
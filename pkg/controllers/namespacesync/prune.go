@@ -0,0 +1,168 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacesync
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// templateKey identifies a Template in a namespace.
+type templateKey struct {
+	schema.GroupKind
+	name string
+}
+
+// pruneCopies deletes the copies of ClusterClasses and Templates in the namespace that are no longer needed.
+//
+// The synced ClusterClasses are the up-to-date copies of the source ClusterClasses. They take precedence over the
+// ClusterClasses read from the reader, which may not have observed their latest update yet. Any other copy of a
+// ClusterClass is deleted, unless a Cluster uses it. Copies of Templates are then deleted if no ClusterClass in the
+// namespace references them.
+//
+// It returns whether a copy of a ClusterClass was kept because a Cluster uses it, and whether any copy was deleted.
+func pruneCopies(
+	ctx context.Context,
+	r client.Reader,
+	w client.Writer,
+	namespace string,
+	synced []*clusterv1.ClusterClass,
+) (inUse, deleted bool, err error) {
+	ccl := &clusterv1.ClusterClassList{}
+	if err := r.List(ctx, ccl, client.InNamespace(namespace)); err != nil {
+		return false, false, fmt.Errorf("failed to list ClusterClasses in namespace %s: %w", namespace, err)
+	}
+
+	usedClusterClassNames, err := clusterClassNamesUsedByClusters(ctx, r, namespace)
+	if err != nil {
+		return false, false, err
+	}
+
+	syncedNames := sets.New[string]()
+	for _, cc := range synced {
+		syncedNames.Insert(cc.Name)
+	}
+
+	// Every Template kind referenced by a ClusterClass, before any ClusterClass is deleted, may have copies to delete.
+	templateGVKs := map[schema.GroupKind]schema.GroupVersionKind{}
+	addTemplateGVKs := func(cc *clusterv1.ClusterClass) {
+		_ = walkTemplateReferences(cc, func(ref *clusterv1.ClusterClassTemplateReference) error {
+			gvk := ref.GroupVersionKind()
+			templateGVKs[gvk.GroupKind()] = gvk
+			return nil
+		})
+	}
+	for _, cc := range synced {
+		addTemplateGVKs(cc)
+	}
+
+	referencedTemplates := sets.New[templateKey]()
+	addReferencedTemplates := func(cc *clusterv1.ClusterClass) {
+		_ = walkTemplateReferences(cc, func(ref *clusterv1.ClusterClassTemplateReference) error {
+			referencedTemplates.Insert(templateKey{ref.GroupVersionKind().GroupKind(), ref.Name})
+			return nil
+		})
+	}
+	for i := range ccl.Items {
+		cc := &ccl.Items[i]
+		addTemplateGVKs(cc)
+
+		if syncedNames.Has(cc.Name) {
+			continue
+		}
+
+		if cc.Labels[managedLabelKey] == "true" {
+			if !usedClusterClassNames.Has(cc.Name) {
+				if err := deleteCopy(ctx, w, cc, &deleted); err != nil {
+					return false, false, fmt.Errorf(
+						"failed to delete ClusterClass %s: %w",
+						client.ObjectKeyFromObject(cc),
+						err,
+					)
+				}
+				continue
+			}
+			inUse = true
+		}
+		addReferencedTemplates(cc)
+	}
+	for _, cc := range synced {
+		addReferencedTemplates(cc)
+	}
+
+	for _, gvk := range templateGVKs {
+		templates := &unstructured.UnstructuredList{}
+		templates.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.List(
+			ctx,
+			templates,
+			client.InNamespace(namespace),
+			client.MatchingLabels{managedLabelKey: "true"},
+		); err != nil {
+			return false, false, fmt.Errorf("failed to list %s in namespace %s: %w", gvk.Kind, namespace, err)
+		}
+
+		for i := range templates.Items {
+			template := &templates.Items[i]
+			if referencedTemplates.Has(templateKey{gvk.GroupKind(), template.GetName()}) {
+				continue
+			}
+			if err := deleteCopy(ctx, w, template, &deleted); err != nil {
+				return false, false, fmt.Errorf(
+					"failed to delete %s %s: %w",
+					gvk.Kind,
+					client.ObjectKeyFromObject(template),
+					err,
+				)
+			}
+		}
+	}
+
+	return inUse, deleted, nil
+}
+
+// deleteCopy deletes the copy, and sets deleted if it existed.
+func deleteCopy(ctx context.Context, w client.Writer, obj client.Object, deleted *bool) error {
+	err := w.Delete(ctx, obj)
+	if err == nil {
+		*deleted = true
+	}
+	return client.IgnoreNotFound(err)
+}
+
+// clusterClassNamesUsedByClusters returns the names of the ClusterClasses in the namespace that are used by Clusters.
+// Clusters may use ClusterClasses from other namespaces, so Clusters in all namespaces are considered.
+func clusterClassNamesUsedByClusters(
+	ctx context.Context,
+	r client.Reader,
+	namespace string,
+) (sets.Set[string], error) {
+	clusters := &clusterv1.ClusterList{}
+	if err := r.List(ctx, clusters); err != nil {
+		return nil, fmt.Errorf("failed to list Clusters: %w", err)
+	}
+
+	names := sets.New[string]()
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		classRef := cluster.Spec.Topology.ClassRef
+		if classRef.Name == "" {
+			continue
+		}
+		classNamespace := classRef.Namespace
+		if classNamespace == "" {
+			classNamespace = cluster.Namespace
+		}
+		if classNamespace == namespace {
+			names.Insert(classRef.Name)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacesync
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPruneCopies(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		clusterUsesSecondCopy bool
+		pruneAll              bool
+		expectInUse           bool
+		expectClusterClasses  []string
+	}{
+		{
+			name:                 "should delete the copy of a deleted source ClusterClass",
+			expectClusterClasses: []string{"first", "unmanaged"},
+		},
+		{
+			name:                  "should keep the copy of a deleted source ClusterClass used by a Cluster",
+			clusterUsesSecondCopy: true,
+			expectInUse:           true,
+			expectClusterClasses:  []string{"first", "second", "unmanaged"},
+		},
+		{
+			name:                 "should delete all copies when pruning all copies",
+			pruneAll:             true,
+			expectClusterClasses: []string{"unmanaged"},
+		},
+		{
+			name:                  "should keep the copies used by a Cluster when pruning all copies",
+			clusterUsesSecondCopy: true,
+			pruneAll:              true,
+			expectInUse:           true,
+			expectClusterClasses:  []string{"second", "unmanaged"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			first, firstTemplates := newTestClusterClassAndTemplates(targetNamespace, "first")
+			second, secondTemplates := newTestClusterClassAndTemplates(targetNamespace, "second")
+			unmanaged, unmanagedTemplates := newTestClusterClassAndTemplates(targetNamespace, "unmanaged")
+			oldTemplate := builder.InfrastructureClusterTemplate(targetNamespace, "first-old").Build()
+
+			copies := []client.Object{first, second, oldTemplate}
+			copies = append(copies, firstTemplates...)
+			copies = append(copies, secondTemplates...)
+			for _, obj := range copies {
+				setCopyMetadata(obj, "hash")
+			}
+			initObjs := []client.Object{unmanaged}
+			initObjs = append(initObjs, unmanagedTemplates...)
+			initObjs = append(initObjs, copies...)
+			if tc.clusterUsesSecondCopy {
+				initObjs = append(initObjs, &clusterv1beta2.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster",
+						Namespace: "cluster-ns",
+					},
+					Spec: clusterv1beta2.ClusterSpec{
+						Topology: clusterv1beta2.Topology{
+							ClassRef: clusterv1beta2.ClusterClassRef{
+								Name:      second.Name,
+								Namespace: targetNamespace,
+							},
+						},
+					},
+				})
+			}
+
+			fakeClient := fake.
+				NewClientBuilder().
+				WithScheme(newCopyTestScheme()).
+				WithObjects(initObjs...).
+				Build()
+
+			synced := []*clusterv1beta2.ClusterClass{first}
+			if tc.pruneAll {
+				synced = nil
+			}
+			inUse, deleted, err := pruneCopies(ctx, fakeClient, fakeClient, targetNamespace, synced)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(inUse).To(Equal(tc.expectInUse))
+			g.Expect(deleted).To(BeTrue())
+
+			// Pruning again has nothing left to delete.
+			_, deleted, err = pruneCopies(ctx, fakeClient, fakeClient, targetNamespace, synced)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(deleted).To(BeFalse())
+
+			ccl := &clusterv1beta2.ClusterClassList{}
+			g.Expect(fakeClient.List(ctx, ccl, client.InNamespace(targetNamespace))).To(Succeed())
+			clusterClassNames := []string{}
+			for i := range ccl.Items {
+				clusterClassNames = append(clusterClassNames, ccl.Items[i].Name)
+			}
+			g.Expect(clusterClassNames).To(ConsistOf(tc.expectClusterClasses))
+
+			// Templates are kept if they are not copies, or if a remaining ClusterClass references them.
+			expectTemplates := map[string][]client.Object{
+				"first":     firstTemplates,
+				"second":    secondTemplates,
+				"unmanaged": unmanagedTemplates,
+			}
+			for name, templates := range expectTemplates {
+				for _, template := range templates {
+					err := fakeClient.Get(
+						ctx,
+						client.ObjectKeyFromObject(template),
+						template.DeepCopyObject().(client.Object),
+					)
+					if name == "unmanaged" || slices.Contains(tc.expectClusterClasses, name) {
+						g.Expect(err).ToNot(HaveOccurred())
+					} else {
+						g.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "expected %s to be deleted", template.GetName())
+					}
+				}
+			}
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(oldTemplate), oldTemplate.DeepCopyObject().(client.Object))
+			g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	}
+}
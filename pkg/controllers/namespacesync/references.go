@@ -70,36 +70,36 @@ func walkReferences(
 	if cc == nil {
 		return nil
 	}
-	if ref := cc.Spec.Infrastructure.TemplateRef.ToObjectReference(cc.Namespace); ref != nil {
-		if err := fn(ctx, ref); err != nil {
-			return err
-		}
-	}
+	return walkTemplateReferences(cc, func(ref *clusterv1.ClusterClassTemplateReference) error {
+		return fn(ctx, ref.ToObjectReference(cc.Namespace))
+	})
+}
 
-	if ref := cc.Spec.ControlPlane.TemplateRef.ToObjectReference(cc.Namespace); ref != nil {
-		if err := fn(ctx, ref); err != nil {
-			return err
-		}
+// walkTemplateReferences calls fn with every defined Template reference of the ClusterClass. The references point into
+// the ClusterClass, so fn can update them.
+func walkTemplateReferences(
+	cc *clusterv1.ClusterClass,
+	fn func(ref *clusterv1.ClusterClassTemplateReference) error,
+) error {
+	if cc == nil {
+		return nil
 	}
-
-	cpInfra := cc.Spec.ControlPlane.MachineInfrastructure
-	if ref := cpInfra.TemplateRef.ToObjectReference(cc.Namespace); ref != nil {
-		if err := fn(ctx, ref); err != nil {
-			return err
-		}
+	refs := []*clusterv1.ClusterClassTemplateReference{
+		&cc.Spec.Infrastructure.TemplateRef,
+		&cc.Spec.ControlPlane.TemplateRef,
+		&cc.Spec.ControlPlane.MachineInfrastructure.TemplateRef,
 	}
-
 	for mdIdx := range cc.Spec.Workers.MachineDeployments {
 		md := &cc.Spec.Workers.MachineDeployments[mdIdx]
-		if ref := md.Infrastructure.TemplateRef.ToObjectReference(cc.Namespace); ref != nil {
-			if err := fn(ctx, ref); err != nil {
-				return err
-			}
+		refs = append(refs, &md.Infrastructure.TemplateRef, &md.Bootstrap.TemplateRef)
+	}
+
+	for _, ref := range refs {
+		if !ref.IsDefined() {
+			continue
 		}
-		if ref := md.Bootstrap.TemplateRef.ToObjectReference(cc.Namespace); ref != nil {
-			if err := fn(ctx, ref); err != nil {
-				return err
-			}
+		if err := fn(ref); err != nil {
+			return err
 		}
 	}
 
@@ -0,0 +1,149 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacesync
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+// Source is a namespace from which ClusterClasses are copied, and the namespaces to which they are copied.
+type Source struct {
+	// Namespace is the namespace from which ClusterClasses are copied.
+	Namespace string
+
+	// TargetNamespaceSelector is a label selector to determine which namespaces should receive
+	// copies of ClusterClasses and Templates from the source namespace.
+	TargetNamespaceSelector labels.Selector
+
+	// ClusterClassSelector is a label selector to determine which ClusterClasses in the source namespace are
+	// copied. If nil, all ClusterClasses are copied.
+	ClusterClassSelector labels.Selector
+}
+
+// matchesTarget returns true if the namespace with the labels is a target namespace of the source.
+func (s *Source) matchesTarget(nsLabels map[string]string) bool {
+	return s.TargetNamespaceSelector.Matches(labels.Set(nsLabels))
+}
+
+// matchesClusterClass returns true if the ClusterClass in the source namespace is copied.
+func (s *Source) matchesClusterClass(cc *clusterv1.ClusterClass) bool {
+	return s.ClusterClassSelector == nil || s.ClusterClassSelector.Matches(labels.Set(cc.Labels))
+}
+
+// sourcesConfig is the format of the file that configures the sources.
+type sourcesConfig struct {
+	Sources []sourceConfig `json:"sources"`
+}
+
+type sourceConfig struct {
+	// Namespace is the namespace from which ClusterClasses are copied.
+	Namespace string `json:"namespace"`
+
+	// TargetNamespaceLabelSelector is the label selector of the namespaces to which ClusterClasses are copied.
+	TargetNamespaceLabelSelector string `json:"targetNamespaceLabelSelector"`
+
+	// ClusterClassLabelSelector is the label selector of the ClusterClasses that are copied. If empty, all
+	// ClusterClasses are copied.
+	ClusterClassLabelSelector string `json:"clusterClassLabelSelector,omitempty"`
+}
+
+// readSourcesConfigFile reads the sources from the configuration file.
+func readSourcesConfigFile(path string) ([]Source, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read namespace sync configuration file %s: %w", path, err)
+	}
+
+	config := &sourcesConfig{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse namespace sync configuration file %s: %w", path, err)
+	}
+	if len(config.Sources) == 0 {
+		return nil, fmt.Errorf("namespace sync configuration file %s does not configure any sources", path)
+	}
+
+	sources := make([]Source, 0, len(config.Sources))
+	for i := range config.Sources {
+		source, err := newSource(
+			config.Sources[i].Namespace,
+			config.Sources[i].TargetNamespaceLabelSelector,
+			config.Sources[i].ClusterClassLabelSelector,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source %d in namespace sync configuration file %s: %w", i, path, err)
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// newSource returns the source with the namespace and label selectors. The ClusterClass label selector is optional.
+func newSource(namespace, targetNamespaceLabelSelector, clusterClassLabelSelector string) (Source, error) {
+	if namespace == "" || targetNamespaceLabelSelector == "" {
+		return Source{}, fmt.Errorf("source namespace and/or target namespace label selector are not configured")
+	}
+
+	targetSelector, err := parseLabelSelector(targetNamespaceLabelSelector)
+	if err != nil {
+		return Source{}, fmt.Errorf(
+			"unable to parse target namespace label selector %q: %w",
+			targetNamespaceLabelSelector,
+			err,
+		)
+	}
+
+	source := Source{
+		Namespace:               namespace,
+		TargetNamespaceSelector: targetSelector,
+	}
+
+	if clusterClassLabelSelector != "" {
+		source.ClusterClassSelector, err = parseLabelSelector(clusterClassLabelSelector)
+		if err != nil {
+			return Source{}, fmt.Errorf(
+				"unable to parse ClusterClass label selector %q: %w",
+				clusterClassLabelSelector,
+				err,
+			)
+		}
+	}
+
+	return source, nil
+}
+
+func parseLabelSelector(selector string) (labels.Selector, error) {
+	labelSelector, err := metav1.ParseToLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
+
+// allowedClusterClassNames returns the names of the ClusterClasses that the namespace allows to be copied to it, and
+// false if the namespace allows all ClusterClasses.
+func allowedClusterClassNames(ns *corev1.Namespace) (sets.Set[string], bool) {
+	value, ok := ns.Annotations[v1alpha1.NamespaceSyncClusterClassesAnnotationKey]
+	if !ok {
+		return nil, false
+	}
+
+	names := sets.New[string]()
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names.Insert(name)
+		}
+	}
+	return names, true
+}
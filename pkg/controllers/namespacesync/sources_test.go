@@ -0,0 +1,230 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacesync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func TestOptionsSources(t *testing.T) {
+	testCases := []struct {
+		name          string
+		options       Options
+		configFile    string
+		expectSources []string
+		expectError   string
+	}{
+		{
+			name: "flags",
+			options: Options{
+				SourceNamespace:              "source",
+				TargetNamespaceLabelSelector: "tenant",
+				ClusterClassLabelSelector:    "provider=nutanix",
+			},
+			expectSources: []string{"source"},
+		},
+		{
+			name: "configuration file",
+			configFile: `sources:
+- namespace: source-a
+  targetNamespaceLabelSelector: tenant=a
+  clusterClassLabelSelector: provider=nutanix
+- namespace: source-b
+  targetNamespaceLabelSelector: tenant in (a,b)
+`,
+			expectSources: []string{"source-a", "source-b"},
+		},
+		{
+			name:        "neither flags nor configuration file",
+			expectError: "source namespace and/or target namespace label selector are not configured",
+		},
+		{
+			name: "invalid ClusterClass label selector",
+			options: Options{
+				SourceNamespace:              "source",
+				TargetNamespaceLabelSelector: "tenant",
+				ClusterClassLabelSelector:    "provider in (",
+			},
+			expectError: "unable to parse ClusterClass label selector",
+		},
+		{
+			name: "both flags and configuration file",
+			options: Options{
+				SourceNamespace: "source",
+			},
+			configFile: `sources:
+- namespace: source-a
+  targetNamespaceLabelSelector: tenant=a
+`,
+			expectError: "the configuration file is mutually exclusive",
+		},
+		{
+			name: "configuration file with unknown field",
+			configFile: `sources:
+- namespace: source-a
+  targetNamespaceSelector: tenant=a
+`,
+			expectError: "failed to parse namespace sync configuration file",
+		},
+		{
+			name: "configuration file without target namespace label selector",
+			configFile: `sources:
+- namespace: source-a
+`,
+			expectError: "invalid source 0 in namespace sync configuration file",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			options := tc.options
+			if tc.configFile != "" {
+				options.ConfigFile = filepath.Join(t.TempDir(), "config.yaml")
+				g.Expect(os.WriteFile(options.ConfigFile, []byte(tc.configFile), 0o600)).To(Succeed())
+			}
+
+			sources, err := options.Sources()
+			if tc.expectError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectError)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			namespaces := []string{}
+			for i := range sources {
+				g.Expect(sources[i].TargetNamespaceSelector).ToNot(BeNil())
+				namespaces = append(namespaces, sources[i].Namespace)
+			}
+			g.Expect(namespaces).To(Equal(tc.expectSources))
+		})
+	}
+}
+
+func TestReconcileSelectsClusterClasses(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		allowedClusterClass  string
+		expectClusterClasses []string
+		expectConflict       bool
+	}{
+		{
+			name:                 "copies the selected ClusterClasses of all sources",
+			expectClusterClasses: []string{"nutanix", "docker", "other"},
+			expectConflict:       true,
+		},
+		{
+			name:                 "copies the selected ClusterClasses allowed by the namespace",
+			allowedClusterClass:  "aws, docker",
+			expectClusterClasses: []string{"docker"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   targetNamespace,
+					Labels: map[string]string{"tenant": ""},
+				},
+			}
+			if tc.allowedClusterClass != "" {
+				ns.Annotations = map[string]string{
+					v1alpha1.NamespaceSyncClusterClassesAnnotationKey: tc.allowedClusterClass,
+				}
+			}
+
+			initObjs := []client.Object{ns}
+			for _, source := range []struct {
+				namespace, name, provider string
+			}{
+				{"source-a", "nutanix", "nutanix"},
+				{"source-a", "aws", "aws"},
+				{"source-b", "docker", "docker"},
+				{"source-b", "nutanix", "nutanix"},
+				{"source-b", "other", "other"},
+			} {
+				cc, templates := newTestClusterClassAndTemplates(source.namespace, source.name)
+				cc.Labels = map[string]string{"provider": source.provider}
+				initObjs = append(initObjs, cc)
+				initObjs = append(initObjs, templates...)
+			}
+
+			scheme := newCopyTestScheme()
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).Build()
+
+			recorder := record.NewFakeRecorder(10)
+			targetSelector := labels.SelectorFromSet(labels.Set{"tenant": ""})
+			r := &Reconciler{
+				Client:                    fakeClient,
+				UnstructuredCachingClient: fakeClient,
+				Sources: []Source{
+					{
+						Namespace:               "source-a",
+						TargetNamespaceSelector: targetSelector,
+						ClusterClassSelector:    labels.SelectorFromSet(labels.Set{"provider": "nutanix"}),
+					},
+					{
+						Namespace:               "source-b",
+						TargetNamespaceSelector: targetSelector,
+					},
+					{
+						Namespace:               "source-c",
+						TargetNamespaceSelector: labels.SelectorFromSet(labels.Set{"other-tenant": ""}),
+					},
+				},
+				Recorder: recorder,
+			}
+			// A name conflict between sources is reported, and does not fail the sync.
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: targetNamespace}})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			ccl := &clusterv1beta2.ClusterClassList{}
+			g.Expect(fakeClient.List(ctx, ccl, client.InNamespace(targetNamespace))).To(Succeed())
+			clusterClassNames := []string{}
+			for i := range ccl.Items {
+				clusterClassNames = append(clusterClassNames, ccl.Items[i].Name)
+			}
+			g.Expect(clusterClassNames).To(ConsistOf(tc.expectClusterClasses))
+
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ns), ns)).To(Succeed())
+			status, ok := readSyncStatus(ns)
+			g.Expect(ok).To(BeTrue())
+			conflict := ContainElement(And(
+				HaveField("Namespace", "source-b"),
+				HaveField("Name", "nutanix"),
+				HaveField("Error", ContainSubstring(errSourceConflict.Error())),
+			))
+			if tc.expectConflict {
+				g.Expect(status.ClusterClasses).To(conflict)
+				g.Expect(recorder.Events).To(Receive(
+					HavePrefix("Warning ClusterClassConflict Did not copy ClusterClass source-b/nutanix"),
+				))
+			} else {
+				g.Expect(status.ClusterClasses).ToNot(conflict)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacesync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+const (
+	// syncStatusAnnotationKey is the key of the annotation on target namespaces that records the result of the last
+	// sync, as a JSON-encoded syncStatus.
+	syncStatusAnnotationKey = v1alpha1.APIGroup + "/namespacesync-status"
+
+	// Reasons of the Events recorded on target namespaces and source ClusterClasses.
+	reasonSynced      = "ClusterClassesSynced"
+	reasonSyncFailed  = "ClusterClassSyncFailed"
+	reasonConflict    = "ClusterClassConflict"
+	reasonPruned      = "CopiesPruned"
+	reasonPruneFailed = "CopiesPruneFailed"
+)
+
+// syncStatus is the result of the last sync of a target namespace.
+type syncStatus struct {
+	// LastSyncTime is the time of the last sync.
+	LastSyncTime metav1.Time `json:"lastSyncTime"`
+
+	// ClusterClasses are the results of the last sync of each source ClusterClass.
+	ClusterClasses []clusterClassSyncStatus `json:"clusterClasses,omitempty"`
+}
+
+// clusterClassSyncStatus is the result of the last sync of a source ClusterClass to a target namespace.
+type clusterClassSyncStatus struct {
+	// Namespace is the namespace of the source ClusterClass.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the source ClusterClass.
+	Name string `json:"name"`
+
+	// LastSyncedTime is the last time the ClusterClass and its Templates were copied successfully. It is kept when a
+	// later sync fails.
+	LastSyncedTime *metav1.Time `json:"lastSyncedTime,omitempty"`
+
+	// Error is the error of the last sync, if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// newSyncStatus returns the status of the sync of the namespace from the results of copying the source
+// ClusterClasses, keeping the time of the previous successful copy of the ClusterClasses that failed to copy.
+func newSyncStatus(ns *corev1.Namespace, results []copyResult, now metav1.Time) syncStatus {
+	lastSyncedTimes := map[client.ObjectKey]*metav1.Time{}
+	if previous, ok := readSyncStatus(ns); ok {
+		for i := range previous.ClusterClasses {
+			ccStatus := &previous.ClusterClasses[i]
+			lastSyncedTimes[client.ObjectKey{Namespace: ccStatus.Namespace, Name: ccStatus.Name}] = ccStatus.LastSyncedTime
+		}
+	}
+
+	status := syncStatus{
+		LastSyncTime:   now,
+		ClusterClasses: make([]clusterClassSyncStatus, 0, len(results)),
+	}
+	for i := range results {
+		result := &results[i]
+		ccStatus := clusterClassSyncStatus{Namespace: result.source.Namespace, Name: result.source.Name}
+		if result.err != nil {
+			ccStatus.LastSyncedTime = lastSyncedTimes[client.ObjectKeyFromObject(result.source)]
+			ccStatus.Error = result.err.Error()
+		} else {
+			ccStatus.LastSyncedTime = &now
+		}
+		status.ClusterClasses = append(status.ClusterClasses, ccStatus)
+	}
+	return status
+}
+
+// sameResults returns true if the ClusterClasses of the statuses have the same results, ignoring the times of the
+// syncs.
+func (s *syncStatus) sameResults(other *syncStatus) bool {
+	return slices.EqualFunc(s.ClusterClasses, other.ClusterClasses, func(a, b clusterClassSyncStatus) bool {
+		return a.Namespace == b.Namespace && a.Name == b.Name && a.Error == b.Error
+	})
+}
+
+// readSyncStatus returns the status recorded on the namespace, if any. A malformed status is ignored, because it is
+// overwritten by the next sync.
+func readSyncStatus(ns *corev1.Namespace) (syncStatus, bool) {
+	status := syncStatus{}
+	value, ok := ns.Annotations[syncStatusAnnotationKey]
+	if !ok {
+		return status, false
+	}
+	if err := json.Unmarshal([]byte(value), &status); err != nil {
+		return syncStatus{}, false
+	}
+	return status, true
+}
+
+// writeSyncStatus records the status on the namespace. If status is nil, the recorded status is removed.
+func writeSyncStatus(ctx context.Context, w client.Writer, ns *corev1.Namespace, status *syncStatus) error {
+	original := ns.DeepCopy()
+	if status == nil {
+		if _, ok := ns.Annotations[syncStatusAnnotationKey]; !ok {
+			return nil
+		}
+		delete(ns.Annotations, syncStatusAnnotationKey)
+	} else {
+		value, err := json.Marshal(status)
+		if err != nil {
+			return fmt.Errorf("failed to marshal sync status: %w", err)
+		}
+		metav1.SetMetaDataAnnotation(&ns.ObjectMeta, syncStatusAnnotationKey, string(value))
+	}
+
+	if err := w.Patch(ctx, ns, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to record sync status on namespace %s: %w", ns.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacesync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewSyncStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	// metav1.Time is unmarshalled in the local time zone.
+	previousTime := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Local())
+	now := metav1.NewTime(previousTime.Add(time.Hour))
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: targetNamespace}}
+	g.Expect(writeSyncStatus(
+		context.Background(),
+		fake.NewClientBuilder().WithObjects(ns).Build(),
+		ns,
+		&syncStatus{
+			LastSyncTime: previousTime,
+			ClusterClasses: []clusterClassSyncStatus{
+				{Namespace: sourceNamespace, Name: "first", LastSyncedTime: &previousTime},
+				{Namespace: sourceNamespace, Name: "second", LastSyncedTime: &previousTime},
+			},
+		},
+	)).To(Succeed())
+
+	status := newSyncStatus(ns, []copyResult{
+		{source: newSourceClusterClass("first")},
+		{source: newSourceClusterClass("second"), err: errors.New("copy failed")},
+		{source: newSourceClusterClass("third"), err: errors.New("copy failed")},
+	}, now)
+
+	g.Expect(status).To(Equal(syncStatus{
+		LastSyncTime: now,
+		ClusterClasses: []clusterClassSyncStatus{
+			{Namespace: sourceNamespace, Name: "first", LastSyncedTime: &now},
+			{Namespace: sourceNamespace, Name: "second", LastSyncedTime: &previousTime, Error: "copy failed"},
+			{Namespace: sourceNamespace, Name: "third", Error: "copy failed"},
+		},
+	}))
+}
+
+func TestReadSyncStatusIgnoresMalformedStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	_, ok := readSyncStatus(&corev1.Namespace{})
+	g.Expect(ok).To(BeFalse())
+
+	_, ok = readSyncStatus(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{syncStatusAnnotationKey: "not json"},
+		},
+	})
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestReconcileContinuesPastFailures(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	first, firstTemplates := newTestClusterClassAndTemplates(sourceNamespace, "first")
+	second, secondTemplates := newTestClusterClassAndTemplates(sourceNamespace, "second")
+	third, thirdTemplates := newTestClusterClassAndTemplates(sourceNamespace, "third")
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   targetNamespace,
+			Labels: map[string]string{"target": ""},
+		},
+	}
+
+	// The second ClusterClass references a Template that does not exist.
+	initObjs := []client.Object{ns, first, second, third}
+	initObjs = append(initObjs, firstTemplates...)
+	initObjs = append(initObjs, secondTemplates[1:]...)
+	initObjs = append(initObjs, thirdTemplates...)
+
+	scheme := newCopyTestScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).Build()
+	recorder := record.NewFakeRecorder(10)
+
+	r := &Reconciler{
+		Client:                    fakeClient,
+		UnstructuredCachingClient: fakeClient,
+		Sources: []Source{{
+			Namespace:               sourceNamespace,
+			TargetNamespaceSelector: labels.SelectorFromSet(labels.Set{"target": ""}),
+		}},
+		Recorder: recorder,
+	}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: targetNamespace}})
+	g.Expect(err).To(MatchError(ContainSubstring("failed to copy source ClusterClass source-ns/second")))
+
+	// The other ClusterClasses are copied.
+	for _, cc := range []*clusterv1beta2.ClusterClass{first, third} {
+		g.Expect(fakeClient.Get(
+			ctx,
+			client.ObjectKey{Namespace: targetNamespace, Name: cc.Name},
+			&clusterv1beta2.ClusterClass{},
+		)).To(Succeed())
+	}
+
+	// The failure is reported on the namespace and on the source ClusterClass.
+	g.Expect(recorder.Events).To(HaveLen(2))
+	g.Expect(<-recorder.Events).To(HavePrefix("Warning ClusterClassSyncFailed Failed to copy ClusterClass"))
+	g.Expect(<-recorder.Events).To(HavePrefix("Warning ClusterClassSyncFailed Failed to copy to namespace"))
+
+	// The result of the sync of every ClusterClass is recorded on the namespace.
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ns), ns)).To(Succeed())
+	status, ok := readSyncStatus(ns)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(status.ClusterClasses).To(HaveLen(3))
+	for _, ccStatus := range status.ClusterClasses {
+		if ccStatus.Name == second.Name {
+			g.Expect(ccStatus.Error).ToNot(BeEmpty())
+			g.Expect(ccStatus.LastSyncedTime).To(BeNil())
+			continue
+		}
+		g.Expect(ccStatus.Error).To(BeEmpty())
+		g.Expect(ccStatus.LastSyncedTime).ToNot(BeNil())
+	}
+}
+
+func TestReconcileOnlyRecordsChanges(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	first, firstTemplates := newTestClusterClassAndTemplates(sourceNamespace, "first")
+	second, secondTemplates := newTestClusterClassAndTemplates(sourceNamespace, "second")
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   targetNamespace,
+			Labels: map[string]string{"target": ""},
+		},
+	}
+
+	initObjs := []client.Object{ns, first, second}
+	initObjs = append(initObjs, firstTemplates...)
+	initObjs = append(initObjs, secondTemplates...)
+
+	scheme := newCopyTestScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).Build()
+	recorder := record.NewFakeRecorder(10)
+
+	r := &Reconciler{
+		Client:                    fakeClient,
+		UnstructuredCachingClient: fakeClient,
+		Sources: []Source{{
+			Namespace:               sourceNamespace,
+			TargetNamespaceSelector: labels.SelectorFromSet(labels.Set{"target": ""}),
+		}},
+		Recorder: recorder,
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: targetNamespace}}
+
+	// The first sync copies the ClusterClasses.
+	_, err := r.Reconcile(ctx, req)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(recorder.Events).To(Receive(HavePrefix("Normal ClusterClassesSynced Synced 2 ClusterClasses")))
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ns), ns)).To(Succeed())
+	syncedStatus := ns.Annotations[syncStatusAnnotationKey]
+	g.Expect(syncedStatus).ToNot(BeEmpty())
+
+	// A sync of an up-to-date namespace does not update the status, or record an Event.
+	_, err = r.Reconcile(ctx, req)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(recorder.Events).To(BeEmpty())
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ns), ns)).To(Succeed())
+	g.Expect(ns.Annotations[syncStatusAnnotationKey]).To(Equal(syncedStatus))
+
+	// Deleting a source ClusterClass prunes its copy.
+	g.Expect(fakeClient.Delete(ctx, second)).To(Succeed())
+	_, err = r.Reconcile(ctx, req)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(recorder.Events).To(Receive(HavePrefix("Normal ClusterClassesSynced Synced 1 ClusterClasses")))
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ns), ns)).To(Succeed())
+	status, ok := readSyncStatus(ns)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(status.ClusterClasses).To(ConsistOf(HaveField("Name", first.Name)))
+}
+
+func newSourceClusterClass(name string) *clusterv1beta2.ClusterClass {
+	return &clusterv1beta2.ClusterClass{ObjectMeta: metav1.ObjectMeta{Namespace: sourceNamespace, Name: name}}
+}
@@ -66,10 +66,13 @@ func TestMain(m *testing.M) {
 		}
 
 		if err := (&Reconciler{
-			Client:                      mgr.GetClient(),
-			UnstructuredCachingClient:   unstructuredCachingClient,
-			SourceClusterClassNamespace: sourceClusterClassNamespace,
-			TargetNamespaceSelector:     targetLabelSelector,
+			Client:                    mgr.GetClient(),
+			UnstructuredCachingClient: unstructuredCachingClient,
+			Sources: []Source{{
+				Namespace:               sourceClusterClassNamespace,
+				TargetNamespaceSelector: targetLabelSelector,
+			}},
+			Recorder: mgr.GetEventRecorderFor("namespacesync"),
 		}).SetupWithManager(mgr, &controller.Options{MaxConcurrentReconciles: 1}); err != nil {
 			panic(fmt.Sprintf("unable to create reconciler: %v", err))
 		}
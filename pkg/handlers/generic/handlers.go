@@ -9,6 +9,7 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers"
 	genericclusterconfig "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/generic/clusterconfig"
 	genericmutation "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/generic/mutation"
+	genericvalidation "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/generic/validation"
 	genericmutationvprev "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/v6/generic/mutation"
 )
 
@@ -25,5 +26,6 @@ func (h *Handlers) AllHandlers(mgr manager.Manager) []handlers.Named {
 		genericmutationvprev.MetaPatchHandler(mgr),
 		genericmutation.MetaWorkerPatchHandler(mgr),
 		genericmutationvprev.MetaWorkerPatchHandler(mgr),
+		genericvalidation.NewTopologyValidator(),
 	}
 }
@@ -98,11 +98,13 @@ func (h *imageRegistriesPatchHandler) Mutate(
 		return globalMirrorErr
 	}
 
+	reader := mutation.ReaderFromContext(ctx, h.client)
+
 	registriesWithOptionalCredentials := make([]providerConfig, 0, len(imageRegistries))
 	for _, imageRegistry := range imageRegistries {
 		registryWithOptionalCredentials, generateErr := registryWithOptionalCredentialsFromImageRegistryCredentials(
 			ctx,
-			h.client,
+			reader,
 			imageRegistry,
 			obj,
 		)
@@ -119,7 +121,7 @@ func (h *imageRegistriesPatchHandler) Mutate(
 	if globalMirrorErr == nil {
 		mirrorCredentials, generateErr := mirrorWithOptionalCredentialsFromGlobalImageRegistryMirror(
 			ctx,
-			h.client,
+			reader,
 			globalMirror,
 			obj,
 		)
@@ -290,7 +292,7 @@ func ensureOwnerReferenceOnCredentialsSecrets(
 
 func registryWithOptionalCredentialsFromImageRegistryCredentials(
 	ctx context.Context,
-	c ctrlclient.Client,
+	c ctrlclient.Reader,
 	imageRegistry v1alpha1.ImageRegistry,
 	obj ctrlclient.Object,
 ) (providerConfig, error) {
@@ -323,7 +325,7 @@ func registryWithOptionalCredentialsFromImageRegistryCredentials(
 
 func mirrorWithOptionalCredentialsFromGlobalImageRegistryMirror(
 	ctx context.Context,
-	c ctrlclient.Client,
+	c ctrlclient.Reader,
 	mirror v1alpha1.GlobalImageRegistryMirror,
 	obj ctrlclient.Object,
 ) (providerConfig, error) {
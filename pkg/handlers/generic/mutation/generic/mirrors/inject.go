@@ -105,11 +105,13 @@ func (h *globalMirrorPatchHandler) Mutate(
 		return registryAddonErr
 	}
 
+	reader := mutation.ReaderFromContext(ctx, h.client)
+
 	var registriesWithOptionalCA []containerdConfig
 	if globalMirrorErr == nil {
 		registryConfig, err := containerdConfigFromGlobalMirror(
 			ctx,
-			h.client,
+			reader,
 			globalMirror,
 			obj,
 		)
@@ -121,7 +123,7 @@ func (h *globalMirrorPatchHandler) Mutate(
 	for _, imageRegistry := range imageRegistries {
 		registryWithOptionalCredentials, generateErr := containerdConfigFromImageRegistry(
 			ctx,
-			h.client,
+			reader,
 			imageRegistry,
 			obj,
 		)
@@ -146,7 +148,7 @@ func (h *globalMirrorPatchHandler) Mutate(
 
 		registryConfig, err := containerdConfigFromRegistryAddon(
 			ctx,
-			h.client,
+			reader,
 			cluster,
 		)
 		if err != nil {
@@ -204,7 +206,7 @@ func (h *globalMirrorPatchHandler) Mutate(
 
 func containerdConfigFromGlobalMirror(
 	ctx context.Context,
-	c ctrlclient.Client,
+	c ctrlclient.Reader,
 	globalMirror v1alpha1.GlobalImageRegistryMirror,
 	obj ctrlclient.Object,
 ) (containerdConfig, error) {
@@ -237,7 +239,7 @@ func containerdConfigFromGlobalMirror(
 
 func containerdConfigFromImageRegistry(
 	ctx context.Context,
-	c ctrlclient.Client,
+	c ctrlclient.Reader,
 	imageRegistry v1alpha1.ImageRegistry,
 	obj ctrlclient.Object,
 ) (containerdConfig, error) {
@@ -269,7 +271,7 @@ func containerdConfigFromImageRegistry(
 
 func containerdConfigFromRegistryAddon(
 	ctx context.Context,
-	c ctrlclient.Client,
+	c ctrlclient.Reader,
 	cluster *clusterv1.Cluster,
 ) (containerdConfig, error) {
 	serviceIP, err := registryutils.ServiceIPForCluster(cluster)
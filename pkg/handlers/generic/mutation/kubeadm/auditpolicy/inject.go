@@ -6,6 +6,8 @@ package auditpolicy
 import (
 	"context"
 	_ "embed"
+	"fmt"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -17,20 +19,52 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/mutation"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/patches"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/patches/selectors"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
 )
 
-type auditPolicyPatchHandler struct{}
+const (
+	// VariableName is the external patch variable name.
+	VariableName = "auditPolicy"
+
+	auditPolicyPath = "/etc/kubernetes/audit-policy.yaml"
+	// auditPolicyConfigMapKey is the key of the audit policy in the referenced ConfigMap.
+	auditPolicyConfigMapKey = "policy.yaml"
+
+	auditWebhookKubeconfigPath = "/etc/kubernetes/audit-webhook-kubeconfig.yaml"
+	// auditWebhookKubeconfigSecretKey is the key of the kubeconfig file in the referenced Secret.
+	auditWebhookKubeconfigSecretKey = "kubeconfig"
+
+	// Originally, we had 1 log of 100MB, and 10 rotated logs of 100MB each, for a total of 1100MB.
+	// We wanted to increase retention, but keep the total disk usage about the same.
+	// Now, we have 1 log of 100MB, and 90 compressed, rotated logs of approximately 10MB each,
+	// for a total of approximately 1000MB.
+	defaultAuditLogMaxAge    = 30
+	defaultAuditLogMaxBackup = 90
+	defaultAuditLogMaxSize   = 100
+)
+
+type auditPolicyPatchHandler struct {
+	client            client.Client
+	variableName      string
+	variableFieldPath []string
+}
 
 //go:embed embedded/apiserver-audit-policy.yaml
 var auditPolicy string
 
-const auditPolicyPath = "/etc/kubernetes/audit-policy.yaml"
-
-func NewPatch() *auditPolicyPatchHandler {
-	return &auditPolicyPatchHandler{}
+func NewPatch(cl client.Client) *auditPolicyPatchHandler {
+	return &auditPolicyPatchHandler{
+		client:       cl,
+		variableName: v1alpha1.ClusterConfigVariableName,
+		variableFieldPath: []string{
+			v1alpha1.ControlPlaneConfigVariableName,
+			VariableName,
+		},
+	}
 }
 
 func (h *auditPolicyPatchHandler) Mutate(
@@ -38,13 +72,35 @@ func (h *auditPolicyPatchHandler) Mutate(
 	obj *unstructured.Unstructured,
 	vars map[string]apiextensionsv1.JSON,
 	holderRef runtimehooksv1.HolderReference,
-	_ client.ObjectKey,
+	clusterKey client.ObjectKey,
 	_ mutation.ClusterGetter,
 ) error {
 	log := ctrl.LoggerFrom(ctx).WithValues(
 		"holderRef", holderRef,
 	)
 
+	// The built-in audit policy, and the default audit log retention, are used if the variable is not defined.
+	auditPolicyVar, err := variables.Get[v1alpha1.AuditPolicy](
+		vars,
+		h.variableName,
+		h.variableFieldPath...,
+	)
+	if err != nil {
+		if !variables.IsNotFoundError(err) {
+			return err
+		}
+		log.V(5).Info("audit policy variable not defined, using defaults")
+	}
+
+	log = log.WithValues(
+		"variableName",
+		h.variableName,
+		"variableFieldPath",
+		h.variableFieldPath,
+		"variableValue",
+		auditPolicyVar,
+	)
+
 	return patches.MutateIfApplicable(
 		obj, vars, &holderRef, selectors.ControlPlane(), log,
 		func(obj *controlplanev1.KubeadmControlPlaneTemplate) error {
@@ -53,42 +109,41 @@ func (h *auditPolicyPatchHandler) Mutate(
 				"patchedObjectName", client.ObjectKeyFromObject(obj),
 			).Info("adding files and updating API server extra args in kubeadm config spec")
 
+			policy, err := h.auditPolicy(ctx, auditPolicyVar, clusterKey.Namespace)
+			if err != nil {
+				return err
+			}
+
 			obj.Spec.Template.Spec.KubeadmConfigSpec.Files = append(
 				obj.Spec.Template.Spec.KubeadmConfigSpec.Files,
 				bootstrapv1.File{
 					Path:        auditPolicyPath,
 					Permissions: "0600",
-					Content:     auditPolicy,
+					Content:     policy,
 				},
 			)
+			if auditPolicyVar.Webhook != nil {
+				obj.Spec.Template.Spec.KubeadmConfigSpec.Files = append(
+					obj.Spec.Template.Spec.KubeadmConfigSpec.Files,
+					bootstrapv1.File{
+						Path:        auditWebhookKubeconfigPath,
+						Permissions: "0600",
+						ContentFrom: bootstrapv1.FileSource{
+							Secret: bootstrapv1.SecretFileSource{
+								Name: auditPolicyVar.Webhook.KubeconfigSecretRef.Name,
+								Key:  auditWebhookKubeconfigSecretKey,
+							},
+						},
+					},
+				)
+			}
 
 			apiServer := &obj.Spec.Template.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer
 			extraArgsMap := make(map[string]bool)
 			for _, arg := range apiServer.ExtraArgs {
 				extraArgsMap[arg.Name] = true
 			}
-			// Originally, we had 1 log of 100MB, and 10 rotated logs of 100MB each, for a total of 1100MB.
-			// We wanted to increase retention, but keep the total disk usage about the same.
-			// Now, we have 1 log of 100MB, and 90 compressed, rotated logs of approximately 10MB each,
-			// for a total of approximately 1000MB.
-			auditArgs := []bootstrapv1.Arg{
-				{Name: "audit-log-path", Value: ptr.To("/var/log/audit/kube-apiserver-audit.log")},
-				{
-					Name:  "audit-log-maxage",
-					Value: ptr.To("30"),
-				}, // Maximum number of days to retain audit log files.
-				{Name: "audit-log-maxbackup", Value: ptr.To("90")}, // Maximum number of audit log files to retain.
-				{
-					Name:  "audit-log-maxsize",
-					Value: ptr.To("100"),
-				}, // Maximum size of log file in MB before it is rotated.
-				{
-					Name:  "audit-log-compress",
-					Value: ptr.To("true"),
-				}, // Compress (gzip) audit log file when it is rotated.
-				{Name: "audit-policy-file", Value: ptr.To(auditPolicyPath)},
-			}
-			for _, arg := range auditArgs {
+			for _, arg := range auditArgs(auditPolicyVar) {
 				if !extraArgsMap[arg.Name] {
 					apiServer.ExtraArgs = append(apiServer.ExtraArgs, arg)
 					extraArgsMap[arg.Name] = true
@@ -96,7 +151,7 @@ func (h *auditPolicyPatchHandler) Mutate(
 			}
 
 			if apiServer.ExtraVolumes == nil {
-				apiServer.ExtraVolumes = make([]bootstrapv1.HostPathMount, 0, 2)
+				apiServer.ExtraVolumes = make([]bootstrapv1.HostPathMount, 0, 3)
 			}
 
 			apiServer.ExtraVolumes = append(
@@ -115,8 +170,124 @@ func (h *auditPolicyPatchHandler) Mutate(
 					PathType:  corev1.HostPathDirectoryOrCreate,
 				},
 			)
+			if auditPolicyVar.Webhook != nil {
+				apiServer.ExtraVolumes = append(
+					apiServer.ExtraVolumes,
+					bootstrapv1.HostPathMount{
+						Name:      "audit-webhook-kubeconfig",
+						HostPath:  auditWebhookKubeconfigPath,
+						MountPath: auditWebhookKubeconfigPath,
+						ReadOnly:  ptr.To(true),
+						PathType:  corev1.HostPathFile,
+					},
+				)
+			}
 
 			return nil
 		},
 	)
 }
+
+// auditPolicy returns the audit policy from the referenced ConfigMap, or the built-in audit policy if no
+// ConfigMap is referenced.
+func (h *auditPolicyPatchHandler) auditPolicy(
+	ctx context.Context,
+	auditPolicyVar v1alpha1.AuditPolicy,
+	namespace string,
+) (string, error) {
+	if auditPolicyVar.ConfigMapRef == nil {
+		return auditPolicy, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: namespace, Name: auditPolicyVar.ConfigMapRef.Name}
+	if err := mutation.ReaderFromContext(ctx, h.client).Get(ctx, key, configMap); err != nil {
+		return "", fmt.Errorf("failed to get audit policy ConfigMap %s: %w", key, err)
+	}
+	policy, ok := configMap.Data[auditPolicyConfigMapKey]
+	if !ok || policy == "" {
+		return "", fmt.Errorf(
+			"audit policy ConfigMap %s does not have the %q key",
+			key,
+			auditPolicyConfigMapKey,
+		)
+	}
+	return policy, nil
+}
+
+// auditArgs returns the API server args of the audit log, and of the audit webhook backend if it is enabled.
+func auditArgs(auditPolicyVar v1alpha1.AuditPolicy) []bootstrapv1.Arg {
+	auditLog := ptr.Deref(auditPolicyVar.Log, v1alpha1.AuditLog{})
+	args := []bootstrapv1.Arg{
+		{Name: "audit-log-path", Value: ptr.To("/var/log/audit/kube-apiserver-audit.log")},
+		{
+			Name:  "audit-log-maxage",
+			Value: ptr.To(strconv.Itoa(int(ptr.Deref(auditLog.MaxAge, defaultAuditLogMaxAge)))),
+		}, // Maximum number of days to retain audit log files.
+		{
+			Name:  "audit-log-maxbackup",
+			Value: ptr.To(strconv.Itoa(int(ptr.Deref(auditLog.MaxBackup, defaultAuditLogMaxBackup)))),
+		}, // Maximum number of audit log files to retain.
+		{
+			Name:  "audit-log-maxsize",
+			Value: ptr.To(strconv.Itoa(int(ptr.Deref(auditLog.MaxSize, defaultAuditLogMaxSize)))),
+		}, // Maximum size of log file in MB before it is rotated.
+		{
+			Name:  "audit-log-compress",
+			Value: ptr.To("true"),
+		}, // Compress (gzip) audit log file when it is rotated.
+		{Name: "audit-policy-file", Value: ptr.To(auditPolicyPath)},
+	}
+
+	webhook := auditPolicyVar.Webhook
+	if webhook == nil {
+		return args
+	}
+	args = append(args, bootstrapv1.Arg{
+		Name:  "audit-webhook-config-file",
+		Value: ptr.To(auditWebhookKubeconfigPath),
+	})
+	if webhook.Mode != "" {
+		args = append(args, bootstrapv1.Arg{Name: "audit-webhook-mode", Value: ptr.To(webhook.Mode)})
+	}
+	if webhook.InitialBackoff != nil {
+		args = append(args, bootstrapv1.Arg{
+			Name:  "audit-webhook-initial-backoff",
+			Value: ptr.To(webhook.InitialBackoff.Duration.String()),
+		})
+	}
+
+	batch := webhook.Batch
+	if batch == nil {
+		return args
+	}
+	if batch.BufferSize != nil {
+		args = append(args, int32Arg("audit-webhook-batch-buffer-size", *batch.BufferSize))
+	}
+	if batch.MaxSize != nil {
+		args = append(args, int32Arg("audit-webhook-batch-max-size", *batch.MaxSize))
+	}
+	if batch.MaxWait != nil {
+		args = append(args, bootstrapv1.Arg{
+			Name:  "audit-webhook-batch-max-wait",
+			Value: ptr.To(batch.MaxWait.Duration.String()),
+		})
+	}
+	if batch.ThrottleEnable != nil {
+		args = append(args, bootstrapv1.Arg{
+			Name:  "audit-webhook-batch-throttle-enable",
+			Value: ptr.To(strconv.FormatBool(*batch.ThrottleEnable)),
+		})
+	}
+	if batch.ThrottleQPS != nil {
+		args = append(args, int32Arg("audit-webhook-batch-throttle-qps", *batch.ThrottleQPS))
+	}
+	if batch.ThrottleBurst != nil {
+		args = append(args, int32Arg("audit-webhook-batch-throttle-burst", *batch.ThrottleBurst))
+	}
+	return args
+}
+
+func int32Arg(name string, value int32) bootstrapv1.Arg {
+	return bootstrapv1.Arg{Name: name, Value: ptr.To(strconv.Itoa(int(value)))}
+}
@@ -4,11 +4,21 @@
 package auditpolicy
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/mutation"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/testutils/capitest"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/testutils/capitest/request"
@@ -22,7 +32,7 @@ func TestAuditPolicyPatch(t *testing.T) {
 
 var _ = Describe("Generate Audit Policy patches", func() {
 	patchGenerator := func() mutation.GeneratePatches {
-		return mutation.NewMetaGeneratePatchesHandler("", helpers.TestEnv.Client, NewPatch()).(mutation.GeneratePatches)
+		return mutation.NewMetaGeneratePatchesHandler("", helpers.TestEnv.Client, NewPatch(helpers.TestEnv.Client)).(mutation.GeneratePatches)
 	}
 
 	testDefs := []capitest.PatchTestDef{
@@ -68,6 +78,79 @@ var _ = Describe("Generate Audit Policy patches", func() {
 				),
 			}},
 		},
+		{
+			Name: "auditpolicy set with retention and webhook backend for KubeadmControlPlaneTemplate",
+			Vars: []runtimehooksv1.Variable{
+				capitest.VariableWithValue(
+					v1alpha1.ClusterConfigVariableName,
+					v1alpha1.AuditPolicy{
+						Log: &v1alpha1.AuditLog{
+							MaxAge:    ptr.To[int32](7),
+							MaxBackup: ptr.To[int32](10),
+						},
+						Webhook: &v1alpha1.AuditWebhook{
+							KubeconfigSecretRef: v1alpha1.LocalObjectReference{Name: "audit-webhook"},
+							Mode:                "batch",
+						},
+					},
+					v1alpha1.ControlPlaneConfigVariableName,
+					VariableName,
+				),
+			},
+			RequestItem: request.NewKubeadmControlPlaneTemplateRequestItem(""),
+			ExpectedPatchMatchers: []capitest.JSONPatchMatcher{{
+				Operation: "add",
+				Path:      "/spec/template/spec/kubeadmConfigSpec/files",
+				ValueMatcher: gomega.ContainElements(
+					gomega.HaveKeyWithValue(
+						"path", "/etc/kubernetes/audit-policy.yaml",
+					),
+					gomega.SatisfyAll(
+						gomega.HaveKeyWithValue(
+							"path", "/etc/kubernetes/audit-webhook-kubeconfig.yaml",
+						),
+						gomega.HaveKeyWithValue(
+							"contentFrom",
+							map[string]any{
+								"secret": map[string]any{
+									"key":  "kubeconfig",
+									"name": "audit-webhook",
+								},
+							},
+						),
+					),
+				),
+			}, {
+				Operation: "add",
+				Path:      "/spec/template/spec/kubeadmConfigSpec/clusterConfiguration",
+				ValueMatcher: gomega.HaveKeyWithValue(
+					"apiServer",
+					gomega.SatisfyAll(
+						gomega.HaveKeyWithValue(
+							"extraArgs",
+							gomega.ContainElements(
+								map[string]any{"name": "audit-log-maxage", "value": "7"},
+								map[string]any{"name": "audit-log-maxbackup", "value": "10"},
+								map[string]any{"name": "audit-log-maxsize", "value": "100"},
+								map[string]any{
+									"name":  "audit-webhook-config-file",
+									"value": "/etc/kubernetes/audit-webhook-kubeconfig.yaml",
+								},
+								map[string]any{"name": "audit-webhook-mode", "value": "batch"},
+							),
+						),
+						gomega.HaveKeyWithValue(
+							"extraVolumes",
+							gomega.ContainElements(
+								gomega.HaveKeyWithValue("name", "audit-policy"),
+								gomega.HaveKeyWithValue("name", "audit-logs"),
+								gomega.HaveKeyWithValue("name", "audit-webhook-kubeconfig"),
+							),
+						),
+					),
+				),
+			}},
+		},
 	}
 
 	// create test node for each case
@@ -77,3 +160,133 @@ var _ = Describe("Generate Audit Policy patches", func() {
 		})
 	}
 })
+
+func Test_auditArgs(t *testing.T) {
+	testcases := []struct {
+		name        string
+		auditPolicy v1alpha1.AuditPolicy
+		want        map[string]string
+	}{
+		{
+			name: "defaults",
+			want: map[string]string{
+				"audit-log-path":      "/var/log/audit/kube-apiserver-audit.log",
+				"audit-log-maxage":    "30",
+				"audit-log-maxbackup": "90",
+				"audit-log-maxsize":   "100",
+				"audit-log-compress":  "true",
+				"audit-policy-file":   "/etc/kubernetes/audit-policy.yaml",
+			},
+		},
+		{
+			name: "retention and webhook backend",
+			auditPolicy: v1alpha1.AuditPolicy{
+				Log: &v1alpha1.AuditLog{
+					MaxAge:    ptr.To[int32](0),
+					MaxBackup: ptr.To[int32](5),
+					MaxSize:   ptr.To[int32](200),
+				},
+				Webhook: &v1alpha1.AuditWebhook{
+					KubeconfigSecretRef: v1alpha1.LocalObjectReference{Name: "audit-webhook"},
+					Mode:                "blocking",
+					InitialBackoff:      &metav1.Duration{Duration: 5 * time.Second},
+					Batch: &v1alpha1.AuditWebhookBatch{
+						BufferSize:     ptr.To[int32](20000),
+						MaxSize:        ptr.To[int32](100),
+						MaxWait:        &metav1.Duration{Duration: time.Minute},
+						ThrottleEnable: ptr.To(false),
+						ThrottleQPS:    ptr.To[int32](20),
+						ThrottleBurst:  ptr.To[int32](30),
+					},
+				},
+			},
+			want: map[string]string{
+				"audit-log-path":                      "/var/log/audit/kube-apiserver-audit.log",
+				"audit-log-maxage":                    "0",
+				"audit-log-maxbackup":                 "5",
+				"audit-log-maxsize":                   "200",
+				"audit-log-compress":                  "true",
+				"audit-policy-file":                   "/etc/kubernetes/audit-policy.yaml",
+				"audit-webhook-config-file":           "/etc/kubernetes/audit-webhook-kubeconfig.yaml",
+				"audit-webhook-mode":                  "blocking",
+				"audit-webhook-initial-backoff":       "5s",
+				"audit-webhook-batch-buffer-size":     "20000",
+				"audit-webhook-batch-max-size":        "100",
+				"audit-webhook-batch-max-wait":        "1m0s",
+				"audit-webhook-batch-throttle-enable": "false",
+				"audit-webhook-batch-throttle-qps":    "20",
+				"audit-webhook-batch-throttle-burst":  "30",
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]string{}
+			for _, arg := range auditArgs(tt.auditPolicy) {
+				got[arg.Name] = ptr.Deref(arg.Value, "")
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+
+func Test_auditPolicy(t *testing.T) {
+	configMaps := []ctrlclient.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "custom-policy", Namespace: request.Namespace},
+			Data:       map[string]string{"policy.yaml": "custom"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "missing-key", Namespace: request.Namespace},
+			Data:       map[string]string{"audit.yaml": "custom"},
+		},
+	}
+	h := NewPatch(fake.NewClientBuilder().WithObjects(configMaps...).Build())
+
+	testcases := []struct {
+		name        string
+		auditPolicy v1alpha1.AuditPolicy
+		want        string
+		wantErr     string
+	}{
+		{
+			name: "built-in audit policy",
+			want: auditPolicy,
+		},
+		{
+			name: "audit policy from ConfigMap",
+			auditPolicy: v1alpha1.AuditPolicy{
+				ConfigMapRef: &v1alpha1.LocalObjectReference{Name: "custom-policy"},
+			},
+			want: "custom",
+		},
+		{
+			name: "ConfigMap without the audit policy key",
+			auditPolicy: v1alpha1.AuditPolicy{
+				ConfigMapRef: &v1alpha1.LocalObjectReference{Name: "missing-key"},
+			},
+			wantErr: `does not have the "policy.yaml" key`,
+		},
+		{
+			name: "ConfigMap not found",
+			auditPolicy: v1alpha1.AuditPolicy{
+				ConfigMapRef: &v1alpha1.LocalObjectReference{Name: "not-found"},
+			},
+			wantErr: "failed to get audit policy ConfigMap",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := h.auditPolicy(context.Background(), tt.auditPolicy, request.Namespace)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
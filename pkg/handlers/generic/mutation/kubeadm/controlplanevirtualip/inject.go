@@ -114,9 +114,16 @@ func (h *ControlPlaneVirtualIP) Mutate(
 			}
 
 			var virtualIPProvider providers.Provider
-			// only kube-vip is supported, but more providers can be added in the future
-			if controlPlaneEndpointVar.VirtualIPSpec.Provider == v1alpha1.VirtualIPProviderKubeVIP {
+			switch controlPlaneEndpointVar.VirtualIPSpec.Provider {
+			case v1alpha1.VirtualIPProviderKubeVIP:
 				virtualIPProvider = providers.NewKubeVIPFromKCPTemplateProvider(obj)
+			case v1alpha1.VirtualIPProviderKeepalivedHAProxy:
+				virtualIPProvider = providers.NewKeepalivedHAProxyFromKCPTemplateProvider(obj)
+			default:
+				return fmt.Errorf(
+					"unknown control plane virtual IP provider %q",
+					controlPlaneEndpointVar.VirtualIPSpec.Provider,
+				)
 			}
 
 			files, preKubeadmCommands, postKubeadmCommands, generateErr := virtualIPProvider.GenerateFilesAndCommands(
@@ -136,6 +143,12 @@ func (h *ControlPlaneVirtualIP) Mutate(
 				virtualIPProvider.Name(),
 			))
 
+			// delete the template files of the other VirtualIP providers,
+			// as we do not want them to end up in the generated KCP
+			obj.Spec.Template.Spec.KubeadmConfigSpec.Files = deleteFiles(
+				obj.Spec.Template.Spec.KubeadmConfigSpec.Files,
+				otherProvidersFileNames(files)...,
+			)
 			obj.Spec.Template.Spec.KubeadmConfigSpec.Files = mergeFiles(
 				obj.Spec.Template.Spec.KubeadmConfigSpec.Files,
 				files...,
@@ -184,6 +197,19 @@ func deleteFiles(files []bootstrapv1.File, filePathsToDelete ...string) []bootst
 	return files
 }
 
+// otherProvidersFileNames returns the file paths of all VirtualIP providers,
+// except the paths of the files generated by the chosen provider.
+func otherProvidersFileNames(generatedFiles []bootstrapv1.File) []string {
+	return slices.DeleteFunc(
+		slices.Clone(providers.VirtualIPProviderFileNames),
+		func(path string) bool {
+			return slices.ContainsFunc(generatedFiles, func(file bootstrapv1.File) bool {
+				return file.Path == path
+			})
+		},
+	)
+}
+
 // mergeFiles will merge the files into the KubeadmControlPlaneTemplate,
 // overriding any file with the same path and appending the rest.
 func mergeFiles(files []bootstrapv1.File, filesToMerge ...bootstrapv1.File) []bootstrapv1.File {
@@ -527,3 +527,48 @@ func Test_mergeFiles(t *testing.T) {
 		})
 	}
 }
+
+func Test_otherProvidersFileNames(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		files             []bootstrapv1.File
+		expectedFileNames []string
+	}{
+		{
+			name: "should return the keepalived and HAProxy files for kube-vip",
+			files: []bootstrapv1.File{
+				{Path: "/etc/kubernetes/manifests/kube-vip.yaml"},
+				{Path: "/etc/caren/configure-for-kube-vip.sh"},
+			},
+			expectedFileNames: []string{
+				"/etc/kubernetes/manifests/keepalived.yaml",
+				"/etc/keepalived/keepalived.conf",
+				"/etc/keepalived/check-apiserver.sh",
+				"/etc/kubernetes/manifests/haproxy.yaml",
+				"/etc/haproxy/haproxy.cfg",
+			},
+		},
+		{
+			name: "should return the kube-vip file for keepalived and HAProxy",
+			files: []bootstrapv1.File{
+				{Path: "/etc/keepalived/keepalived.conf"},
+				{Path: "/etc/keepalived/check-apiserver.sh"},
+				{Path: "/etc/kubernetes/manifests/keepalived.yaml"},
+				{Path: "/etc/haproxy/haproxy.cfg"},
+				{Path: "/etc/kubernetes/manifests/haproxy.yaml"},
+			},
+			expectedFileNames: []string{
+				"/etc/kubernetes/manifests/kube-vip.yaml",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expectedFileNames, otherProvidersFileNames(tt.files))
+		})
+	}
+}
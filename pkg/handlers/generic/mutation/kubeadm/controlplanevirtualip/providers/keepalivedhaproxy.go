@@ -0,0 +1,242 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"cmp"
+	"context"
+	_ "embed"
+	"fmt"
+	"hash/fnv"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/common"
+)
+
+const (
+	keepalivedFilePath            = "/etc/kubernetes/manifests/keepalived.yaml"
+	keepalivedConfigFilePath      = "/etc/keepalived/keepalived.conf"
+	keepalivedCheckScriptFilePath = "/etc/keepalived/check-apiserver.sh"
+	haproxyFilePath               = "/etc/kubernetes/manifests/haproxy.yaml"
+	haproxyConfigFilePath         = "/etc/haproxy/haproxy.cfg"
+
+	keepalivedHAProxyFileOwner           = "root:root"
+	keepalivedHAProxyFilePermissions     = "0600"
+	keepalivedCheckScriptFilePermissions = "0700"
+	// The HAProxy container does not run as root, and must be able to read its configuration.
+	haproxyConfigFilePermissions            = "0644"
+	configureForKeepalivedScriptPermissions = "0700"
+
+	// DefaultAPIServerBindPort is the port the API server binds to, if the KubeadmControlPlaneTemplate
+	// does not set it.
+	DefaultAPIServerBindPort = 6443
+	// maxKeepalivedVirtualRouterID is the largest VRRP virtual router ID. Valid IDs are 1 to 255.
+	maxKeepalivedVirtualRouterID = 255
+	// keepalivedInterfacePlaceholder is replaced with the interface of the route to the virtual IP
+	// by the configure-for-keepalived.sh script, if the configuration does not set the interface.
+	keepalivedInterfacePlaceholder = "__VIRTUAL_IP_INTERFACE__"
+)
+
+var (
+	//go:embed templates/keepalived.yaml
+	keepalivedStaticPod string
+	//go:embed templates/keepalived.conf
+	keepalivedConfigTemplate string
+	//go:embed templates/check-apiserver.sh
+	keepalivedCheckScriptTemplate string
+	//go:embed templates/haproxy.yaml
+	haproxyStaticPod string
+	//go:embed templates/haproxy.cfg
+	haproxyConfigTemplate string
+	//go:embed templates/configure-for-keepalived.sh
+	configureForKeepalivedScript string
+
+	configureForKeepalivedScriptOnRemote = common.ConfigFilePathOnRemote(
+		"configure-for-keepalived.sh")
+)
+
+type keepalivedHAProxyFromKCPTemplateProvider struct {
+	template *controlplanev1.KubeadmControlPlaneTemplate
+}
+
+func NewKeepalivedHAProxyFromKCPTemplateProvider(
+	template *controlplanev1.KubeadmControlPlaneTemplate,
+) *keepalivedHAProxyFromKCPTemplateProvider {
+	return &keepalivedHAProxyFromKCPTemplateProvider{
+		template: template,
+	}
+}
+
+func (p *keepalivedHAProxyFromKCPTemplateProvider) Name() string {
+	return "keepalived-haproxy"
+}
+
+// GenerateFilesAndCommands returns files and pre kubeadm commands for keepalived and HAProxy.
+// keepalived assigns the virtual IP to a control plane machine with a healthy API server, using VRRP.
+// HAProxy listens on the virtual IP port and forwards connections to the API server on the same machine,
+// which listens on a different port.
+// The keepalived and HAProxy static Pods are read from the KCPTemplate, if it has them, and default to the embedded
+// static Pods otherwise.
+// If the configuration does not set the network interface, it also returns a script file and a pre kubeadm command
+// to set the interface of the route to the virtual IP in the keepalived configuration.
+func (p *keepalivedHAProxyFromKCPTemplateProvider) GenerateFilesAndCommands(
+	_ context.Context,
+	spec v1alpha1.ControlPlaneEndpointSpec,
+	cluster *clusterv1.Cluster,
+) (files []bootstrapv1.File, preKubeadmCommands, postKubeadmCommands []string, err error) {
+	var keepalivedHAProxyConfig v1alpha1.KeepalivedHAProxyConfiguration
+	if spec.VirtualIPSpec != nil && spec.VirtualIPSpec.KeepalivedHAProxy != nil {
+		keepalivedHAProxyConfig = *spec.VirtualIPSpec.KeepalivedHAProxy
+	}
+
+	apiServerPort, err := APIServerBindPort(p.template)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	input := keepalivedHAProxyTemplateInput{
+		Address:       spec.VirtualIPAddress(),
+		Port:          virtualIPPort(spec),
+		APIServerPort: apiServerPort,
+		Interface: cmp.Or(
+			keepalivedHAProxyConfig.Interface,
+			keepalivedInterfacePlaceholder,
+		),
+		VirtualRouterID: cmp.Or(
+			keepalivedHAProxyConfig.VirtualRouterID,
+			defaultKeepalivedVirtualRouterID(cluster),
+		),
+	}
+	if input.Port == input.APIServerPort {
+		return nil, nil, nil, fmt.Errorf(
+			"virtual IP port %d must be different from the API server bind port %d",
+			input.Port,
+			input.APIServerPort,
+		)
+	}
+
+	keepalivedConfig, err := executeTemplate(keepalivedConfigTemplate, input)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed templating keepalived configuration: %w", err)
+	}
+	keepalivedCheckScript, err := executeTemplate(keepalivedCheckScriptTemplate, input)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed templating keepalived check script: %w", err)
+	}
+	haproxyConfig, err := executeTemplate(haproxyConfigTemplate, input)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed templating HAProxy configuration: %w", err)
+	}
+
+	files = []bootstrapv1.File{
+		{
+			Content:     keepalivedConfig,
+			Owner:       keepalivedHAProxyFileOwner,
+			Path:        keepalivedConfigFilePath,
+			Permissions: keepalivedHAProxyFilePermissions,
+		},
+		{
+			Content:     keepalivedCheckScript,
+			Owner:       keepalivedHAProxyFileOwner,
+			Path:        keepalivedCheckScriptFilePath,
+			Permissions: keepalivedCheckScriptFilePermissions,
+		},
+		{
+			Content:     staticPodFromKCPTemplate(p.template, keepalivedFilePath, keepalivedStaticPod),
+			Owner:       keepalivedHAProxyFileOwner,
+			Path:        keepalivedFilePath,
+			Permissions: keepalivedHAProxyFilePermissions,
+		},
+		{
+			Content:     haproxyConfig,
+			Owner:       keepalivedHAProxyFileOwner,
+			Path:        haproxyConfigFilePath,
+			Permissions: haproxyConfigFilePermissions,
+		},
+		{
+			Content:     staticPodFromKCPTemplate(p.template, haproxyFilePath, haproxyStaticPod),
+			Owner:       keepalivedHAProxyFileOwner,
+			Path:        haproxyFilePath,
+			Permissions: keepalivedHAProxyFilePermissions,
+		},
+	}
+
+	if keepalivedHAProxyConfig.Interface != "" {
+		return files, nil, nil, nil
+	}
+
+	files = append(
+		files,
+		bootstrapv1.File{
+			Content:     configureForKeepalivedScript,
+			Path:        configureForKeepalivedScriptOnRemote,
+			Permissions: configureForKeepalivedScriptPermissions,
+		},
+	)
+	preKubeadmCommands = []string{
+		"/bin/bash " + configureForKeepalivedScriptOnRemote + " " + input.Address,
+	}
+
+	return files, preKubeadmCommands, nil, nil
+}
+
+// staticPodFromKCPTemplate returns the content of the static Pod file at the path in the KubeadmControlPlaneTemplate, or
+// the default static Pod if the KubeadmControlPlaneTemplate does not have the file. Like the kube-vip static Pod, the
+// keepalived and HAProxy static Pods can be customized in the ClusterClass, for example to use mirrored images.
+func staticPodFromKCPTemplate(
+	template *controlplanev1.KubeadmControlPlaneTemplate,
+	path, defaultStaticPod string,
+) string {
+	for _, file := range template.Spec.Template.Spec.KubeadmConfigSpec.Files {
+		if file.Path == path && file.Content != "" {
+			return file.Content
+		}
+	}
+	return defaultStaticPod
+}
+
+// defaultKeepalivedVirtualRouterID returns the VRRP virtual router ID used if the configuration does not set it. It is
+// derived from the namespace and name of the cluster, so that clusters in the same network are unlikely to use the same
+// ID, and does not change when the cluster is moved to another management cluster.
+func defaultKeepalivedVirtualRouterID(cluster *clusterv1.Cluster) int32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(cluster.Namespace + "/" + cluster.Name))
+	return int32(h.Sum32()%maxKeepalivedVirtualRouterID) + 1 //nolint:gosec // The value is between 1 and 255.
+}
+
+// APIServerBindPort returns the port the API server binds to on every control plane machine. The first machine binds
+// to the port of the InitConfiguration, and the machines that join later to the port of the JoinConfiguration, so they
+// must be the same, because every machine forwards the virtual IP port to the API server with the same configuration.
+func APIServerBindPort(template *controlplanev1.KubeadmControlPlaneTemplate) (int32, error) {
+	kubeadmConfigSpec := &template.Spec.Template.Spec.KubeadmConfigSpec
+	initBindPort := cmp.Or(kubeadmConfigSpec.InitConfiguration.LocalAPIEndpoint.BindPort, DefaultAPIServerBindPort)
+	joinBindPort := int32(DefaultAPIServerBindPort)
+	if kubeadmConfigSpec.JoinConfiguration.ControlPlane != nil {
+		joinBindPort = cmp.Or(
+			kubeadmConfigSpec.JoinConfiguration.ControlPlane.LocalAPIEndpoint.BindPort,
+			DefaultAPIServerBindPort,
+		)
+	}
+	if initBindPort != joinBindPort {
+		return 0, fmt.Errorf(
+			"API server bind port %d of the InitConfiguration must be the same as the bind port %d of the "+
+				"JoinConfiguration",
+			initBindPort,
+			joinBindPort,
+		)
+	}
+	return initBindPort, nil
+}
+
+type keepalivedHAProxyTemplateInput struct {
+	Address         string
+	Port            int32
+	APIServerPort   int32
+	Interface       string
+	VirtualRouterID int32
+}
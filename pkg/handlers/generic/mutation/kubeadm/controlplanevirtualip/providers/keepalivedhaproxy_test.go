@@ -0,0 +1,337 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package providers
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func Test_KeepalivedHAProxyGenerateFilesAndCommands(t *testing.T) {
+	t.Parallel()
+
+	cluster := &clusterv1beta2.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "test-namespace",
+		},
+		Spec: clusterv1beta2.ClusterSpec{
+			Topology: clusterv1beta2.Topology{
+				ClassRef: clusterv1beta2.ClusterClassRef{Name: "dummy-class"},
+				Version:  "v1.30.0",
+			},
+		},
+	}
+
+	tests := []struct {
+		name                       string
+		controlPlaneEndpointSpec   v1alpha1.ControlPlaneEndpointSpec
+		kcp                        *controlplanev1.KubeadmControlPlaneTemplate
+		expectedFiles              []bootstrapv1.File
+		expectedPreKubeadmCommands []string
+		expectedErr                string
+	}{
+		{
+			name: "should return templated files and a pre kubeadm command to set the interface",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "10.20.100.10",
+				Port: 8443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+				},
+			},
+			kcp: kubeadmControlPlaneTemplateWithFiles(t, nil),
+			expectedFiles: expectedKeepalivedHAProxyFiles(
+				expectedKeepalivedConfig("__VIRTUAL_IP_INTERFACE__", 171, "10.20.100.10"),
+				expectedCheckScript(8443),
+				expectedHAProxyConfig(8443, 6443),
+				bootstrapv1.File{
+					Content:     configureForKeepalivedScript,
+					Path:        configureForKeepalivedScriptOnRemote,
+					Permissions: configureForKeepalivedScriptPermissions,
+				},
+			),
+			expectedPreKubeadmCommands: []string{
+				"/bin/bash /etc/caren/configure-for-keepalived.sh 10.20.100.10",
+			},
+		},
+		{
+			name: "should return templated files with configuration overrides and the API server bind port",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "10.20.100.10",
+				Port: 6443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+					Configuration: &v1alpha1.ControlPlaneVirtualIPConfiguration{
+						Address: "172.20.100.10",
+						Port:    9443,
+					},
+					KeepalivedHAProxy: &v1alpha1.KeepalivedHAProxyConfiguration{
+						VirtualRouterID: 100,
+						Interface:       "eth1",
+					},
+				},
+			},
+			kcp: kubeadmControlPlaneTemplateWithBindPorts(t, 7443, 7443),
+			expectedFiles: expectedKeepalivedHAProxyFiles(
+				expectedKeepalivedConfig("eth1", 100, "172.20.100.10"),
+				expectedCheckScript(9443),
+				expectedHAProxyConfig(9443, 7443),
+			),
+		},
+		{
+			name: "should allow the default port when the API server binds to another port",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "10.20.100.10",
+				Port: 6443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+					KeepalivedHAProxy: &v1alpha1.KeepalivedHAProxyConfiguration{
+						VirtualRouterID: 100,
+						Interface:       "eth1",
+					},
+				},
+			},
+			kcp: kubeadmControlPlaneTemplateWithBindPorts(t, 7443, 7443),
+			expectedFiles: expectedKeepalivedHAProxyFiles(
+				expectedKeepalivedConfig("eth1", 100, "10.20.100.10"),
+				expectedCheckScript(6443),
+				expectedHAProxyConfig(6443, 7443),
+			),
+		},
+		{
+			name: "should fail when the API server bind port of joining machines is different",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "10.20.100.10",
+				Port: 8443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+				},
+			},
+			kcp: kubeadmControlPlaneTemplateWithBindPorts(t, 0, 8443),
+			expectedErr: "API server bind port 6443 of the InitConfiguration must be the same as the bind port 8443 of " +
+				"the JoinConfiguration",
+		},
+		{
+			name: "should fail when the virtual IP port is the API server bind port",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "10.20.100.10",
+				Port: 6443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+				},
+			},
+			kcp:         kubeadmControlPlaneTemplateWithFiles(t, nil),
+			expectedErr: "virtual IP port 6443 must be different from the API server bind port 6443",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := NewKeepalivedHAProxyFromKCPTemplateProvider(tt.kcp)
+
+			files, preKubeadmCommands, postKubeadmCommands, err := provider.GenerateFilesAndCommands(
+				context.Background(),
+				tt.controlPlaneEndpointSpec,
+				cluster,
+			)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFiles, files)
+			assert.Equal(t, tt.expectedPreKubeadmCommands, preKubeadmCommands)
+			assert.Empty(t, postKubeadmCommands)
+		})
+	}
+}
+
+func Test_KeepalivedHAProxyStaticPodsFromKCPTemplate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		customKeepalivedStaticPod = "keepalived static Pod with a mirrored image"
+		customHAProxyStaticPod    = "haproxy static Pod with a mirrored image"
+	)
+	kcp := kubeadmControlPlaneTemplateWithFiles(t, []bootstrapv1.File{
+		{Path: keepalivedFilePath, Content: customKeepalivedStaticPod},
+		{Path: haproxyFilePath, Content: customHAProxyStaticPod},
+	})
+
+	files, _, _, err := NewKeepalivedHAProxyFromKCPTemplateProvider(kcp).GenerateFilesAndCommands(
+		context.Background(),
+		v1alpha1.ControlPlaneEndpointSpec{
+			Host: "10.20.100.10",
+			Port: 8443,
+			VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+				Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+			},
+		},
+		&clusterv1beta2.Cluster{},
+	)
+	require.NoError(t, err)
+
+	contents := map[string]string{}
+	for _, file := range files {
+		contents[file.Path] = file.Content
+	}
+	assert.Equal(t, customKeepalivedStaticPod, contents[keepalivedFilePath])
+	assert.Equal(t, customHAProxyStaticPod, contents[haproxyFilePath])
+}
+
+func Test_defaultKeepalivedVirtualRouterID(t *testing.T) {
+	t.Parallel()
+
+	newCluster := func(name string) *clusterv1beta2.Cluster {
+		return &clusterv1beta2.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+			},
+		}
+	}
+
+	// The ID is derived from the namespace and name of the cluster, so it does not change, and differs between
+	// clusters.
+	assert.Equal(t, int32(171), defaultKeepalivedVirtualRouterID(newCluster("test-cluster")))
+	assert.Equal(t, int32(240), defaultKeepalivedVirtualRouterID(newCluster("other-cluster")))
+
+	for i := range 1000 {
+		id := defaultKeepalivedVirtualRouterID(newCluster("cluster-" + strconv.Itoa(i)))
+		assert.GreaterOrEqual(t, id, int32(1))
+		assert.LessOrEqual(t, id, int32(255))
+	}
+}
+
+func kubeadmControlPlaneTemplateWithBindPort(
+	t *testing.T, bindPort int32,
+) *controlplanev1.KubeadmControlPlaneTemplate {
+	t.Helper()
+	kcp := kubeadmControlPlaneTemplateWithFiles(t, nil)
+	kcp.Spec.Template.Spec.KubeadmConfigSpec.InitConfiguration.LocalAPIEndpoint.BindPort = bindPort
+	return kcp
+}
+
+func kubeadmControlPlaneTemplateWithBindPorts(
+	t *testing.T, initBindPort, joinBindPort int32,
+) *controlplanev1.KubeadmControlPlaneTemplate {
+	t.Helper()
+	kcp := kubeadmControlPlaneTemplateWithBindPort(t, initBindPort)
+	kcp.Spec.Template.Spec.KubeadmConfigSpec.JoinConfiguration.ControlPlane = &bootstrapv1.JoinControlPlane{
+		LocalAPIEndpoint: bootstrapv1.APIEndpoint{BindPort: joinBindPort},
+	}
+	return kcp
+}
+
+func expectedKeepalivedHAProxyFiles(
+	keepalivedConfig, checkScript, haproxyConfig string,
+	extraFiles ...bootstrapv1.File,
+) []bootstrapv1.File {
+	return append([]bootstrapv1.File{
+		{
+			Content:     keepalivedConfig,
+			Owner:       "root:root",
+			Path:        "/etc/keepalived/keepalived.conf",
+			Permissions: "0600",
+		},
+		{
+			Content:     checkScript,
+			Owner:       "root:root",
+			Path:        "/etc/keepalived/check-apiserver.sh",
+			Permissions: "0700",
+		},
+		{
+			Content:     keepalivedStaticPod,
+			Owner:       "root:root",
+			Path:        "/etc/kubernetes/manifests/keepalived.yaml",
+			Permissions: "0600",
+		},
+		{
+			Content:     haproxyConfig,
+			Owner:       "root:root",
+			Path:        "/etc/haproxy/haproxy.cfg",
+			Permissions: "0644",
+		},
+		{
+			Content:     haproxyStaticPod,
+			Owner:       "root:root",
+			Path:        "/etc/kubernetes/manifests/haproxy.yaml",
+			Permissions: "0600",
+		},
+	}, extraFiles...)
+}
+
+func expectedKeepalivedConfig(iface string, virtualRouterID int, address string) string {
+	return `global_defs {
+  enable_script_security
+  script_user root
+}
+
+vrrp_script check_apiserver {
+  script "/etc/keepalived/check-apiserver.sh"
+  interval 3
+  timeout 5
+  fall 3
+  rise 2
+}
+
+vrrp_instance API_SERVER {
+  state BACKUP
+  interface ` + iface + `
+  virtual_router_id ` + strconv.Itoa(virtualRouterID) + `
+  priority 100
+  advert_int 1
+  virtual_ipaddress {
+    ` + address + `
+  }
+  track_script {
+    check_apiserver
+  }
+}
+`
+}
+
+func expectedCheckScript(port int) string {
+	return `#!/bin/sh
+# Fails if the API server is not reachable through the HAProxy on this machine,
+# so that keepalived moves the virtual IP to another control plane machine.
+curl --silent --fail --insecure --max-time 2 --output /dev/null "https://localhost:` + strconv.Itoa(port) + `/healthz"
+`
+}
+
+func expectedHAProxyConfig(port, apiServerPort int) string {
+	return `global
+  log stdout format raw local0
+
+defaults
+  mode tcp
+  log global
+  option tcplog
+  timeout connect 10s
+  timeout client 1h
+  timeout server 1h
+
+frontend apiserver
+  bind *:` + strconv.Itoa(port) + `
+  default_backend apiserver
+
+backend apiserver
+  option httpchk GET /healthz
+  http-check expect status 200
+  server apiserver 127.0.0.1:` + strconv.Itoa(apiServerPort) + ` check check-ssl verify none inter 2s fall 3 rise 2
+`
+}
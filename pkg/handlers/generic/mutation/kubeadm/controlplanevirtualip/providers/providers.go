@@ -16,8 +16,14 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 )
 
+// VirtualIPProviderFileNames are the paths of the files of all virtual IP providers.
 var VirtualIPProviderFileNames = []string{
 	kubeVIPFilePath,
+	keepalivedFilePath,
+	keepalivedConfigFilePath,
+	keepalivedCheckScriptFilePath,
+	haproxyFilePath,
+	haproxyConfigFilePath,
 }
 
 // Provider is an interface for getting the virtual IP provider static Pod as a file.
@@ -34,30 +40,38 @@ func templateValues(
 	controlPlaneEndpoint v1alpha1.ControlPlaneEndpointSpec,
 	text string,
 ) (string, error) {
-	virtualIPTemplate, err := template.New("").Parse(text)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse template: %w", err)
-	}
-
 	type input struct {
 		Address string
 		Port    int32
 	}
 
-	// If specified, use the virtual IP address and/or port,
-	// otherwise fall back to the control plane endpoint host and port.
+	templateInput := input{
+		Address: controlPlaneEndpoint.VirtualIPAddress(),
+		Port:    virtualIPPort(controlPlaneEndpoint),
+	}
+
+	return executeTemplate(text, templateInput)
+}
+
+// virtualIPPort returns the virtual IP port if specified,
+// otherwise falls back to the control plane endpoint port.
+func virtualIPPort(controlPlaneEndpoint v1alpha1.ControlPlaneEndpointSpec) int32 {
 	var virtualIPConfig v1alpha1.ControlPlaneVirtualIPConfiguration
 	if controlPlaneEndpoint.VirtualIPSpec != nil &&
 		controlPlaneEndpoint.VirtualIPSpec.Configuration != nil {
 		virtualIPConfig = *controlPlaneEndpoint.VirtualIPSpec.Configuration
 	}
-	templateInput := input{
-		Address: cmp.Or(virtualIPConfig.Address, controlPlaneEndpoint.Host),
-		Port:    cmp.Or(virtualIPConfig.Port, controlPlaneEndpoint.Port),
+	return cmp.Or(virtualIPConfig.Port, controlPlaneEndpoint.Port)
+}
+
+func executeTemplate(text string, data any) (string, error) {
+	virtualIPTemplate, err := template.New("").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %w", err)
 	}
 
 	var b bytes.Buffer
-	err = virtualIPTemplate.Execute(&b, templateInput)
+	err = virtualIPTemplate.Execute(&b, data)
 	if err != nil {
 		return "", fmt.Errorf("failed setting API endpoint configuration in template: %w", err)
 	}
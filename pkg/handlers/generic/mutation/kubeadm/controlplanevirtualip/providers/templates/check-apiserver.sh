@@ -0,0 +1,4 @@
+#!/bin/sh
+# Fails if the API server is not reachable through the HAProxy on this machine,
+# so that keepalived moves the virtual IP to another control plane machine.
+curl --silent --fail --insecure --max-time 2 --output /dev/null "https://localhost:{{ .Port }}/healthz"
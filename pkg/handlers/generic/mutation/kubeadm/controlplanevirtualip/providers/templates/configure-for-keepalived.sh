@@ -0,0 +1,35 @@
+#!/bin/bash
+set -euo pipefail
+IFS=$'\n\t'
+
+SCRIPT_NAME="$(basename "${0}")"
+readonly SCRIPT_NAME
+
+declare -r KEEPALIVED_CONFIG_FILE="/etc/keepalived/keepalived.conf"
+declare -r INTERFACE_PLACEHOLDER="__VIRTUAL_IP_INTERFACE__"
+
+function print_usage {
+  cat >&2 <<EOF_USAGE
+Usage: ${SCRIPT_NAME} VIRTUAL_IP
+EOF_USAGE
+}
+
+function set_interface() {
+  local -r virtual_ip="${1}"
+  local interface
+  interface="$(ip -o route get "${virtual_ip}" |
+    awk '{for (i = 1; i < NF; i++) if ($i == "dev") { print $(i + 1); exit }}')"
+  if [[ -z ${interface} ]]; then
+    echo "failed to find the network interface of the route to ${virtual_ip}" >&2
+    exit 1
+  fi
+
+  sed -i "s#${INTERFACE_PLACEHOLDER}#${interface}#" "${KEEPALIVED_CONFIG_FILE}"
+}
+
+if [[ $# -ne 1 ]]; then
+  print_usage
+  exit 1
+fi
+
+set_interface "$1"
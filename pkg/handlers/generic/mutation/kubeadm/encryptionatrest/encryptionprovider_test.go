@@ -6,8 +6,10 @@ package encryptionatrest
 import (
 	"encoding/base64"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
 
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
@@ -39,6 +41,8 @@ func Test_encryptionConfigForSecretsAndConfigMaps(t *testing.T) {
 								},
 							},
 						},
+					},
+					{
 						Secretbox: &apiserverv1.SecretboxConfiguration{
 							Keys: []apiserverv1.Key{
 								{
@@ -73,6 +77,102 @@ func Test_encryptionConfigForSecretsAndConfigMaps(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "encryption configuration using kms provider and custom resources",
+			providers: &v1alpha1.EncryptionProviders{
+				KMS: &v1alpha1.KMSConfiguration{
+					Name:     "vault",
+					Endpoint: "unix:///var/run/kms-plugin/vault.sock",
+					Timeout:  &metav1.Duration{Duration: 5 * time.Second},
+				},
+				Resources: []string{"secrets", "externalsecrets.external-secrets.io"},
+			},
+			wantErr: nil,
+			want: &apiserverv1.ResourceConfiguration{
+				Resources: []string{"secrets", "externalsecrets.external-secrets.io"},
+				Providers: []apiserverv1.ProviderConfiguration{
+					{
+						KMS: &apiserverv1.KMSConfiguration{
+							APIVersion: "v2",
+							Name:       "vault",
+							Endpoint:   "unix:///var/run/kms-plugin/vault.sock",
+							Timeout:    &metav1.Duration{Duration: 5 * time.Second},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "encryption configuration using kms provider to encrypt and aescbc provider to decrypt",
+			providers: &v1alpha1.EncryptionProviders{
+				AESCBC: &v1alpha1.AESConfiguration{},
+				KMS: &v1alpha1.KMSConfiguration{
+					Name:     "vault",
+					Endpoint: "unix:///var/run/kms-plugin/vault.sock",
+				},
+			},
+			wantErr: nil,
+			want: &apiserverv1.ResourceConfiguration{
+				Resources: []string{"secrets", "configmaps"},
+				Providers: []apiserverv1.ProviderConfiguration{
+					{
+						KMS: &apiserverv1.KMSConfiguration{
+							APIVersion: "v2",
+							Name:       "vault",
+							Endpoint:   "unix:///var/run/kms-plugin/vault.sock",
+						},
+					},
+					{
+						AESCBC: &apiserverv1.AESConfiguration{
+							Keys: []apiserverv1.Key{
+								{
+									Name:   "key1",
+									Secret: base64.StdEncoding.EncodeToString([]byte(testToken)),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "encryption configuration using secretbox provider to encrypt and wildcard resources",
+			providers: &v1alpha1.EncryptionProviders{
+				AESCBC:    &v1alpha1.AESConfiguration{},
+				Secretbox: &v1alpha1.SecretboxConfiguration{},
+				Order: []v1alpha1.EncryptionProviderName{
+					v1alpha1.EncryptionProviderSecretbox,
+					v1alpha1.EncryptionProviderAESCBC,
+				},
+				Resources: []string{"*.cert-manager.io", "secrets"},
+			},
+			wantErr: nil,
+			want: &apiserverv1.ResourceConfiguration{
+				Resources: []string{"*.cert-manager.io", "secrets"},
+				Providers: []apiserverv1.ProviderConfiguration{
+					{
+						Secretbox: &apiserverv1.SecretboxConfiguration{
+							Keys: []apiserverv1.Key{
+								{
+									Name:   "key1",
+									Secret: base64.StdEncoding.EncodeToString([]byte(testToken)),
+								},
+							},
+						},
+					},
+					{
+						AESCBC: &apiserverv1.AESConfiguration{
+							Keys: []apiserverv1.Key{
+								{
+									Name:   "key1",
+									Secret: base64.StdEncoding.EncodeToString([]byte(testToken)),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range testcases {
@@ -85,3 +185,106 @@ func Test_encryptionConfigForSecretsAndConfigMaps(t *testing.T) {
 		})
 	}
 }
+
+func Test_mergeEncryptionConfiguration(t *testing.T) {
+	key := func(name, secret string) []apiserverv1.Key {
+		return []apiserverv1.Key{{Name: name, Secret: secret}}
+	}
+	existing := &apiserverv1.EncryptionConfiguration{
+		Resources: []apiserverv1.ResourceConfiguration{{
+			Resources: []string{"secrets", "configmaps"},
+			Providers: []apiserverv1.ProviderConfiguration{
+				{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key2", "existing")}},
+			},
+		}},
+	}
+
+	testcases := []struct {
+		name     string
+		existing *apiserverv1.EncryptionConfiguration
+		new      *apiserverv1.EncryptionConfiguration
+		want     *apiserverv1.EncryptionConfiguration
+	}{
+		{
+			name:     "keeps the existing keys",
+			existing: existing,
+			new: &apiserverv1.EncryptionConfiguration{
+				Resources: []apiserverv1.ResourceConfiguration{{
+					Resources: []string{"secrets", "configmaps"},
+					Providers: []apiserverv1.ProviderConfiguration{
+						{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key1", "new")}},
+					},
+				}},
+			},
+			want: existing,
+		},
+		{
+			name:     "adds the identity provider for resources that were not encrypted",
+			existing: existing,
+			new: &apiserverv1.EncryptionConfiguration{
+				Resources: []apiserverv1.ResourceConfiguration{{
+					Resources: []string{"secrets", "configmaps"},
+					Providers: []apiserverv1.ProviderConfiguration{
+						{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key1", "new")}},
+					},
+				}, {
+					Resources: []string{"*.cert-manager.io"},
+					Providers: []apiserverv1.ProviderConfiguration{
+						{Secretbox: &apiserverv1.SecretboxConfiguration{Keys: key("key1", "new")}},
+						{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key1", "new")}},
+					},
+				}},
+			},
+			want: &apiserverv1.EncryptionConfiguration{
+				Resources: []apiserverv1.ResourceConfiguration{{
+					Resources: []string{"secrets", "configmaps"},
+					Providers: []apiserverv1.ProviderConfiguration{
+						{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key2", "existing")}},
+					},
+				}, {
+					Resources: []string{"*.cert-manager.io"},
+					Providers: []apiserverv1.ProviderConfiguration{
+						{Secretbox: &apiserverv1.SecretboxConfiguration{Keys: key("key1", "new")}},
+						{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key2", "existing")}},
+						{Identity: &apiserverv1.IdentityConfiguration{}},
+					},
+				}},
+			},
+		},
+		{
+			name: "keeps the identity provider",
+			existing: &apiserverv1.EncryptionConfiguration{
+				Resources: []apiserverv1.ResourceConfiguration{{
+					Resources: []string{"secrets"},
+					Providers: []apiserverv1.ProviderConfiguration{
+						{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key1", "existing")}},
+						{Identity: &apiserverv1.IdentityConfiguration{}},
+					},
+				}},
+			},
+			new: &apiserverv1.EncryptionConfiguration{
+				Resources: []apiserverv1.ResourceConfiguration{{
+					Resources: []string{"secrets"},
+					Providers: []apiserverv1.ProviderConfiguration{
+						{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key1", "new")}},
+					},
+				}},
+			},
+			want: &apiserverv1.EncryptionConfiguration{
+				Resources: []apiserverv1.ResourceConfiguration{{
+					Resources: []string{"secrets"},
+					Providers: []apiserverv1.ProviderConfiguration{
+						{AESCBC: &apiserverv1.AESConfiguration{Keys: key("key1", "existing")}},
+						{Identity: &apiserverv1.IdentityConfiguration{}},
+					},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mergeEncryptionConfiguration(tt.existing, tt.new))
+		})
+	}
+}
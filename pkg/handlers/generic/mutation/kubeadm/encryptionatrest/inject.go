@@ -7,13 +7,17 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
 	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
 	"k8s.io/utils/ptr"
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
@@ -41,6 +45,14 @@ const (
 	defaultEncryptionSecretNameTemplate = "%s-encryption-config" //nolint:gosec // Does not contain hard coded credentials.
 	encryptionConfigurationOnRemote     = "/etc/kubernetes/pki/encryptionconfig.yaml"
 	apiServerEncryptionConfigArg        = "encryption-provider-config"
+
+	// InitialKeyName is the name of the key generated when the cluster is created.
+	InitialKeyName = "key1"
+
+	// ConfigurationUpdatedAnnotation is set on the encryption configuration Secret, with the time of the
+	// update, when the providers or resources of an existing cluster change, so that the control plane is
+	// rolled out with the updated configuration.
+	ConfigurationUpdatedAnnotation = v1alpha1.APIGroup + "/encryption-configuration-updated"
 )
 
 type encryptionPatchHandler struct {
@@ -100,25 +112,28 @@ func (h *encryptionPatchHandler) Mutate(
 				return err
 			}
 
-			found, err := h.defaultEncryptionSecretExists(ctx, cluster)
+			existingSecret, err := h.getDefaultEncryptionSecret(ctx, cluster)
 			if err != nil {
 				log.WithValues(
-					"defaultEncryptionSecret", defaultEncryptionSecretName(cluster.Name),
+					"defaultEncryptionSecret", DefaultEncryptionSecretName(cluster.Name),
 				).Error(err, "failed to find default encryption configuration secret")
 				return err
 			}
 
-			// we do not rotate or override the secret keys for encryption configuration
-			if !found {
-				encryptionConfig, err := h.generateEncryptionConfiguration(
-					encryptionVariable.Providers,
-				)
-				if err != nil {
-					return err
-				}
+			encryptionConfig, err := h.generateEncryptionConfiguration(
+				encryptionVariable.Providers,
+			)
+			if err != nil {
+				return err
+			}
+			// we do not override the secret keys for encryption configuration. The keys are rotated by the
+			// encryption key rotation controller.
+			if existingSecret == nil {
 				if err := h.createEncryptionConfigurationSecret(ctx, encryptionConfig, cluster); err != nil {
 					return err
 				}
+			} else if err := h.updateEncryptionConfigurationSecret(ctx, encryptionConfig, existingSecret); err != nil {
+				return err
 			}
 
 			log.WithValues(
@@ -131,6 +146,13 @@ func (h *encryptionPatchHandler) Mutate(
 				obj.Spec.Template.Spec.KubeadmConfigSpec.Files,
 				generateEncryptionCredentialsFile(cluster))
 
+			if err := applyKMSPlugins(
+				&obj.Spec.Template.Spec.KubeadmConfigSpec,
+				encryptionVariable.Providers,
+			); err != nil {
+				return err
+			}
+
 			// set APIServer args for encryption config
 			apiServer := &obj.Spec.Template.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer
 			hasEncryptionConfig := false
@@ -152,7 +174,7 @@ func (h *encryptionPatchHandler) Mutate(
 }
 
 func generateEncryptionCredentialsFile(cluster *clusterv1.Cluster) bootstrapv1.File {
-	secretName := defaultEncryptionSecretName(cluster.Name)
+	secretName := DefaultEncryptionSecretName(cluster.Name)
 	return bootstrapv1.File{
 		Path: encryptionConfigurationOnRemote,
 		ContentFrom: bootstrapv1.FileSource{
@@ -167,21 +189,28 @@ func generateEncryptionCredentialsFile(cluster *clusterv1.Cluster) bootstrapv1.F
 
 func (h *encryptionPatchHandler) generateEncryptionConfiguration(
 	providers []v1alpha1.EncryptionProviders,
+) (*apiserverv1.EncryptionConfiguration, error) {
+	return encryptionConfiguration(providers, h.keyGenerator)
+}
+
+// encryptionConfiguration returns the encryption configuration of the API server, with one resource
+// configuration for each entry of the providers.
+func encryptionConfiguration(
+	providers []v1alpha1.EncryptionProviders,
+	secretGenerator TokenGenerator,
 ) (*apiserverv1.EncryptionConfiguration, error) {
 	resourceConfigs := []apiserverv1.ResourceConfiguration{}
 	for _, encProvider := range providers {
 		provider := encProvider
 		resourceConfig, err := defaultEncryptionConfiguration(
 			&provider,
-			h.keyGenerator,
+			secretGenerator,
 		)
 		if err != nil {
 			return nil, err
 		}
 		resourceConfigs = append(resourceConfigs, *resourceConfig)
 	}
-	// We only support encryption for "secrets" and "configmaps" using "aescbc" provider.
-
 	return &apiserverv1.EncryptionConfiguration{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: apiserverv1.SchemeGroupVersion.String(),
@@ -191,11 +220,13 @@ func (h *encryptionPatchHandler) generateEncryptionConfiguration(
 	}, nil
 }
 
-func (h *encryptionPatchHandler) defaultEncryptionSecretExists(
+// getDefaultEncryptionSecret returns the encryption configuration Secret of the cluster, or nil if the
+// Secret does not exist.
+func (h *encryptionPatchHandler) getDefaultEncryptionSecret(
 	ctx context.Context,
 	cluster *clusterv1.Cluster,
-) (bool, error) {
-	secretName := defaultEncryptionSecretName(cluster.Name)
+) (*corev1.Secret, error) {
+	secretName := DefaultEncryptionSecretName(cluster.Name)
 	existingSecret := &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
@@ -206,14 +237,18 @@ func (h *encryptionPatchHandler) defaultEncryptionSecretExists(
 			Namespace: cluster.Namespace,
 		},
 	}
-	err := h.client.Get(ctx, ctrlclient.ObjectKeyFromObject(existingSecret), existingSecret)
+	err := mutation.ReaderFromContext(ctx, h.client).Get(
+		ctx,
+		ctrlclient.ObjectKeyFromObject(existingSecret),
+		existingSecret,
+	)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return false, nil
+			return nil, nil
 		}
-		return false, err
+		return nil, err
 	}
-	return true, nil
+	return existingSecret, nil
 }
 
 func (h *encryptionPatchHandler) createEncryptionConfigurationSecret(
@@ -229,7 +264,7 @@ func (h *encryptionPatchHandler) createEncryptionConfigurationSecret(
 	secretData := map[string]string{
 		SecretKeyForEtcdEncryption: strings.TrimSpace(string(dataYaml)),
 	}
-	secretName := defaultEncryptionSecretName(cluster.Name)
+	secretName := DefaultEncryptionSecretName(cluster.Name)
 	encryptionConfigSecret := &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
@@ -258,58 +293,205 @@ func (h *encryptionPatchHandler) createEncryptionConfigurationSecret(
 	return nil
 }
 
-// We only support encryption for "secrets" and "configmaps".
+// updateEncryptionConfigurationSecret updates the encryption configuration of an existing cluster when the
+// providers or resources change. The keys of the existing configuration are kept, see
+// mergeEncryptionConfiguration.
+func (h *encryptionPatchHandler) updateEncryptionConfigurationSecret(
+	ctx context.Context,
+	encryptionConfig *apiserverv1.EncryptionConfiguration,
+	existingSecret *corev1.Secret,
+) error {
+	data, ok := existingSecret.Data[SecretKeyForEtcdEncryption]
+	if !ok {
+		ctrl.LoggerFrom(ctx).V(5).Info(
+			"encryption configuration secret does not have an encryption configuration, skipping update",
+			"secret", ctrlclient.ObjectKeyFromObject(existingSecret),
+		)
+		return nil
+	}
+	existingConfig := &apiserverv1.EncryptionConfiguration{}
+	if err := yaml.Unmarshal(data, existingConfig); err != nil {
+		return fmt.Errorf("failed to unmarshal existing encryption configuration: %w", err)
+	}
+
+	mergedConfig := mergeEncryptionConfiguration(existingConfig, encryptionConfig)
+	if equality.Semantic.DeepEqual(existingConfig.Resources, mergedConfig.Resources) {
+		return nil
+	}
+
+	dataYaml, err := yaml.Marshal(mergedConfig)
+	if err != nil {
+		return fmt.Errorf("unable to marshal encryption configuration to YAML: %w", err)
+	}
+	secret := existingSecret.DeepCopy()
+	secret.Data[SecretKeyForEtcdEncryption] = []byte(strings.TrimSpace(string(dataYaml)))
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[ConfigurationUpdatedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if err := h.client.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update encryption configuration secret: %w", err)
+	}
+	return nil
+}
+
+// mergeEncryptionConfiguration returns the new encryption configuration of an existing cluster. Providers
+// keep the keys of the existing configuration, so that resources that are already encrypted can be
+// decrypted. Resources that were not encrypted before are stored in plain text, so the identity provider is
+// added after the providers of these resources, and kept once it is added.
+func mergeEncryptionConfiguration(
+	existingConfig, newConfig *apiserverv1.EncryptionConfiguration,
+) *apiserverv1.EncryptionConfiguration {
+	var (
+		aescbc    *apiserverv1.AESConfiguration
+		secretbox *apiserverv1.SecretboxConfiguration
+	)
+	existingResources := sets.New[string]()
+	identityResources := sets.New[string]()
+	for _, resourceConfig := range existingConfig.Resources {
+		existingResources.Insert(resourceConfig.Resources...)
+		for _, provider := range resourceConfig.Providers {
+			if provider.AESCBC != nil && aescbc == nil {
+				aescbc = provider.AESCBC
+			}
+			if provider.Secretbox != nil && secretbox == nil {
+				secretbox = provider.Secretbox
+			}
+			if provider.Identity != nil {
+				identityResources.Insert(resourceConfig.Resources...)
+			}
+		}
+	}
+
+	mergedConfig := newConfig.DeepCopy()
+	for i := range mergedConfig.Resources {
+		resourceConfig := &mergedConfig.Resources[i]
+		for j := range resourceConfig.Providers {
+			provider := &resourceConfig.Providers[j]
+			if provider.AESCBC != nil && aescbc != nil {
+				provider.AESCBC = aescbc.DeepCopy()
+			}
+			if provider.Secretbox != nil && secretbox != nil {
+				provider.Secretbox = secretbox.DeepCopy()
+			}
+		}
+		if !existingResources.HasAll(resourceConfig.Resources...) ||
+			identityResources.HasAny(resourceConfig.Resources...) {
+			resourceConfig.Providers = append(resourceConfig.Providers, apiserverv1.ProviderConfiguration{
+				Identity: &apiserverv1.IdentityConfiguration{},
+			})
+		}
+	}
+	return mergedConfig
+}
+
+// defaultEncryptionResources are the resources encrypted when the providers do not list resources.
+var defaultEncryptionResources = []string{"secrets", "configmaps"}
+
+// defaultProviderOrder is the order of the providers when the order is not set. The first provider is used
+// to encrypt, so the KMS provider is first, if it is set.
+var defaultProviderOrder = []v1alpha1.EncryptionProviderName{
+	v1alpha1.EncryptionProviderKMS,
+	v1alpha1.EncryptionProviderAESCBC,
+	v1alpha1.EncryptionProviderSecretbox,
+}
+
+// providerOrder returns the names of the providers that are set, in the configured order. Providers that
+// are set, but not listed in the order, follow in the default order.
+func providerOrder(providers *v1alpha1.EncryptionProviders) []v1alpha1.EncryptionProviderName {
+	order := slices.Clone(providers.Order)
+	for _, name := range defaultProviderOrder {
+		if !slices.Contains(order, name) {
+			order = append(order, name)
+		}
+	}
+	return slices.DeleteFunc(order, func(name v1alpha1.EncryptionProviderName) bool {
+		return !providerIsSet(providers, name)
+	})
+}
+
+func providerIsSet(providers *v1alpha1.EncryptionProviders, name v1alpha1.EncryptionProviderName) bool {
+	switch name {
+	case v1alpha1.EncryptionProviderAESCBC:
+		return providers.AESCBC != nil
+	case v1alpha1.EncryptionProviderSecretbox:
+		return providers.Secretbox != nil
+	case v1alpha1.EncryptionProviderKMS:
+		return providers.KMS != nil
+	default:
+		return false
+	}
+}
+
 func defaultEncryptionConfiguration(
 	providers *v1alpha1.EncryptionProviders,
 	secretGenerator TokenGenerator,
 ) (*apiserverv1.ResourceConfiguration, error) {
-	providerConfig := apiserverv1.ProviderConfiguration{}
-	// We only support "aescbc", "secretbox" for now.
-	// "aesgcm" is another AESConfiguration. "aesgcm" requires secret key rotation before 200k write calls.
-	// "aesgcm" should not be supported until secret key's rotation is implemented.
-	if providers.AESCBC != nil {
-		token, err := secretGenerator()
-		if err != nil {
-			return nil, fmt.Errorf(
-				"could not create random encryption token for aescbc provider: %w",
-				err,
-			)
-		}
-		providerConfig.AESCBC = &apiserverv1.AESConfiguration{
-			Keys: []apiserverv1.Key{
-				{
-					Name:   "key1", // we only support one key during cluster creation.
-					Secret: base64.StdEncoding.EncodeToString(token),
+	// The API server only allows one provider in each provider configuration.
+	providerConfigs := []apiserverv1.ProviderConfiguration{}
+	for _, name := range providerOrder(providers) {
+		switch name {
+		case v1alpha1.EncryptionProviderKMS:
+			providerConfigs = append(providerConfigs, kmsProviderConfiguration(providers.KMS))
+		// We only support "aescbc", "secretbox" for now.
+		// "aesgcm" is another AESConfiguration. "aesgcm" requires secret key rotation before 200k write calls.
+		// "aesgcm" should not be supported until keys are rotated automatically, because keys are only rotated
+		// on request.
+		case v1alpha1.EncryptionProviderAESCBC:
+			token, err := secretGenerator()
+			if err != nil {
+				return nil, fmt.Errorf(
+					"could not create random encryption token for aescbc provider: %w",
+					err,
+				)
+			}
+			providerConfigs = append(providerConfigs, apiserverv1.ProviderConfiguration{
+				AESCBC: &apiserverv1.AESConfiguration{
+					Keys: []apiserverv1.Key{
+						{
+							Name:   InitialKeyName, // we only support one key during cluster creation.
+							Secret: base64.StdEncoding.EncodeToString(token),
+						},
+					},
 				},
-			},
-		}
-	}
-	if providers.Secretbox != nil {
-		token, err := secretGenerator()
-		if err != nil {
-			return nil, fmt.Errorf(
-				"could not create random encryption token for secretbox provider: %w",
-				err,
-			)
-		}
-		providerConfig.Secretbox = &apiserverv1.SecretboxConfiguration{
-			Keys: []apiserverv1.Key{
-				{
-					Name:   "key1", // we only support one key during cluster creation.
-					Secret: base64.StdEncoding.EncodeToString(token),
+			})
+		case v1alpha1.EncryptionProviderSecretbox:
+			token, err := secretGenerator()
+			if err != nil {
+				return nil, fmt.Errorf(
+					"could not create random encryption token for secretbox provider: %w",
+					err,
+				)
+			}
+			providerConfigs = append(providerConfigs, apiserverv1.ProviderConfiguration{
+				Secretbox: &apiserverv1.SecretboxConfiguration{
+					Keys: []apiserverv1.Key{
+						{
+							Name:   InitialKeyName, // we only support one key during cluster creation.
+							Secret: base64.StdEncoding.EncodeToString(token),
+						},
+					},
 				},
-			},
+			})
 		}
 	}
 
 	return &apiserverv1.ResourceConfiguration{
-		Resources: []string{"secrets", "configmaps"},
-		Providers: []apiserverv1.ProviderConfiguration{
-			providerConfig,
-		},
+		Resources: encryptedResources(providers),
+		Providers: providerConfigs,
 	}, nil
 }
 
-func defaultEncryptionSecretName(clusterName string) string {
+// encryptedResources returns the resources encrypted by the providers.
+func encryptedResources(providers *v1alpha1.EncryptionProviders) []string {
+	if len(providers.Resources) > 0 {
+		return slices.Clone(providers.Resources)
+	}
+	return slices.Clone(defaultEncryptionResources)
+}
+
+// DefaultEncryptionSecretName returns the name of the Secret that holds the encryption configuration of
+// the cluster.
+func DefaultEncryptionSecretName(clusterName string) string {
 	return fmt.Sprintf(defaultEncryptionSecretNameTemplate, clusterName)
 }
@@ -38,6 +38,17 @@ resources:
         secret: dGVzdEFFU0NvbmZpZ0tleQ==
   resources:
   - secrets
+  - configmaps`
+	testKMSEncryptionConfigSecretData = `apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/kms-plugin/vault.sock
+      name: vault
+  resources:
+  - secrets
   - configmaps`
 )
 
@@ -94,7 +105,7 @@ var _ = Describe("Generate Encryption configuration patches", func() {
 						map[string]any{
 							"secret": map[string]any{
 								"key":  "config",
-								"name": defaultEncryptionSecretName(request.ClusterName),
+								"name": DefaultEncryptionSecretName(request.ClusterName),
 							},
 						},
 					),
@@ -220,12 +231,108 @@ var _ = Describe("Generate Encryption configuration patches", func() {
 				string(gotSecret.Data[SecretKeyForEtcdEncryption]))
 		})
 	})
+
+	// Test that the KMS plugin static pod is added, and the plugin socket is mounted into the API server.
+	patchKMSEncryptionConfigDef := capitest.PatchTestDef{
+		Name: "files added in KubeadmControlPlaneTemplate for KMS plugin",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				v1alpha1.EncryptionAtRest{
+					Providers: []v1alpha1.EncryptionProviders{
+						{
+							KMS: testKMSConfiguration(),
+						},
+					},
+				},
+				VariableName,
+			),
+		},
+		RequestItem: request.NewKubeadmControlPlaneTemplateRequestItem(""),
+		ExpectedPatchMatchers: []capitest.JSONPatchMatcher{
+			{
+				Operation: "add",
+				Path:      "/spec/template/spec/kubeadmConfigSpec/files",
+				ValueMatcher: ContainElements(
+					HaveKeyWithValue(
+						"path", "/etc/kubernetes/pki/encryptionconfig.yaml",
+					),
+					SatisfyAll(
+						HaveKeyWithValue(
+							"path", "/etc/kubernetes/manifests/kms-plugin-vault.yaml",
+						),
+						HaveKeyWithValue(
+							"content", testKMSPluginStaticPod,
+						),
+					),
+				),
+			},
+			{
+				Operation: "add",
+				Path:      "/spec/template/spec/kubeadmConfigSpec/clusterConfiguration",
+				ValueMatcher: HaveKeyWithValue(
+					"apiServer",
+					HaveKeyWithValue(
+						"extraVolumes",
+						ContainElement(
+							SatisfyAll(
+								HaveKeyWithValue("name", "kms-plugin-vault"),
+								HaveKeyWithValue("hostPath", "/var/run/kms-plugin"),
+								HaveKeyWithValue("mountPath", "/var/run/kms-plugin"),
+							),
+						),
+					),
+				),
+			},
+		},
+	}
+
+	Context("KMS encryption provider", func() {
+		// the encryption configuration may have been created by an earlier test
+		BeforeEach(func(ctx SpecContext) {
+			client, err := helpers.TestEnv.GetK8sClientWithScheme(clientScheme)
+			Expect(err).To(BeNil())
+
+			Expect(ctrlclient.IgnoreNotFound(client.Delete(
+				ctx,
+				testEncryptionSecretObj(),
+			))).To(BeNil())
+		})
+		// delete encryption configuration after the test
+		AfterEach(func(ctx SpecContext) {
+			client, err := helpers.TestEnv.GetK8sClientWithScheme(clientScheme)
+			Expect(err).To(BeNil())
+
+			Expect(client.Delete(
+				ctx,
+				testEncryptionSecretObj(),
+			)).To(BeNil())
+		})
+		It(patchKMSEncryptionConfigDef.Name, func(ctx SpecContext) {
+			capitest.AssertGeneratePatches(GinkgoT(), patchGenerator, &patchKMSEncryptionConfigDef)
+
+			// assert secret containing Encryption configuration is generated
+			client, err := helpers.TestEnv.GetK8sClientWithScheme(clientScheme)
+			Expect(err).To(BeNil())
+
+			gotSecret := testEncryptionSecretObj()
+			err = client.Get(
+				ctx,
+				ctrlclient.ObjectKeyFromObject(gotSecret),
+				gotSecret)
+			Expect(err).To(BeNil())
+			assert.Equal(
+				GinkgoT(),
+				testKMSEncryptionConfigSecretData,
+				string(gotSecret.Data[SecretKeyForEtcdEncryption]))
+		})
+	})
 })
 
 func testEncryptionSecretObj() *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      defaultEncryptionSecretName(request.ClusterName),
+			Name:      DefaultEncryptionSecretName(request.ClusterName),
 			Namespace: request.Namespace,
 		},
 	}
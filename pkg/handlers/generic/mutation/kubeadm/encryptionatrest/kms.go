@@ -0,0 +1,147 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionatrest
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"k8s.io/utils/ptr"
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+const (
+	kmsAPIVersion = "v2"
+
+	kmsPluginFileOwner               = "root:root"
+	kmsPluginFilePathTemplate        = "/etc/kubernetes/manifests/kms-plugin-%s.yaml"
+	kmsPluginFilePermissions         = "0600"
+	kmsPluginSocketVolumeName        = "kms-plugin-socket"
+	kmsPluginAPIServerVolumeTemplate = "kms-plugin-%s"
+)
+
+// kmsProviderConfiguration returns the KMS v2 provider configuration of the API server.
+func kmsProviderConfiguration(kms *v1alpha1.KMSConfiguration) apiserverv1.ProviderConfiguration {
+	return apiserverv1.ProviderConfiguration{
+		KMS: &apiserverv1.KMSConfiguration{
+			APIVersion: kmsAPIVersion,
+			Name:       kms.Name,
+			Endpoint:   kms.Endpoint,
+			Timeout:    kms.Timeout.DeepCopy(),
+		},
+	}
+}
+
+// kmsSocketDir returns the directory of the Unix domain socket of the KMS plugin endpoint.
+func kmsSocketDir(endpoint string) string {
+	return path.Dir(strings.TrimPrefix(endpoint, "unix://"))
+}
+
+// kmsAPIServerVolume mounts the directory of the KMS plugin socket into the API server.
+func kmsAPIServerVolume(kms *v1alpha1.KMSConfiguration) bootstrapv1.HostPathMount {
+	socketDir := kmsSocketDir(kms.Endpoint)
+	return bootstrapv1.HostPathMount{
+		Name:      fmt.Sprintf(kmsPluginAPIServerVolumeTemplate, kms.Name),
+		HostPath:  socketDir,
+		MountPath: socketDir,
+		PathType:  corev1.HostPathDirectoryOrCreate,
+	}
+}
+
+// kmsPluginFile returns the static pod manifest that runs the KMS plugin on control plane machines.
+func kmsPluginFile(kms *v1alpha1.KMSConfiguration) (bootstrapv1.File, error) {
+	name := "kms-plugin-" + kms.Name
+	socketDir := kmsSocketDir(kms.Endpoint)
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+			Labels: map[string]string{
+				"component": name,
+				"tier":      "control-plane",
+			},
+		},
+		Spec: corev1.PodSpec{
+			HostNetwork:       true,
+			PriorityClassName: "system-node-critical",
+			Containers: []corev1.Container{{
+				Name:  "kms-plugin",
+				Image: kms.Plugin.Image,
+				Args:  kms.Plugin.Args,
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      kmsPluginSocketVolumeName,
+					MountPath: socketDir,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: kmsPluginSocketVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{
+						Path: socketDir,
+						Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+					},
+				},
+			}},
+		},
+	}
+
+	manifest, err := yaml.Marshal(pod)
+	if err != nil {
+		return bootstrapv1.File{}, fmt.Errorf("failed to marshal KMS plugin static pod: %w", err)
+	}
+
+	return bootstrapv1.File{
+		Content:     string(manifest),
+		Owner:       kmsPluginFileOwner,
+		Path:        fmt.Sprintf(kmsPluginFilePathTemplate, kms.Name),
+		Permissions: kmsPluginFilePermissions,
+	}, nil
+}
+
+// applyKMSPlugins mounts the sockets of the KMS plugins into the API server, and adds the static pods of
+// the KMS plugins that are run on control plane machines.
+func applyKMSPlugins(
+	kubeadmConfigSpec *bootstrapv1.KubeadmConfigSpec,
+	providers []v1alpha1.EncryptionProviders,
+) error {
+	apiServer := &kubeadmConfigSpec.ClusterConfiguration.APIServer
+	for _, provider := range providers {
+		if provider.KMS == nil {
+			continue
+		}
+
+		volume := kmsAPIServerVolume(provider.KMS)
+		hasVolume := false
+		for _, v := range apiServer.ExtraVolumes {
+			if v.MountPath == volume.MountPath {
+				hasVolume = true
+				break
+			}
+		}
+		if !hasVolume {
+			apiServer.ExtraVolumes = append(apiServer.ExtraVolumes, volume)
+		}
+
+		if provider.KMS.Plugin == nil {
+			continue
+		}
+		file, err := kmsPluginFile(provider.KMS)
+		if err != nil {
+			return err
+		}
+		kubeadmConfigSpec.Files = append(kubeadmConfigSpec.Files, file)
+	}
+	return nil
+}
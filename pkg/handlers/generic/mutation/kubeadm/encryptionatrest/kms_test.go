@@ -0,0 +1,101 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionatrest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+const testKMSPluginStaticPod = `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    component: kms-plugin-vault
+    tier: control-plane
+  name: kms-plugin-vault
+  namespace: kube-system
+spec:
+  containers:
+  - args:
+    - --listen=unix:///var/run/kms-plugin/vault.sock
+    image: example.com/vault-kms-plugin:v1.0.0
+    name: kms-plugin
+    resources: {}
+    volumeMounts:
+    - mountPath: /var/run/kms-plugin
+      name: kms-plugin-socket
+  hostNetwork: true
+  priorityClassName: system-node-critical
+  volumes:
+  - hostPath:
+      path: /var/run/kms-plugin
+      type: DirectoryOrCreate
+    name: kms-plugin-socket
+status: {}
+`
+
+func testKMSConfiguration() *v1alpha1.KMSConfiguration {
+	return &v1alpha1.KMSConfiguration{
+		Name:     "vault",
+		Endpoint: "unix:///var/run/kms-plugin/vault.sock",
+		Plugin: &v1alpha1.KMSPlugin{
+			Image: "example.com/vault-kms-plugin:v1.0.0",
+			Args:  []string{"--listen=unix:///var/run/kms-plugin/vault.sock"},
+		},
+	}
+}
+
+func Test_kmsPluginFile(t *testing.T) {
+	file, err := kmsPluginFile(testKMSConfiguration())
+	require.NoError(t, err)
+	assert.Equal(t, bootstrapv1.File{
+		Path:        "/etc/kubernetes/manifests/kms-plugin-vault.yaml",
+		Owner:       "root:root",
+		Permissions: "0600",
+		Content:     testKMSPluginStaticPod,
+	}, file)
+}
+
+func Test_applyKMSPlugins(t *testing.T) {
+	withoutPlugin := testKMSConfiguration()
+	withoutPlugin.Name = "external"
+	withoutPlugin.Endpoint = "unix:///var/run/external-kms/socket.sock"
+	withoutPlugin.Plugin = nil
+
+	spec := &bootstrapv1.KubeadmConfigSpec{}
+	// The socket directory is already mounted into the API server.
+	spec.ClusterConfiguration.APIServer.ExtraVolumes = []bootstrapv1.HostPathMount{{
+		Name:      "existing",
+		HostPath:  "/var/run/external-kms",
+		MountPath: "/var/run/external-kms",
+	}}
+
+	require.NoError(t, applyKMSPlugins(spec, []v1alpha1.EncryptionProviders{
+		{AESCBC: &v1alpha1.AESConfiguration{}},
+		{KMS: testKMSConfiguration()},
+		{KMS: withoutPlugin},
+	}))
+
+	assert.Equal(t, []bootstrapv1.HostPathMount{{
+		Name:      "existing",
+		HostPath:  "/var/run/external-kms",
+		MountPath: "/var/run/external-kms",
+	}, {
+		Name:      "kms-plugin-vault",
+		HostPath:  "/var/run/kms-plugin",
+		MountPath: "/var/run/kms-plugin",
+		PathType:  corev1.HostPathDirectoryOrCreate,
+	}}, spec.ClusterConfiguration.APIServer.ExtraVolumes)
+
+	require.Len(t, spec.Files, 1)
+	assert.Equal(t, "/etc/kubernetes/manifests/kms-plugin-vault.yaml", spec.Files[0].Path)
+	assert.Equal(t, testKMSPluginStaticPod, spec.Files[0].Content)
+}
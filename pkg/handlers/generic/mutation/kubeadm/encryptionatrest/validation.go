@@ -0,0 +1,190 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionatrest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/apis/apiserver"
+	apiserverv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	apiservervalidation "k8s.io/apiserver/pkg/apis/apiserver/validation"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+// ValidateEncryptionAtRest validates the encryption at rest configuration of a cluster. oldEncryption is
+// the configuration before an update, or nil. If the configuration of an existing cluster changes, the API
+// server must still be able to decrypt every resource that was encrypted with the old configuration.
+func ValidateEncryptionAtRest(
+	encryption, oldEncryption *v1alpha1.EncryptionAtRest,
+	fldPath *field.Path,
+) field.ErrorList {
+	if encryption == nil {
+		if oldEncryption != nil {
+			return field.ErrorList{field.Forbidden(
+				fldPath,
+				"cannot be removed, because resources are encrypted with the existing configuration",
+			)}
+		}
+		return nil
+	}
+
+	providersPath := fldPath.Child("providers")
+	allErrs := validateProviders(encryption.Providers, providersPath)
+	if oldEncryption != nil {
+		allErrs = append(
+			allErrs,
+			validateEncryptedResourcesReadable(encryption.Providers, oldEncryption.Providers, providersPath)...,
+		)
+	}
+	return allErrs
+}
+
+// validateProviders validates the order of the providers, and the encryption configuration rendered from the
+// providers, with the validation of the API server.
+func validateProviders(providers []v1alpha1.EncryptionProviders, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	listedBy := map[string]int{}
+	for i := range providers {
+		orderPath := fldPath.Index(i).Child("order")
+		for j, name := range providers[i].Order {
+			if !providerIsSet(&providers[i], name) {
+				allErrs = append(allErrs, field.Invalid(orderPath.Index(j), name, "provider is not set"))
+			}
+		}
+		if len(providers[i].Order) > 0 {
+			for _, name := range defaultProviderOrder {
+				if providerIsSet(&providers[i], name) && !slices.Contains(providers[i].Order, name) {
+					allErrs = append(allErrs, field.Invalid(
+						orderPath,
+						providers[i].Order,
+						fmt.Sprintf("must list every provider that is set, %q is missing", name),
+					))
+				}
+			}
+		}
+
+		for j, resource := range encryptedResources(&providers[i]) {
+			if first, ok := listedBy[resource]; ok {
+				allErrs = append(allErrs, field.Invalid(
+					fldPath.Index(i).Child("resources").Index(j),
+					resource,
+					fmt.Sprintf("is already listed by %s", fldPath.Index(first)),
+				))
+				continue
+			}
+			listedBy[resource] = i
+		}
+	}
+
+	// The keys are not validated, so the configuration is rendered with keys of a valid length.
+	config, err := encryptionConfiguration(providers, RandomTokenGenerator)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+	// The API server sets the defaults of the configuration before it validates the configuration.
+	apiserverv1.SetObjectDefaults_EncryptionConfiguration(config)
+	internalConfig := &apiserver.EncryptionConfiguration{}
+	if err := apiserverv1.Convert_v1_EncryptionConfiguration_To_apiserver_EncryptionConfiguration(
+		config, internalConfig, nil,
+	); err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+	for _, validationErr := range apiservervalidation.ValidateEncryptionConfiguration(internalConfig, false) {
+		// Every entry of the providers is rendered as the resource configuration with the same index.
+		validationErr.Field = strings.Replace(validationErr.Field, "resources", fldPath.String(), 1)
+		allErrs = append(allErrs, validationErr)
+	}
+	return allErrs
+}
+
+// validateEncryptedResourcesReadable checks that every resource encrypted with the old providers is matched by
+// an entry of the new providers that includes the provider that encrypted the resource, so that the API server
+// can decrypt the resource.
+func validateEncryptedResourcesReadable(
+	providers, oldProviders []v1alpha1.EncryptionProviders,
+	fldPath *field.Path,
+) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	checked := sets.New[string]()
+	for i := range oldProviders {
+		order := providerOrder(&oldProviders[i])
+		if len(order) == 0 {
+			continue
+		}
+		encryptedBy := providerDescription(&oldProviders[i], order[0])
+
+		for _, resource := range encryptedResources(&oldProviders[i]) {
+			if checked.Has(resource) {
+				continue
+			}
+			checked.Insert(resource)
+
+			j := matchingProviders(providers, resource)
+			if j < 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf(
+					"resource %q is encrypted with the %s provider, and must remain encrypted, so that "+
+						"the API server can decrypt it",
+					resource,
+					encryptedBy,
+				)))
+				continue
+			}
+			if !slices.ContainsFunc(
+				providerOrder(&providers[j]),
+				func(name v1alpha1.EncryptionProviderName) bool {
+					return providerDescription(&providers[j], name) == encryptedBy
+				},
+			) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Index(j), fmt.Sprintf(
+					"must include the %s provider, because resource %q is encrypted with it",
+					encryptedBy,
+					resource,
+				)))
+			}
+		}
+	}
+	return allErrs
+}
+
+// matchingProviders returns the index of the providers that the API server uses for the resource, or -1 if no
+// providers match the resource. Like the API server, the providers that list the resource take precedence
+// over the providers that list the wildcard of the group of the resource, followed by the providers that list
+// the wildcard of all resources.
+func matchingProviders(providers []v1alpha1.EncryptionProviders, resource string) int {
+	gr := schema.ParseGroupResource(resource)
+	for _, candidate := range []schema.GroupResource{
+		gr,
+		{Group: gr.Group, Resource: "*"},
+		{Group: "*", Resource: "*"},
+	} {
+		for i := range providers {
+			if slices.ContainsFunc(encryptedResources(&providers[i]), func(r string) bool {
+				return schema.ParseGroupResource(r) == candidate
+			}) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// providerDescription describes the provider, including the name of a KMS provider, because the name is
+// stored with the encrypted data.
+func providerDescription(
+	providers *v1alpha1.EncryptionProviders,
+	name v1alpha1.EncryptionProviderName,
+) string {
+	if name == v1alpha1.EncryptionProviderKMS {
+		return fmt.Sprintf("%s %q", name, providers.KMS.Name)
+	}
+	return string(name)
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryptionatrest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func TestValidateEncryptionAtRest(t *testing.T) {
+	vault := func() *v1alpha1.KMSConfiguration {
+		return &v1alpha1.KMSConfiguration{
+			Name:     "vault",
+			Endpoint: "unix:///var/run/kms-plugin/vault.sock",
+		}
+	}
+
+	testcases := []struct {
+		name          string
+		encryption    *v1alpha1.EncryptionAtRest
+		oldEncryption *v1alpha1.EncryptionAtRest
+		wantErrs      []string
+	}{
+		{
+			name: "valid configuration with custom resources and wildcards",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC:    &v1alpha1.AESConfiguration{},
+					Resources: []string{"secrets", "*.cert-manager.io"},
+				}, {
+					Secretbox: &v1alpha1.SecretboxConfiguration{},
+					KMS:       vault(),
+					Order: []v1alpha1.EncryptionProviderName{
+						v1alpha1.EncryptionProviderSecretbox,
+						v1alpha1.EncryptionProviderKMS,
+					},
+					Resources: []string{"*.*"},
+				}},
+			},
+		},
+		{
+			name: "order lists a provider that is not set",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+					Order: []v1alpha1.EncryptionProviderName{
+						v1alpha1.EncryptionProviderSecretbox,
+						v1alpha1.EncryptionProviderAESCBC,
+					},
+				}},
+			},
+			wantErrs: []string{
+				`encryptionAtRest.providers[0].order[0]: Invalid value: "secretbox": provider is not set`,
+			},
+		},
+		{
+			name: "order does not list a provider that is set",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+					KMS:    vault(),
+					Order:  []v1alpha1.EncryptionProviderName{v1alpha1.EncryptionProviderAESCBC},
+				}},
+			},
+			wantErrs: []string{
+				`encryptionAtRest.providers[0].order: Invalid value: ["aescbc"]: ` +
+					`must list every provider that is set, "kms" is missing`,
+			},
+		},
+		{
+			name: "resource listed by more than one entry",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+				}, {
+					Secretbox: &v1alpha1.SecretboxConfiguration{},
+					Resources: []string{"configmaps"},
+				}},
+			},
+			wantErrs: []string{
+				`encryptionAtRest.providers[1].resources[0]: Invalid value: "configmaps": ` +
+					`is already listed by encryptionAtRest.providers[0]`,
+			},
+		},
+		{
+			name: "resources rejected by the API server",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC:    &v1alpha1.AESConfiguration{},
+					Resources: []string{"Secrets"},
+				}, {
+					Secretbox: &v1alpha1.SecretboxConfiguration{},
+					Resources: []string{"*"},
+				}},
+			},
+			wantErrs: []string{
+				`encryptionAtRest.providers[0].resources[0]: Invalid value: "Secrets": ` +
+					`resource name should not contain capital letters`,
+				`encryptionAtRest.providers[1].resources[0]: Invalid value: "*": ` +
+					`use '*.' to encrypt all the resources from core API group or *.* to encrypt all resources`,
+			},
+		},
+		{
+			name: "resources added on an existing cluster",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+				}, {
+					Secretbox: &v1alpha1.SecretboxConfiguration{},
+					Resources: []string{"*.cert-manager.io"},
+				}},
+			},
+			oldEncryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+				}},
+			},
+		},
+		{
+			name: "encrypted resources matched by a wildcard that includes the provider",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					KMS:       vault(),
+					AESCBC:    &v1alpha1.AESConfiguration{},
+					Resources: []string{"*."},
+				}},
+			},
+			oldEncryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+				}},
+			},
+		},
+		{
+			name: "encrypted resource removed on an existing cluster",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC:    &v1alpha1.AESConfiguration{},
+					Resources: []string{"secrets"},
+				}},
+			},
+			oldEncryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+				}},
+			},
+			wantErrs: []string{
+				`encryptionAtRest.providers: Forbidden: resource "configmaps" is encrypted with the aescbc ` +
+					`provider, and must remain encrypted, so that the API server can decrypt it`,
+			},
+		},
+		{
+			name: "provider that encrypted a resource removed on an existing cluster",
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+				}, {
+					KMS: &v1alpha1.KMSConfiguration{
+						Name:     "vault-2",
+						Endpoint: "unix:///var/run/kms-plugin/vault.sock",
+					},
+					Resources: []string{"*.cert-manager.io"},
+				}},
+			},
+			oldEncryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+				}, {
+					KMS:       vault(),
+					Resources: []string{"certificates.cert-manager.io"},
+				}},
+			},
+			wantErrs: []string{
+				`encryptionAtRest.providers[1]: Forbidden: must include the kms "vault" provider, ` +
+					`because resource "certificates.cert-manager.io" is encrypted with it`,
+			},
+		},
+		{
+			name: "encryption removed on an existing cluster",
+			oldEncryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC: &v1alpha1.AESConfiguration{},
+				}},
+			},
+			wantErrs: []string{
+				`encryptionAtRest: Forbidden: cannot be removed, because resources are encrypted with the ` +
+					`existing configuration`,
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateEncryptionAtRest(tt.encryption, tt.oldEncryption, field.NewPath(VariableName))
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tt.wantErrs, got)
+		})
+	}
+}
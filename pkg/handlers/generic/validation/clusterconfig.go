@@ -0,0 +1,110 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	apivariables "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+// validateClusterConfig decodes the clusterConfig variable and checks the cross-field rules that apply to it.
+func validateClusterConfig(vars map[string]apiextensionsv1.JSON) []preflight.Cause {
+	clusterConfig, err := variables.Get[apivariables.ClusterConfigSpec](
+		vars,
+		v1alpha1.ClusterConfigVariableName,
+	)
+	if err != nil {
+		if variables.IsNotFoundError(err) {
+			return nil
+		}
+		return []preflight.Cause{{
+			Message: fmt.Sprintf(
+				"Failed to unmarshal cluster variable %q: %s",
+				v1alpha1.ClusterConfigVariableName,
+				err,
+			),
+			Field: fmt.Sprintf("$.spec.topology.variables[?@.name==%q]", v1alpha1.ClusterConfigVariableName),
+		}}
+	}
+
+	return slices.Concat(
+		validateCNIAndKubeProxyMode(&clusterConfig),
+		validateCSIDefaultStorage(&clusterConfig),
+		validateControlPlaneTaints(&clusterConfig),
+	)
+}
+
+// validateCNIAndKubeProxyMode checks that kube-proxy is only disabled when the CNI provider is able to replace it.
+func validateCNIAndKubeProxyMode(clusterConfig *apivariables.ClusterConfigSpec) []preflight.Cause {
+	if clusterConfig.KubeProxy == nil || clusterConfig.KubeProxy.Mode != v1alpha1.KubeProxyModeDisabled {
+		return nil
+	}
+	if clusterConfig.Addons == nil || clusterConfig.Addons.CNI == nil {
+		return nil
+	}
+
+	if clusterConfig.Addons.CNI.Provider != v1alpha1.CNIProviderCilium {
+		return []preflight.Cause{{
+			Message: fmt.Sprintf(
+				"kube-proxy mode %q requires the %q CNI provider to replace kube-proxy, but the CNI provider is %q",
+				v1alpha1.KubeProxyModeDisabled,
+				v1alpha1.CNIProviderCilium,
+				clusterConfig.Addons.CNI.Provider,
+			),
+			Field: clusterConfigFieldPath("kubeProxy.mode"),
+		}}
+	}
+
+	return nil
+}
+
+// validateCSIDefaultStorage checks that the default storage references a configured CSI provider and one of
+// its storage class configs.
+func validateCSIDefaultStorage(clusterConfig *apivariables.ClusterConfigSpec) []preflight.Cause {
+	if clusterConfig.Addons == nil || clusterConfig.Addons.CSI == nil {
+		return nil
+	}
+	csi := clusterConfig.Addons.CSI
+	defaultStorage := csi.DefaultStorage
+
+	provider, ok := csi.Providers[defaultStorage.Provider]
+	if !ok {
+		return []preflight.Cause{{
+			Message: fmt.Sprintf(
+				"The default storage provider %q is not one of the configured CSI providers",
+				defaultStorage.Provider,
+			),
+			Field: clusterConfigFieldPath("addons.csi.defaultStorage.provider"),
+		}}
+	}
+
+	if _, ok := provider.StorageClassConfigs[defaultStorage.StorageClassConfig]; !ok {
+		return []preflight.Cause{{
+			Message: fmt.Sprintf(
+				"The default storage class config %q is not one of the storage class configs of the CSI provider %q",
+				defaultStorage.StorageClassConfig,
+				defaultStorage.Provider,
+			),
+			Field: clusterConfigFieldPath("addons.csi.defaultStorage.storageClassConfig"),
+		}}
+	}
+
+	return nil
+}
+
+// validateControlPlaneTaints checks the control plane taints for duplicates.
+func validateControlPlaneTaints(clusterConfig *apivariables.ClusterConfigSpec) []preflight.Cause {
+	if clusterConfig.ControlPlane == nil {
+		return nil
+	}
+
+	return validateTaints(clusterConfig.ControlPlane.Taints, clusterConfigFieldPath("controlPlane.taints"))
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+const (
+	// HandlerName is the name of the topology validation handler.
+	HandlerName = "GenericTopologyValidator"
+)
+
+type topologyValidator struct{}
+
+// NewTopologyValidator returns a handler that implements the ValidateTopology runtime hook. It validates
+// cross-field rules of the clusterConfig and workerConfig variables that cannot be expressed in the
+// variable schemas, so that semantic errors are surfaced before the topology is reconciled.
+func NewTopologyValidator() *topologyValidator {
+	return &topologyValidator{}
+}
+
+func (v *topologyValidator) Name() string {
+	return HandlerName
+}
+
+func (v *topologyValidator) ValidateTopology(
+	ctx context.Context,
+	req *runtimehooksv1.ValidateTopologyRequest,
+	resp *runtimehooksv1.ValidateTopologyResponse,
+) {
+	log := ctrl.LoggerFrom(ctx).WithValues("handlerName", HandlerName)
+
+	globalVars := variablesMap(req.Variables)
+
+	causes := validateClusterConfig(globalVars)
+
+	// The infrastructure and bootstrap templates of a MachineDeployment share the same variables, so only
+	// validate the variables of each MachineDeployment once.
+	validatedMachineDeployments := map[string]struct{}{}
+	for _, item := range req.Items {
+		if item == nil || item.HolderReference.Kind != "MachineDeployment" {
+			continue
+		}
+
+		itemVars := variablesMap(item.Variables)
+		topologyName, err := variables.Get[string](
+			itemVars,
+			runtimehooksv1.BuiltinsName,
+			"machineDeployment",
+			"topologyName",
+		)
+		if err != nil {
+			// Fall back to the MachineDeployment name if the builtin variable is not available.
+			topologyName = item.HolderReference.Name
+		}
+		if _, ok := validatedMachineDeployments[topologyName]; ok {
+			continue
+		}
+		validatedMachineDeployments[topologyName] = struct{}{}
+
+		causes = append(
+			causes,
+			validateWorkerConfig(globalVars, itemVars, topologyName)...,
+		)
+	}
+
+	if len(causes) == 0 {
+		resp.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+		return
+	}
+
+	statusCauses := make([]metav1.StatusCause, 0, len(causes))
+	for _, c := range causes {
+		statusCauses = append(statusCauses, metav1.StatusCause{
+			Message: c.Message,
+			Field:   c.Field,
+		})
+	}
+	message := fmt.Sprintf("topology validation failed: %s", preflight.SummarizeCauses(statusCauses))
+
+	log.V(5).Info("Topology validation failed", "causes", causes)
+
+	resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+	resp.SetMessage(message)
+}
+
+func variablesMap(vars []runtimehooksv1.Variable) map[string]apiextensionsv1.JSON {
+	varsMap := make(map[string]apiextensionsv1.JSON, len(vars))
+	for i := range vars {
+		varsMap[vars[i].Name] = vars[i].Value
+	}
+	return varsMap
+}
+
+func clusterConfigFieldPath(path string) string {
+	return fmt.Sprintf(
+		"$.spec.topology.variables[?@.name==%q].value.%s",
+		v1alpha1.ClusterConfigVariableName,
+		path,
+	)
+}
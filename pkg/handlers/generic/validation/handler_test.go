@@ -0,0 +1,339 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	apivariables "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/mutation"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/testutils/capitest"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/testutils/capitest/request"
+)
+
+func machineDeploymentRequestItem(
+	topologyName string,
+	vars ...runtimehooksv1.Variable,
+) runtimehooksv1.GeneratePatchesRequestItem {
+	item := request.NewKubeadmConfigTemplateRequestItem("")
+	item.HolderReference.Name = topologyName + "-abcde"
+	item.Variables = append(
+		[]runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				runtimehooksv1.BuiltinsName,
+				map[string]any{
+					"machineDeployment": map[string]any{
+						"topologyName": topologyName,
+					},
+				},
+			),
+		},
+		vars...,
+	)
+	return item
+}
+
+func TestValidateTopology(t *testing.T) {
+	t.Parallel()
+
+	validator := func() mutation.ValidateTopology {
+		return NewTopologyValidator()
+	}
+
+	testDefs := []capitest.ValidateTopologyTestDef{{
+		Name: "no variables",
+		RequestItems: []runtimehooksv1.GeneratePatchesRequestItem{
+			request.NewKubeadmControlPlaneTemplateRequestItem(""),
+		},
+	}, {
+		Name: "clusterConfig cannot be decoded",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				map[string]any{"kubeProxy": "invalid"},
+			),
+		},
+		ExpectedFailure: true,
+		MessageMatcher: gomega.And(
+			gomega.ContainSubstring("Failed to unmarshal cluster variable \"clusterConfig\""),
+			gomega.ContainSubstring(`(field: $.spec.topology.variables[?@.name=="clusterConfig"])`),
+		),
+	}, {
+		Name: "kube-proxy disabled with Cilium",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					KubeProxy: &v1alpha1.KubeProxy{Mode: v1alpha1.KubeProxyModeDisabled},
+					Addons: &apivariables.Addons{
+						CNI: &v1alpha1.CNI{Provider: v1alpha1.CNIProviderCilium},
+					},
+				},
+			),
+		},
+	}, {
+		Name: "kube-proxy disabled with Calico",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					KubeProxy: &v1alpha1.KubeProxy{Mode: v1alpha1.KubeProxyModeDisabled},
+					Addons: &apivariables.Addons{
+						CNI: &v1alpha1.CNI{Provider: v1alpha1.CNIProviderCalico},
+					},
+				},
+			),
+		},
+		ExpectedFailure: true,
+		MessageMatcher: gomega.ContainSubstring(
+			`(field: $.spec.topology.variables[?@.name=="clusterConfig"].value.kubeProxy.mode)`,
+		),
+	}, {
+		Name: "kube-proxy in nftables mode with Calico",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					KubeProxy: &v1alpha1.KubeProxy{Mode: v1alpha1.KubeProxyModeNFTables},
+					Addons: &apivariables.Addons{
+						CNI: &v1alpha1.CNI{Provider: v1alpha1.CNIProviderCalico},
+					},
+				},
+			),
+		},
+	}, {
+		Name: "CSI default storage provider is configured",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					Addons: &apivariables.Addons{
+						CSI: &apivariables.CSI{
+							GenericCSI: v1alpha1.GenericCSI{
+								DefaultStorage: v1alpha1.DefaultStorage{
+									Provider:           v1alpha1.CSIProviderNutanix,
+									StorageClassConfig: "volume",
+								},
+							},
+							Providers: map[string]v1alpha1.CSIProvider{
+								v1alpha1.CSIProviderNutanix: {
+									StorageClassConfigs: map[string]v1alpha1.StorageClassConfig{
+										"volume": {},
+									},
+								},
+							},
+						},
+					},
+				},
+			),
+		},
+	}, {
+		Name: "CSI default storage provider is not configured",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					Addons: &apivariables.Addons{
+						CSI: &apivariables.CSI{
+							GenericCSI: v1alpha1.GenericCSI{
+								DefaultStorage: v1alpha1.DefaultStorage{
+									Provider:           v1alpha1.CSIProviderLocalPath,
+									StorageClassConfig: "volume",
+								},
+							},
+							Providers: map[string]v1alpha1.CSIProvider{
+								v1alpha1.CSIProviderNutanix: {
+									StorageClassConfigs: map[string]v1alpha1.StorageClassConfig{
+										"volume": {},
+									},
+								},
+							},
+						},
+					},
+				},
+			),
+		},
+		ExpectedFailure: true,
+		MessageMatcher: gomega.ContainSubstring(
+			`(field: $.spec.topology.variables[?@.name=="clusterConfig"].value.addons.csi.defaultStorage.provider)`,
+		),
+	}, {
+		Name: "CSI default storage class config is not configured",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					Addons: &apivariables.Addons{
+						CSI: &apivariables.CSI{
+							GenericCSI: v1alpha1.GenericCSI{
+								DefaultStorage: v1alpha1.DefaultStorage{
+									Provider:           v1alpha1.CSIProviderNutanix,
+									StorageClassConfig: "missing",
+								},
+							},
+							Providers: map[string]v1alpha1.CSIProvider{
+								v1alpha1.CSIProviderNutanix: {
+									StorageClassConfigs: map[string]v1alpha1.StorageClassConfig{
+										"volume": {},
+									},
+								},
+							},
+						},
+					},
+				},
+			),
+		},
+		ExpectedFailure: true,
+		MessageMatcher: gomega.ContainSubstring(
+			`(field: $.spec.topology.variables[?@.name=="clusterConfig"].value.addons.csi.defaultStorage.storageClassConfig)`,
+		),
+	}, {
+		Name: "registry addon without global image registry mirror",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					Addons: &apivariables.Addons{
+						GenericAddons: v1alpha1.GenericAddons{
+							Registry: &v1alpha1.RegistryAddon{
+								Provider: v1alpha1.RegistryProviderCNCFDistribution,
+							},
+						},
+					},
+				},
+			),
+		},
+	}, {
+		Name: "registry addon with global image registry mirror",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					GenericClusterConfigSpec: v1alpha1.GenericClusterConfigSpec{
+						GlobalImageRegistryMirror: &v1alpha1.GlobalImageRegistryMirror{
+							URL: "https://mirror.example.com",
+						},
+					},
+					Addons: &apivariables.Addons{
+						GenericAddons: v1alpha1.GenericAddons{
+							Registry: &v1alpha1.RegistryAddon{
+								Provider: v1alpha1.RegistryProviderCNCFDistribution,
+							},
+						},
+					},
+				},
+			),
+		},
+	}, {
+		Name: "duplicate control plane taints",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					ControlPlane: &apivariables.ControlPlaneSpec{
+						GenericNodeSpec: v1alpha1.GenericNodeSpec{
+							Taints: []v1alpha1.Taint{
+								{Key: "key", Effect: v1alpha1.TaintEffectNoSchedule},
+								{Key: "key", Effect: v1alpha1.TaintEffectNoExecute},
+								{Key: "key", Value: "other", Effect: v1alpha1.TaintEffectNoSchedule},
+							},
+						},
+					},
+				},
+			),
+		},
+		ExpectedFailure: true,
+		MessageMatcher: gomega.ContainSubstring(
+			`(field: $.spec.topology.variables[?@.name=="clusterConfig"].value.controlPlane.taints[2])`,
+		),
+	}, {
+		Name: "duplicate worker taints in cluster variable",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.WorkerConfigVariableName,
+				apivariables.WorkerNodeConfigSpec{
+					GenericNodeSpec: v1alpha1.GenericNodeSpec{
+						Taints: []v1alpha1.Taint{
+							{Key: "key", Effect: v1alpha1.TaintEffectNoSchedule},
+							{Key: "key", Effect: v1alpha1.TaintEffectNoSchedule},
+						},
+					},
+				},
+			),
+		},
+		RequestItems: []runtimehooksv1.GeneratePatchesRequestItem{
+			machineDeploymentRequestItem("md-0"),
+			machineDeploymentRequestItem("md-0"),
+		},
+		ExpectedFailure: true,
+		MessageMatcher: gomega.And(
+			gomega.ContainSubstring(
+				`(field: $.spec.topology.variables[?@.name=="workerConfig"].value.taints[1])`,
+			),
+			// The variables of each MachineDeployment are validated once.
+			gomega.Not(gomega.ContainSubstring(";")),
+		),
+	}, {
+		Name: "duplicate worker taints in MachineDeployment override",
+		RequestItems: []runtimehooksv1.GeneratePatchesRequestItem{
+			machineDeploymentRequestItem("md-0"),
+			machineDeploymentRequestItem(
+				"md-1",
+				capitest.VariableWithValue(
+					v1alpha1.WorkerConfigVariableName,
+					apivariables.WorkerNodeConfigSpec{
+						GenericNodeSpec: v1alpha1.GenericNodeSpec{
+							Taints: []v1alpha1.Taint{
+								{Key: "key", Effect: v1alpha1.TaintEffectNoSchedule},
+								{Key: "key", Effect: v1alpha1.TaintEffectNoSchedule},
+							},
+						},
+					},
+				),
+			),
+		},
+		ExpectedFailure: true,
+		MessageMatcher: gomega.ContainSubstring(
+			`(field: $.spec.topology.workers.machineDeployments[?@.name=="md-1"].variables.overrides[?@.name=="workerConfig"].value.taints[1])`, //nolint:lll // Field path is long.
+		),
+	}, {
+		Name: "multiple causes are reported",
+		Vars: []runtimehooksv1.Variable{
+			capitest.VariableWithValue(
+				v1alpha1.ClusterConfigVariableName,
+				apivariables.ClusterConfigSpec{
+					KubeProxy: &v1alpha1.KubeProxy{Mode: v1alpha1.KubeProxyModeDisabled},
+					Addons: &apivariables.Addons{
+						CNI: &v1alpha1.CNI{Provider: v1alpha1.CNIProviderCalico},
+					},
+					ControlPlane: &apivariables.ControlPlaneSpec{
+						GenericNodeSpec: v1alpha1.GenericNodeSpec{
+							Taints: []v1alpha1.Taint{
+								{Key: "key", Effect: v1alpha1.TaintEffectNoSchedule},
+								{Key: "key", Effect: v1alpha1.TaintEffectNoSchedule},
+							},
+						},
+					},
+				},
+			),
+		},
+		ExpectedFailure: true,
+		MessageMatcher: gomega.And(
+			gomega.ContainSubstring("value.kubeProxy.mode"),
+			gomega.ContainSubstring("value.controlPlane.taints[1]"),
+		),
+	}}
+
+	for i := range testDefs {
+		tt := testDefs[i]
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+			capitest.AssertValidateTopology(t, validator, &tt)
+		})
+	}
+}
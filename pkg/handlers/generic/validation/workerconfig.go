@@ -0,0 +1,86 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	apivariables "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+// validateWorkerConfig decodes the workerConfig variable of a MachineDeployment and checks the cross-field rules
+// that apply to it. The MachineDeployment variable overrides take precedence over the Cluster variables.
+func validateWorkerConfig(
+	globalVars, machineDeploymentVars map[string]apiextensionsv1.JSON,
+	topologyName string,
+) []preflight.Cause {
+	vars := globalVars
+	fieldPathPrefix := fmt.Sprintf(
+		"$.spec.topology.variables[?@.name==%q].value",
+		v1alpha1.WorkerConfigVariableName,
+	)
+	if _, ok := machineDeploymentVars[v1alpha1.WorkerConfigVariableName]; ok {
+		vars = machineDeploymentVars
+		fieldPathPrefix = fmt.Sprintf(
+			"$.spec.topology.workers.machineDeployments[?@.name==%q].variables.overrides[?@.name==%q].value",
+			topologyName,
+			v1alpha1.WorkerConfigVariableName,
+		)
+	}
+
+	workerConfig, err := variables.Get[apivariables.WorkerNodeConfigSpec](
+		vars,
+		v1alpha1.WorkerConfigVariableName,
+	)
+	if err != nil {
+		if variables.IsNotFoundError(err) {
+			return nil
+		}
+		return []preflight.Cause{{
+			Message: fmt.Sprintf(
+				"Failed to unmarshal variable %q of MachineDeployment %q: %s",
+				v1alpha1.WorkerConfigVariableName,
+				topologyName,
+				err,
+			),
+			Field: fieldPathPrefix,
+		}}
+	}
+
+	return validateTaints(workerConfig.Taints, fieldPathPrefix+".taints")
+}
+
+// validateTaints checks that no two taints share the same key and effect, which the API server rejects when
+// the taints are applied to the Node.
+func validateTaints(taints []v1alpha1.Taint, fieldPath string) []preflight.Cause {
+	type taintKey struct {
+		key    string
+		effect v1alpha1.TaintEffect
+	}
+
+	var causes []preflight.Cause
+	seen := make(map[taintKey]struct{}, len(taints))
+	for i, taint := range taints {
+		k := taintKey{key: taint.Key, effect: taint.Effect}
+		if _, ok := seen[k]; ok {
+			causes = append(causes, preflight.Cause{
+				Message: fmt.Sprintf(
+					"Taint with key %q and effect %q is defined more than once",
+					taint.Key,
+					taint.Effect,
+				),
+				Field: fmt.Sprintf("%s[%d]", fieldPath, i),
+			})
+			continue
+		}
+		seen[k] = struct{}{}
+	}
+
+	return causes
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addons
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+// SetReadyCondition records the outcome of applying an addon as a condition of the given type on the
+// Cluster, so that addon failures remain visible on the Cluster after the hook response is gone.
+// Nothing is recorded if the response has no status, which is the case when the handler skipped the
+// addon because it is not configured for the cluster. Failing to record the condition is logged and
+// does not affect the response.
+func SetReadyCondition(
+	ctx context.Context,
+	c ctrlclient.Client,
+	cluster *clusterv1.Cluster,
+	conditionType string,
+	resp *runtimehooksv1.CommonResponse,
+) {
+	condition := metav1.Condition{
+		Type:   conditionType,
+		Status: metav1.ConditionTrue,
+		Reason: v1alpha1.AddonAppliedReason,
+	}
+	switch resp.GetStatus() {
+	case runtimehooksv1.ResponseStatusSuccess:
+	case runtimehooksv1.ResponseStatusFailure:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = v1alpha1.AddonApplyFailedReason
+		condition.Message = resp.GetMessage()
+	default:
+		return
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &clusterv1.Cluster{}
+		if err := c.Get(ctx, ctrlclient.ObjectKeyFromObject(cluster), latest); err != nil {
+			return err
+		}
+		original := latest.DeepCopy()
+
+		condition.ObservedGeneration = latest.GetGeneration()
+		if !meta.SetStatusCondition(&latest.Status.Conditions, condition) {
+			return nil
+		}
+
+		return c.Status().Patch(
+			ctx,
+			latest,
+			ctrlclient.MergeFromWithOptions(original, ctrlclient.MergeFromWithOptimisticLock{}),
+		)
+	})
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Error(
+			err,
+			"failed to set addon condition on cluster",
+			"cluster", ctrlclient.ObjectKeyFromObject(cluster),
+			"condition", conditionType,
+		)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addons
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func TestSetReadyCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		status            runtimehooksv1.ResponseStatus
+		message           string
+		expectedCondition *metav1.Condition
+	}{{
+		name: "addon skipped",
+	}, {
+		name:   "addon applied",
+		status: runtimehooksv1.ResponseStatusSuccess,
+		expectedCondition: &metav1.Condition{
+			Type:   v1alpha1.AddonCNIReadyCondition,
+			Status: metav1.ConditionTrue,
+			Reason: v1alpha1.AddonAppliedReason,
+		},
+	}, {
+		name:    "addon failed",
+		status:  runtimehooksv1.ResponseStatusFailure,
+		message: "failed to apply CNI",
+		expectedCondition: &metav1.Condition{
+			Type:    v1alpha1.AddonCNIReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1alpha1.AddonApplyFailedReason,
+			Message: "failed to apply CNI",
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-cluster",
+					Namespace:  "default",
+					Generation: 2,
+				},
+				Status: clusterv1.ClusterStatus{
+					Conditions: []metav1.Condition{{
+						Type:               clusterv1.ReadyCondition,
+						Status:             metav1.ConditionTrue,
+						Reason:             clusterv1.ReadyReason,
+						LastTransitionTime: metav1.Now(),
+					}},
+				},
+			}
+			c := newFakeClient(t, cluster)
+
+			resp := &runtimehooksv1.CommonResponse{}
+			resp.SetStatus(tt.status)
+			resp.SetMessage(tt.message)
+			SetReadyCondition(
+				context.Background(), c, cluster, v1alpha1.AddonCNIReadyCondition, resp,
+			)
+
+			got := &clusterv1.Cluster{}
+			require.NoError(t, c.Get(context.Background(), ctrlclient.ObjectKeyFromObject(cluster), got))
+			assert.NotNil(t, meta.FindStatusCondition(got.Status.Conditions, clusterv1.ReadyCondition))
+
+			condition := meta.FindStatusCondition(got.Status.Conditions, v1alpha1.AddonCNIReadyCondition)
+			if tt.expectedCondition == nil {
+				assert.Nil(t, condition)
+				return
+			}
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.expectedCondition.Status, condition.Status)
+			assert.Equal(t, tt.expectedCondition.Reason, condition.Reason)
+			assert.Equal(t, tt.expectedCondition.Message, condition.Message)
+			assert.Equal(t, cluster.Generation, condition.ObservedGeneration)
+		})
+	}
+}
+
+func TestSetReadyCondition_ClusterNotFound(t *testing.T) {
+	t.Parallel()
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+	}
+	c := newFakeClient(t)
+
+	resp := &runtimehooksv1.CommonResponse{}
+	resp.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+	// Failing to record the condition must not panic or change the response.
+	SetReadyCondition(context.Background(), c, cluster, v1alpha1.AddonCNIReadyCondition, resp)
+	assert.Equal(t, runtimehooksv1.ResponseStatusSuccess, resp.GetStatus())
+}
+
+func newFakeClient(t *testing.T, objs ...ctrlclient.Object) ctrlclient.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clusterv1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&clusterv1.Cluster{}).
+		Build()
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package addons provides the shared building blocks used by the lifecycle handlers to apply addons
+// to workload clusters and to report their status on the Cluster.
+//
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters/status,verbs=get;patch
+package addons
@@ -111,6 +111,7 @@ type applyOptions struct {
 	waiter             waiterFunc
 	hooks              hooksFuncs
 	takeOwnership      bool
+	valuesSource       *v1alpha1.AddonValues
 }
 
 type applyOption func(*applyOptions)
@@ -173,6 +174,17 @@ func (a *helmAddonApplier) WithTakeOwnership() *helmAddonApplier {
 	return a
 }
 
+// WithValuesSource sets the user-provided values of the addon. The values are read from the ConfigMap referenced by
+// the values source in the namespace of the Cluster, and replace or are merged over the default values template
+// depending on the mode of the values source.
+func (a *helmAddonApplier) WithValuesSource(valuesSource *v1alpha1.AddonValues) *helmAddonApplier {
+	a.opts = append(a.opts, func(o *applyOptions) {
+		o.valuesSource = valuesSource
+	})
+
+	return a
+}
+
 func (a *helmAddonApplier) Apply(
 	ctx context.Context,
 	cluster *clusterv1.Cluster,
@@ -198,26 +210,9 @@ func (a *helmAddonApplier) Apply(
 		configMapTemplateKey = defaultCiliumPreflightValuesTemplateKey
 	}
 
-	log.Info("Retrieving installation values template for cluster")
-	values, err := handlersutils.RetrieveValuesTemplate(
-		ctx,
-		a.client,
-		a.config.defaultValuesTemplateConfigMapName,
-		configMapTemplateKey,
-		defaultsNamespace,
-	)
+	values, valuesSources, err := a.values(ctx, cluster, defaultsNamespace, configMapTemplateKey, applyOpts, log)
 	if err != nil {
-		return fmt.Errorf(
-			"failed to retrieve installation values template for cluster: %w",
-			err,
-		)
-	}
-
-	if applyOpts.valueTemplater != nil {
-		values, err = applyOpts.valueTemplater(cluster, values)
-		if err != nil {
-			return fmt.Errorf("failed to template Helm values: %w", err)
-		}
+		return err
 	}
 
 	targetCluster := cluster
@@ -255,6 +250,17 @@ func (a *helmAddonApplier) Apply(
 		},
 	}
 
+	if debugValuesEnabled(cluster) {
+		metav1.SetMetaDataAnnotation(
+			&chartProxy.ObjectMeta,
+			v1alpha1.EffectiveValuesAnnotationKey,
+			effectiveValuesAnnotation(valuesSources, values),
+		)
+	}
+	if debugHelmChartsEnabled(cluster) {
+		metav1.SetMetaDataAnnotation(&chartProxy.ObjectMeta, v1alpha1.HelmChartSourceAnnotationKey, a.helmChart.Source)
+	}
+
 	handlersutils.SetTLSConfigForHelmChartProxyIfNeeded(chartProxy)
 	if err = controllerutil.SetOwnerReference(targetCluster, chartProxy, a.client.Scheme()); err != nil {
 		return fmt.Errorf(
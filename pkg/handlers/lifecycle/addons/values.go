@@ -0,0 +1,192 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addons
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	handlersutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/utils"
+)
+
+// values returns the templated Helm values of the addon, and the ConfigMaps they were read from in the order they
+// were merged.
+func (a *helmAddonApplier) values(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	defaultsNamespace string,
+	configMapKey string,
+	applyOpts *applyOptions,
+	log logr.Logger,
+) (string, []string, error) {
+	valuesSource := applyOpts.valuesSource
+	if valuesSource == nil || valuesSource.SourceRef == nil {
+		log.Info("Retrieving installation values template for cluster")
+		values, err := a.templatedValues(
+			ctx,
+			cluster,
+			a.config.defaultValuesTemplateConfigMapName,
+			configMapKey,
+			defaultsNamespace,
+			applyOpts,
+		)
+		if err != nil {
+			return "", nil, err
+		}
+		return values, []string{defaultsNamespace + "/" + a.config.defaultValuesTemplateConfigMapName}, nil
+	}
+
+	sourceRef := valuesSource.SourceRef
+	err := handlersutils.EnsureClusterOwnerReferenceForObject(
+		ctx,
+		a.client,
+		corev1.TypedLocalObjectReference{
+			Kind: sourceRef.Kind,
+			Name: sourceRef.Name,
+		},
+		cluster,
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf(
+			"failed to set Cluster's owner reference on Helm values source %s %q: %w",
+			sourceRef.Kind,
+			sourceRef.Name,
+			err,
+		)
+	}
+
+	// Values.SourceRef is always a local object reference in the namespace of the Cluster.
+	log.Info("Retrieving user-provided values for cluster", "name", sourceRef.Name, "mode", valuesSource.Mode)
+	userValues, err := a.templatedValues(ctx, cluster, sourceRef.Name, configMapKey, cluster.Namespace, applyOpts)
+	if err != nil {
+		return "", nil, err
+	}
+	userValuesSource := cluster.Namespace + "/" + sourceRef.Name
+
+	if valuesSource.Mode != v1alpha1.AddonValuesModeMerge {
+		return userValues, []string{userValuesSource}, nil
+	}
+
+	log.Info("Retrieving installation values template for cluster to merge user-provided values over")
+	defaultValues, err := a.templatedValues(
+		ctx,
+		cluster,
+		a.config.defaultValuesTemplateConfigMapName,
+		configMapKey,
+		defaultsNamespace,
+		applyOpts,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	values, err := mergeValues(defaultValues, userValues)
+	if err != nil {
+		return "", nil, fmt.Errorf(
+			"failed to merge Helm values from ConfigMap %s over the default values: %w",
+			userValuesSource,
+			err,
+		)
+	}
+	return values, []string{
+		defaultsNamespace + "/" + a.config.defaultValuesTemplateConfigMapName,
+		userValuesSource,
+	}, nil
+}
+
+// templatedValues retrieves the values template from the ConfigMap, and templates it with the value templater.
+func (a *helmAddonApplier) templatedValues(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	configMapName string,
+	configMapKey string,
+	namespace string,
+	applyOpts *applyOptions,
+) (string, error) {
+	values, err := handlersutils.RetrieveValuesTemplate(
+		ctx,
+		a.client,
+		configMapName,
+		configMapKey,
+		namespace,
+	)
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to retrieve installation values template for cluster: %w",
+			err,
+		)
+	}
+
+	if applyOpts.valueTemplater != nil {
+		values, err = applyOpts.valueTemplater(cluster, values)
+		if err != nil {
+			return "", fmt.Errorf("failed to template Helm values: %w", err)
+		}
+	}
+
+	return values, nil
+}
+
+// mergeValues deep-merges the overrides over the values. Maps are merged recursively, while lists and scalars of the
+// overrides replace those of the values. As with Helm, a null override removes the value.
+func mergeValues(values, overrides string) (string, error) {
+	valuesObj := map[string]any{}
+	if err := yaml.Unmarshal([]byte(values), &valuesObj); err != nil {
+		return "", fmt.Errorf("failed to parse default values: %w", err)
+	}
+	overridesObj := map[string]any{}
+	if err := yaml.Unmarshal([]byte(overrides), &overridesObj); err != nil {
+		return "", fmt.Errorf("failed to parse user-provided values: %w", err)
+	}
+
+	merged, err := yaml.Marshal(mergeMaps(valuesObj, overridesObj))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal merged values: %w", err)
+	}
+	return string(merged), nil
+}
+
+func mergeMaps(values, overrides map[string]any) map[string]any {
+	merged := make(map[string]any, len(values))
+	for k, v := range values {
+		merged[k] = v
+	}
+	for k, override := range overrides {
+		if override == nil {
+			delete(merged, k)
+			continue
+		}
+		value, valueIsMap := merged[k].(map[string]any)
+		overrideMap, overrideIsMap := override.(map[string]any)
+		if valueIsMap && overrideIsMap {
+			merged[k] = mergeMaps(value, overrideMap)
+			continue
+		}
+		merged[k] = override
+	}
+	return merged
+}
+
+// debugValuesEnabled returns true if the Cluster requests recording the effective values of its addons.
+func debugValuesEnabled(cluster *clusterv1.Cluster) bool {
+	return cluster.Annotations[v1alpha1.DebugAddonValuesAnnotationKey] == "true"
+}
+
+// debugHelmChartsEnabled returns true if the Cluster requests recording the source of the Helm charts of its addons.
+func debugHelmChartsEnabled(cluster *clusterv1.Cluster) bool {
+	return cluster.Annotations[v1alpha1.DebugHelmChartsAnnotationKey] == "true"
+}
+
+// effectiveValuesAnnotation returns the value of the effective values annotation, that lists the ConfigMaps the
+// values were read from before the values.
+func effectiveValuesAnnotation(valuesSources []string, values string) string {
+	return fmt.Sprintf("# Values from ConfigMaps: %s\n%s", strings.Join(valuesSources, ", "), values)
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package addons
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func Test_mergeValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		values    string
+		overrides string
+		expected  string
+	}{{
+		name: "empty overrides keep the values",
+		values: `image:
+  repository: example.com/addon
+  tag: v1.0.0
+`,
+		expected: `image:
+  repository: example.com/addon
+  tag: v1.0.0
+`,
+	}, {
+		name: "maps are merged recursively",
+		values: `image:
+  repository: example.com/addon
+  tag: v1.0.0
+replicas: 1
+`,
+		overrides: `image:
+  tag: v1.1.0
+resources:
+  limits:
+    memory: 128Mi
+`,
+		expected: `image:
+  repository: example.com/addon
+  tag: v1.1.0
+replicas: 1
+resources:
+  limits:
+    memory: 128Mi
+`,
+	}, {
+		name: "lists are replaced",
+		values: `tolerations:
+- key: node-role.kubernetes.io/control-plane
+  effect: NoSchedule
+- key: node-role.kubernetes.io/master
+  effect: NoSchedule
+`,
+		overrides: `tolerations:
+- operator: Exists
+`,
+		expected: `tolerations:
+- operator: Exists
+`,
+	}, {
+		name: "scalars replace maps",
+		values: `nodeSelector:
+  kubernetes.io/os: linux
+`,
+		overrides: `nodeSelector: ""
+`,
+		expected: `nodeSelector: ""
+`,
+	}, {
+		name: "null overrides remove the values",
+		values: `nodeSelector:
+  kubernetes.io/os: linux
+replicas: 1
+`,
+		overrides: `nodeSelector: null
+`,
+		expected: `replicas: 1
+`,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			merged, err := mergeValues(tt.values, tt.overrides)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, merged)
+		})
+	}
+}
+
+func Test_mergeValues_InvalidValues(t *testing.T) {
+	t.Parallel()
+
+	_, err := mergeValues("replicas: 1\n", "image: [")
+	require.ErrorContains(t, err, "failed to parse user-provided values")
+}
+
+func TestHelmAddonApplierValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		valuesSource    *v1alpha1.AddonValues
+		expectedValues  string
+		expectedSources []string
+	}{{
+		name: "default values template",
+		expectedValues: `image:
+  tag: test-cluster
+replicas: 1
+`,
+		expectedSources: []string{"defaults/default-addon-helm-values-template"},
+	}, {
+		name: "user-provided values replace the default values template",
+		valuesSource: &v1alpha1.AddonValues{
+			SourceRef: &v1alpha1.ValuesReference{Kind: "ConfigMap", Name: "addon-values"},
+		},
+		expectedValues: `replicas: 3
+tolerations:
+- operator: Exists
+`,
+		expectedSources: []string{"test-namespace/addon-values"},
+	}, {
+		name: "user-provided values replace the default values template in the Replace mode",
+		valuesSource: &v1alpha1.AddonValues{
+			SourceRef: &v1alpha1.ValuesReference{Kind: "ConfigMap", Name: "addon-values"},
+			Mode:      v1alpha1.AddonValuesModeReplace,
+		},
+		expectedValues: `replicas: 3
+tolerations:
+- operator: Exists
+`,
+		expectedSources: []string{"test-namespace/addon-values"},
+	}, {
+		name: "user-provided values are merged over the default values template in the Merge mode",
+		valuesSource: &v1alpha1.AddonValues{
+			SourceRef: &v1alpha1.ValuesReference{Kind: "ConfigMap", Name: "addon-values"},
+			Mode:      v1alpha1.AddonValuesModeMerge,
+		},
+		expectedValues: `image:
+  tag: test-cluster
+replicas: 3
+tolerations:
+- operator: Exists
+`,
+		expectedSources: []string{
+			"defaults/default-addon-helm-values-template",
+			"test-namespace/addon-values",
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			require.NoError(t, clusterv1.AddToScheme(scheme))
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "test-namespace",
+					UID:       "test-uid",
+				},
+			}
+			client := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					&corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "default-addon-helm-values-template",
+							Namespace: "defaults",
+						},
+						Data: map[string]string{
+							"values.yaml": "image:\n  tag: {{ .Name }}\nreplicas: 1\n",
+						},
+					},
+					&corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "addon-values",
+							Namespace: "test-namespace",
+						},
+						Data: map[string]string{
+							"values.yaml": "replicas: 3\ntolerations:\n- operator: Exists\n",
+						},
+					},
+				).
+				Build()
+
+			applier := NewHelmAddonApplier(
+				NewHelmAddonConfig("default-addon-helm-values-template", "addon-system", "addon"),
+				client,
+				nil,
+			).
+				WithValueTemplater(func(cluster *clusterv1.Cluster, text string) (string, error) {
+					return strings.ReplaceAll(text, "{{ .Name }}", cluster.Name), nil
+				}).
+				WithValuesSource(tt.valuesSource)
+			applyOpts := &applyOptions{}
+			for _, opt := range applier.opts {
+				opt(applyOpts)
+			}
+
+			values, sources, err := applier.values(
+				context.Background(),
+				cluster,
+				"defaults",
+				defaultCiliumValuesTemplateKey,
+				applyOpts,
+				logr.Discard(),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedValues, values)
+			assert.Equal(t, tt.expectedSources, sources)
+
+			if tt.valuesSource != nil {
+				userValues := &corev1.ConfigMap{}
+				require.NoError(t, client.Get(
+					context.Background(),
+					ctrlclient.ObjectKey{Namespace: "test-namespace", Name: "addon-values"},
+					userValues,
+				))
+				require.Len(t, userValues.OwnerReferences, 1)
+				assert.Equal(t, "test-cluster", userValues.OwnerReferences[0].Name)
+			}
+		})
+	}
+}
+
+func TestEffectiveValuesAnnotation(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(
+		t,
+		"# Values from ConfigMaps: defaults/default-values, test-namespace/user-values\nreplicas: 3\n",
+		effectiveValuesAnnotation(
+			[]string{"defaults/default-values", "test-namespace/user-values"},
+			"replicas: 3\n",
+		),
+	)
+}
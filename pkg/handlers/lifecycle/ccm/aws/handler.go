@@ -94,7 +94,7 @@ func (a *AWSCCM) Apply(
 	var strategy addons.Applier
 	switch clusterConfig.Addons.CCM.Strategy {
 	case v1alpha1.AddonStrategyHelmAddon:
-		helmChart, err := a.helmChartInfoGetter.For(ctx, log, config.AWSCCM)
+		helmChart, err := a.helmChartInfoGetter.For(ctx, log, cluster, config.AWSCCM)
 		if err != nil {
 			return fmt.Errorf("failed to get configuration to create helm addon: %w", err)
 		}
@@ -102,7 +102,7 @@ func (a *AWSCCM) Apply(
 			a.config.helmAddonConfig,
 			a.client,
 			helmChart,
-		)
+		).WithValuesSource(clusterConfig.Addons.CCM.Values)
 	case v1alpha1.AddonStrategyClusterResourceSet:
 		strategy = crsStrategy{
 			config: crsConfig{
@@ -20,6 +20,7 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/lifecycle"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
 	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/addons"
 )
 
 const (
@@ -45,7 +46,7 @@ type CCMHandler struct {
 var (
 	_ commonhandlers.Named                   = &CCMHandler{}
 	_ lifecycle.AfterControlPlaneInitialized = &CCMHandler{}
-	_ lifecycle.BeforeClusterUpgrade         = &CCMHandler{}
+	_ lifecycle.AfterClusterUpgrade          = &CCMHandler{}
 )
 
 func New(
@@ -60,8 +61,11 @@ func New(
 	}
 }
 
+// HandlerName is the name of the CCM lifecycle handler.
+const HandlerName = "CCMHandler"
+
 func (c *CCMHandler) Name() string {
-	return "CCMHandler"
+	return HandlerName
 }
 
 func (c *CCMHandler) AfterControlPlaneInitialized(
@@ -77,14 +81,17 @@ func (c *CCMHandler) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCCMReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
 
-func (c *CCMHandler) BeforeClusterUpgrade(
+// AfterClusterUpgrade upgrades the CCM once the whole cluster runs the new Kubernetes version, because the CCM
+// version must match the Kubernetes minor version of the control plane.
+func (c *CCMHandler) AfterClusterUpgrade(
 	ctx context.Context,
-	req *runtimehooksv1.BeforeClusterUpgradeRequest,
-	resp *runtimehooksv1.BeforeClusterUpgradeResponse,
+	req *runtimehooksv1.AfterClusterUpgradeRequest,
+	resp *runtimehooksv1.AfterClusterUpgradeResponse,
 ) {
 	cluster, err := capiutils.ConvertV1Beta1ClusterToV1Beta2(&req.Cluster)
 	if err != nil {
@@ -94,6 +101,7 @@ func (c *CCMHandler) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCCMReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -0,0 +1,133 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ccm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	apivariables "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/lifecycle"
+	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+)
+
+type fakeCCMProvider struct {
+	returnedErr error
+	versions    []string
+}
+
+func (p *fakeCCMProvider) Apply(
+	_ context.Context,
+	cluster *clusterv1beta2.Cluster,
+	_ *apivariables.ClusterConfigSpec,
+	_ logr.Logger,
+) error {
+	p.versions = append(p.versions, cluster.Spec.Topology.Version)
+	return p.returnedErr
+}
+
+func testCluster(ccm *v1alpha1.CCM) (*clusterv1beta2.Cluster, error) {
+	cv, err := apivariables.MarshalToClusterVariable(
+		"clusterConfig",
+		&apivariables.ClusterConfigSpec{
+			Addons: &apivariables.Addons{
+				GenericAddons: v1alpha1.GenericAddons{
+					CCM: ccm,
+				},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterv1beta2.Cluster{
+		Spec: clusterv1beta2.ClusterSpec{
+			Topology: clusterv1beta2.Topology{
+				ClassRef: clusterv1beta2.ClusterClassRef{Name: "dummy-class"},
+				Version:  "v1.34.1",
+				Variables: []clusterv1beta2.ClusterVariable{
+					*cv,
+				},
+			},
+		},
+	}, nil
+}
+
+func Test_AfterClusterUpgrade(t *testing.T) {
+	tests := []struct {
+		name         string
+		ccm          *v1alpha1.CCM
+		providerErr  error
+		wantFailure  bool
+		wantVersions []string
+	}{
+		{
+			name: "ccm variable is optional",
+			ccm:  nil,
+		},
+		{
+			name:         "ccm is upgraded to the version of the cluster",
+			ccm:          &v1alpha1.CCM{},
+			wantVersions: []string{"v1.34.1"},
+		},
+		{
+			name:         "ccm upgrade fails",
+			ccm:          &v1alpha1.CCM{},
+			providerErr:  fmt.Errorf("fake error"),
+			wantFailure:  true,
+			wantVersions: []string{"v1.34.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fake.NewClientBuilder().Build()
+			provider := &fakeCCMProvider{returnedErr: tt.providerErr}
+			handler := New(client, map[string]CCMProvider{v1alpha1.CCMProviderNutanix: provider})
+			resp := &runtimehooksv1.AfterClusterUpgradeResponse{}
+
+			cluster, err := testCluster(tt.ccm)
+			if err != nil {
+				t.Fatalf("failed to create test cluster: %s", err)
+			}
+			clusterV1beta1, err := capiutils.ConvertV1Beta2ClusterToV1Beta1(cluster)
+			if err != nil {
+				t.Fatalf("failed to convert test cluster: %s", err)
+			}
+			clusterV1beta1.Spec.InfrastructureRef = &corev1.ObjectReference{
+				APIVersion: "infrastructure.cluster.x-k8s.io/v1beta1",
+				Kind:       "NutanixCluster",
+			}
+			req := &runtimehooksv1.AfterClusterUpgradeRequest{
+				Cluster:           *clusterV1beta1,
+				KubernetesVersion: cluster.Spec.Topology.Version,
+			}
+
+			handler.AfterClusterUpgrade(ctx, req, resp)
+			if got := resp.Status == runtimehooksv1.ResponseStatusFailure; got != tt.wantFailure {
+				t.Errorf("response Status %q, Message: %s", resp.Status, resp.Message)
+			}
+			if diff := cmp.Diff(tt.wantVersions, provider.versions); diff != "" {
+				t.Errorf("applied versions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_CCMHandlerDoesNotUpgradeBeforeClusterUpgrade(t *testing.T) {
+	var handler any = New(fake.NewClientBuilder().Build(), nil)
+	if _, ok := handler.(lifecycle.BeforeClusterUpgrade); ok {
+		t.Error("CCMHandler must upgrade the CCM after the cluster upgrade, not before it")
+	}
+}
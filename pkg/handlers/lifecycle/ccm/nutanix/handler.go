@@ -119,7 +119,7 @@ func (p *provider) Apply(
 		}
 	}
 
-	helmChart, err := p.helmChartInfoGetter.For(ctx, log, config.NutanixCCM)
+	helmChart, err := p.helmChartInfoGetter.For(ctx, log, cluster, config.NutanixCCM)
 	if err != nil {
 		return fmt.Errorf("failed to get values for nutanix-ccm-config: %w", err)
 	}
@@ -132,7 +132,9 @@ func (p *provider) Apply(
 		),
 		p.client,
 		helmChart,
-	).WithValueTemplater(templateValuesFunc(clusterConfig.Nutanix))
+	).
+		WithValueTemplater(templateValuesFunc(clusterConfig.Nutanix)).
+		WithValuesSource(clusterConfig.Addons.CCM.Values)
 
 	if err = applier.Apply(ctx, cluster, p.config.DefaultsNamespace(), log); err != nil {
 		return fmt.Errorf("failed to apply nutanix-ccm installation HelmChartProxy: %w", err)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -19,6 +20,7 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/lifecycle"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
 	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/addons"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/config"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/options"
 )
@@ -99,6 +101,7 @@ func (n *DefaultClusterAutoscaler) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonClusterAutoscalerReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -116,6 +119,7 @@ func (n *DefaultClusterAutoscaler) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonClusterAutoscalerReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -147,17 +151,24 @@ func (n *DefaultClusterAutoscaler) apply(
 		return
 	}
 
-	var strategy addonStrategy
+	var (
+		strategy addonStrategy
+		// caNamespace is the namespace cluster-autoscaler is configured with, where it reads the priority expander
+		// ConfigMap from in the workload cluster.
+		caNamespace string
+	)
 	switch caVar.Strategy {
 	case v1alpha1.AddonStrategyClusterResourceSet:
 		strategy = crsStrategy{
 			config: n.config.crsConfig,
 			client: n.client,
 		}
+		caNamespace = metav1.NamespaceSystem
 	case v1alpha1.AddonStrategyHelmAddon:
 		helmChart, err := n.helmChartInfoGetter.For(
 			ctx,
 			log,
+			cluster,
 			config.Autoscaler,
 		)
 		if err != nil {
@@ -177,7 +188,9 @@ func (n *DefaultClusterAutoscaler) apply(
 			config:    n.config.helmAddonConfig,
 			client:    n.client,
 			helmChart: helmChart,
+			values:    caVar.Values,
 		}
+		caNamespace = cluster.Namespace
 	case "":
 		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
 		resp.SetMessage("strategy not specified for cluster-autoscaler addon")
@@ -195,6 +208,12 @@ func (n *DefaultClusterAutoscaler) apply(
 		return
 	}
 
+	if err = ensurePriorityExpander(ctx, n.client, cluster, caVar, caNamespace, log); err != nil {
+		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+		resp.SetMessage(err.Error())
+		return
+	}
+
 	resp.SetStatus(runtimehooksv1.ResponseStatusSuccess)
 }
 
@@ -0,0 +1,175 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterautoscaler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/k8s/client"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/utils"
+)
+
+// priorityExpanderConfigMapName is the name of the ConfigMap read by the cluster-autoscaler priority expander.
+const priorityExpanderConfigMapName = "cluster-autoscaler-priority-expander"
+
+func priorityExpanderResourceNameForCluster(cluster *clusterv1.Cluster) string {
+	return addonResourceNameForCluster(cluster) + "-priority-expander"
+}
+
+// ensurePriorityExpander deploys the priority expander ConfigMap to the workload cluster with a ClusterResourceSet.
+// The cluster-autoscaler reads the ConfigMap with the workload cluster kubeconfig, from the namespace it is configured
+// with. The ClusterResourceSet is deleted if no priorities are set.
+func ensurePriorityExpander(
+	ctx context.Context,
+	c ctrlclient.Client,
+	cluster *clusterv1.Cluster,
+	caVar *v1alpha1.ClusterAutoscaler,
+	namespace string,
+	log logr.Logger,
+) error {
+	crsName := priorityExpanderResourceNameForCluster(cluster)
+
+	if len(caVar.Priorities) == 0 {
+		return deletePriorityExpander(ctx, c, cluster)
+	}
+
+	log.Info("Ensuring cluster-autoscaler priority expander ConfigMap exists for cluster")
+
+	manifests, err := priorityExpanderManifests(caVar.Priorities, namespace)
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      crsName,
+		},
+		Data: map[string]string{
+			"priority-expander.yaml": manifests,
+		},
+	}
+
+	if err := client.ServerSideApply(ctx, c, cm, client.ForceOwnership); err != nil {
+		return fmt.Errorf(
+			"failed to apply cluster-autoscaler priority expander ConfigMap: %w",
+			err,
+		)
+	}
+
+	if err := utils.EnsureCRSForClusterFromObjects(
+		ctx,
+		crsName,
+		c,
+		cluster,
+		utils.DefaultEnsureCRSForClusterFromObjectsOptions(),
+		cm,
+	); err != nil {
+		return fmt.Errorf(
+			"failed to apply cluster-autoscaler priority expander ClusterResourceSet: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+func deletePriorityExpander(ctx context.Context, c ctrlclient.Client, cluster *clusterv1.Cluster) error {
+	crs := &crsv1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      priorityExpanderResourceNameForCluster(cluster),
+		},
+	}
+	if err := ctrlclient.IgnoreNotFound(c.Delete(ctx, crs)); err != nil {
+		return fmt.Errorf(
+			"failed to delete cluster-autoscaler priority expander ClusterResourceSet: %w",
+			err,
+		)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      priorityExpanderResourceNameForCluster(cluster),
+		},
+	}
+	if err := ctrlclient.IgnoreNotFound(c.Delete(ctx, cm)); err != nil {
+		return fmt.Errorf(
+			"failed to delete cluster-autoscaler priority expander ConfigMap: %w",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// priorityExpanderManifests returns the manifests of the priority expander ConfigMap, and of its Namespace if it is
+// not kube-system.
+func priorityExpanderManifests(
+	priorities []v1alpha1.ClusterAutoscalerNodeGroupPriority,
+	namespace string,
+) (string, error) {
+	patterns := make(map[string][]string, len(priorities))
+	for _, p := range priorities {
+		key := strconv.Itoa(int(p.Priority))
+		patterns[key] = append(patterns[key], p.NodeGroupPatterns...)
+	}
+	prioritiesYAML, err := yaml.Marshal(patterns)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cluster-autoscaler priorities: %w", err)
+	}
+
+	objs := []any{}
+	if namespace != metav1.NamespaceSystem {
+		objs = append(objs, &corev1.Namespace{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "Namespace",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		})
+	}
+	objs = append(objs, &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      priorityExpanderConfigMapName,
+		},
+		Data: map[string]string{
+			"priorities": string(prioritiesYAML),
+		},
+	})
+
+	var manifests string
+	for _, obj := range objs {
+		b, err := yaml.Marshal(obj)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal cluster-autoscaler priority expander manifest: %w", err)
+		}
+		manifests += "---\n" + string(b)
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package clusterautoscaler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func Test_priorityExpanderManifests(t *testing.T) {
+	priorities := []v1alpha1.ClusterAutoscalerNodeGroupPriority{
+		{
+			Priority:          10,
+			NodeGroupPatterns: []string{".*-gpu-.*"},
+		},
+		{
+			Priority:          50,
+			NodeGroupPatterns: []string{".*-spot-.*", ".*-small-.*"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		want      string
+	}{
+		{
+			name:      "kube-system",
+			namespace: "kube-system",
+			want: `---
+apiVersion: v1
+data:
+  priorities: |
+    "10":
+    - .*-gpu-.*
+    "50":
+    - .*-spot-.*
+    - .*-small-.*
+kind: ConfigMap
+metadata:
+  name: cluster-autoscaler-priority-expander
+  namespace: kube-system
+`,
+		},
+		{
+			name:      "other namespace",
+			namespace: "test-namespace",
+			want: `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test-namespace
+spec: {}
+status: {}
+---
+apiVersion: v1
+data:
+  priorities: |
+    "10":
+    - .*-gpu-.*
+    "50":
+    - .*-spot-.*
+    - .*-small-.*
+kind: ConfigMap
+metadata:
+  name: cluster-autoscaler-priority-expander
+  namespace: test-namespace
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := priorityExpanderManifests(priorities, tt.namespace)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
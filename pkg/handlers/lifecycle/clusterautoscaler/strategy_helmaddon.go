@@ -14,6 +14,7 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	caaphv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/sigs.k8s.io/cluster-api-addon-provider-helm/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/addons"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/config"
 )
@@ -36,6 +37,7 @@ type helmAddonStrategy struct {
 
 	client    ctrlclient.Client
 	helmChart *config.HelmChart
+	values    *v1alpha1.AddonValues
 }
 
 func (s helmAddonStrategy) apply(
@@ -63,6 +65,7 @@ func (s helmAddonStrategy) apply(
 	).
 		WithTargetCluster(targetCluster).
 		WithValueTemplater(templateValues).
+		WithValuesSource(s.values).
 		WithHelmReleaseName(addonResourceNameForCluster(cluster))
 
 	if err = applier.Apply(ctx, cluster, defaultsNamespace, log); err != nil {
@@ -6,9 +6,13 @@ package clusterautoscaler
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"text/template"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
 )
 
 // templateData uses golang template to replace values in a map.
@@ -24,19 +28,33 @@ func templateData(cluster *clusterv1.Cluster, data map[string]string) (map[strin
 	return templated, nil
 }
 
-// templateValues replaces Cluster.Name and Cluster.Namespace in Helm values text.
+// extraArg is a cluster-autoscaler command line argument, without the leading dashes.
+type extraArg struct {
+	Name  string
+	Value string
+}
+
+// templateValues replaces Cluster.Name and Cluster.Namespace in Helm values text, and renders the cluster-autoscaler
+// arguments set in the clusterAutoscaler addon variable of the Cluster as ExtraArgs.
 func templateValues(cluster *clusterv1.Cluster, text string) (string, error) {
 	clusterAutoscalerTemplate, err := template.New("").Parse(text)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse template: %w", err)
 	}
 
+	args, err := extraArgs(cluster)
+	if err != nil {
+		return "", err
+	}
+
 	type input struct {
-		Cluster *clusterv1.Cluster
+		Cluster   *clusterv1.Cluster
+		ExtraArgs []extraArg
 	}
 
 	templateInput := input{
-		Cluster: cluster,
+		Cluster:   cluster,
+		ExtraArgs: args,
 	}
 
 	var b bytes.Buffer
@@ -50,3 +68,68 @@ func templateValues(cluster *clusterv1.Cluster, text string) (string, error) {
 
 	return b.String(), nil
 }
+
+// extraArgs returns the cluster-autoscaler arguments set in the clusterAutoscaler addon variable of the Cluster,
+// sorted by name.
+func extraArgs(cluster *clusterv1.Cluster) ([]extraArg, error) {
+	caVar, err := variables.Get[v1alpha1.ClusterAutoscaler](
+		variables.ClusterVariablesToVariablesMap(cluster.Spec.Topology.Variables),
+		v1alpha1.ClusterConfigVariableName,
+		"addons",
+		v1alpha1.ClusterAutoscalerVariableName,
+	)
+	if err != nil {
+		if variables.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cluster-autoscaler variable from cluster definition: %w", err)
+	}
+
+	var args []extraArg
+	if caVar.BalanceSimilarNodeGroups != nil {
+		args = append(args, extraArg{
+			Name:  "balance-similar-node-groups",
+			Value: strconv.FormatBool(*caVar.BalanceSimilarNodeGroups),
+		})
+	}
+	if caVar.Expander != "" {
+		args = append(args, extraArg{Name: "expander", Value: string(caVar.Expander)})
+	}
+	if caVar.MaxNodeProvisionTime != nil {
+		args = append(args, extraArg{Name: "max-node-provision-time", Value: caVar.MaxNodeProvisionTime.Duration.String()})
+	}
+	if scaleDown := caVar.ScaleDown; scaleDown != nil {
+		if scaleDown.DelayAfterAdd != nil {
+			args = append(args, extraArg{
+				Name:  "scale-down-delay-after-add",
+				Value: scaleDown.DelayAfterAdd.Duration.String(),
+			})
+		}
+		if scaleDown.DelayAfterDelete != nil {
+			args = append(args, extraArg{
+				Name:  "scale-down-delay-after-delete",
+				Value: scaleDown.DelayAfterDelete.Duration.String(),
+			})
+		}
+		if scaleDown.DelayAfterFailure != nil {
+			args = append(args, extraArg{
+				Name:  "scale-down-delay-after-failure",
+				Value: scaleDown.DelayAfterFailure.Duration.String(),
+			})
+		}
+		if scaleDown.UnneededTime != nil {
+			args = append(args, extraArg{
+				Name:  "scale-down-unneeded-time",
+				Value: scaleDown.UnneededTime.Duration.String(),
+			})
+		}
+		if scaleDown.UtilizationThreshold != "" {
+			args = append(args, extraArg{
+				Name:  "scale-down-utilization-threshold",
+				Value: scaleDown.UtilizationThreshold,
+			})
+		}
+	}
+
+	return args, nil
+}
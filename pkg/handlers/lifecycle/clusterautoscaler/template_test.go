@@ -8,8 +8,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 )
 
 func Test_templateData(t *testing.T) {
@@ -98,6 +101,76 @@ func Test_templateValues(t *testing.T) {
 	}
 }
 
+func Test_templateValuesExtraArgs(t *testing.T) {
+	const text = `extraArgs:
+  enforce-node-group-min-size: true
+{{- range .ExtraArgs }}
+  {{ .Name }}: {{ printf "%q" .Value }}
+{{- end }}`
+
+	tests := []struct {
+		name   string
+		caJSON string
+		want   string
+	}{
+		{
+			name:   "no typed configuration",
+			caJSON: `{"strategy": "HelmAddon"}`,
+			want: `extraArgs:
+  enforce-node-group-min-size: true`,
+		},
+		{
+			name: "typed configuration",
+			caJSON: `{
+				"strategy": "HelmAddon",
+				"expander": "least-waste",
+				"scaleDown": {
+					"delayAfterAdd": "10m",
+					"delayAfterDelete": "30s",
+					"delayAfterFailure": "3m",
+					"unneededTime": "20m",
+					"utilizationThreshold": "0.6"
+				},
+				"balanceSimilarNodeGroups": true,
+				"maxNodeProvisionTime": "15m"
+			}`,
+			want: `extraArgs:
+  enforce-node-group-min-size: true
+  balance-similar-node-groups: "true"
+  expander: "least-waste"
+  max-node-provision-time: "15m0s"
+  scale-down-delay-after-add: "10m0s"
+  scale-down-delay-after-delete: "30s"
+  scale-down-delay-after-failure: "3m0s"
+  scale-down-unneeded-time: "20m0s"
+  scale-down-utilization-threshold: "0.6"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &clusterv1beta2.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "test-namespace",
+				},
+				Spec: clusterv1beta2.ClusterSpec{
+					Topology: clusterv1beta2.Topology{
+						Variables: []clusterv1beta2.ClusterVariable{{
+							Name: v1alpha1.ClusterConfigVariableName,
+							Value: apiextensionsv1.JSON{
+								Raw: []byte(`{"addons": {"clusterAutoscaler": ` + tt.caJSON + `}}`),
+							},
+						}},
+					},
+				},
+			}
+			got, err := templateValues(cluster, text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 const (
 	mapKey = "deployment.yaml"
 
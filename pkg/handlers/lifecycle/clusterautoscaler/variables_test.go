@@ -5,7 +5,9 @@ package clusterautoscaler
 
 import (
 	"testing"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
@@ -60,6 +62,63 @@ func TestVariableValidation_AWS(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		capitest.VariableTestDef{
+			Name: "typed configuration",
+			Vals: apivariables.ClusterConfigSpec{
+				Addons: &apivariables.Addons{
+					GenericAddons: v1alpha1.GenericAddons{
+						ClusterAutoscaler: &v1alpha1.ClusterAutoscaler{
+							Strategy: v1alpha1.AddonStrategyHelmAddon,
+							Expander: v1alpha1.ClusterAutoscalerExpanderPriority,
+							Priorities: []v1alpha1.ClusterAutoscalerNodeGroupPriority{{
+								Priority:          10,
+								NodeGroupPatterns: []string{".*-gpu-.*"},
+							}},
+							ScaleDown: &v1alpha1.ClusterAutoscalerScaleDown{
+								DelayAfterAdd:        &metav1.Duration{Duration: 10 * time.Minute},
+								UtilizationThreshold: "0.5",
+							},
+							BalanceSimilarNodeGroups: ptr.To(true),
+							MaxNodeProvisionTime:     &metav1.Duration{Duration: 15 * time.Minute},
+						},
+					},
+				},
+			},
+		},
+		capitest.VariableTestDef{
+			Name: "priorities without priority expander",
+			Vals: apivariables.ClusterConfigSpec{
+				Addons: &apivariables.Addons{
+					GenericAddons: v1alpha1.GenericAddons{
+						ClusterAutoscaler: &v1alpha1.ClusterAutoscaler{
+							Strategy: v1alpha1.AddonStrategyHelmAddon,
+							Expander: v1alpha1.ClusterAutoscalerExpanderLeastWaste,
+							Priorities: []v1alpha1.ClusterAutoscalerNodeGroupPriority{{
+								Priority:          10,
+								NodeGroupPatterns: []string{".*-gpu-.*"},
+							}},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		capitest.VariableTestDef{
+			Name: "invalid utilization threshold",
+			Vals: apivariables.ClusterConfigSpec{
+				Addons: &apivariables.Addons{
+					GenericAddons: v1alpha1.GenericAddons{
+						ClusterAutoscaler: &v1alpha1.ClusterAutoscaler{
+							Strategy: v1alpha1.AddonStrategyHelmAddon,
+							ScaleDown: &v1alpha1.ClusterAutoscalerScaleDown{
+								UtilizationThreshold: "1.5",
+							},
+						},
+					},
+				},
+			},
+			ExpectError: true,
+		},
 	)
 }
 
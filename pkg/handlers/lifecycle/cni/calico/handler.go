@@ -19,6 +19,7 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/lifecycle"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
 	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/addons"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/config"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/options"
 )
@@ -73,8 +74,11 @@ func New(
 	}
 }
 
+// HandlerName is the name of the Calico CNI lifecycle handler.
+const HandlerName = "CalicoCNI"
+
 func (c *CalicoCNI) Name() string {
-	return "CalicoCNI"
+	return HandlerName
 }
 
 func (c *CalicoCNI) AfterControlPlaneInitialized(
@@ -90,6 +94,7 @@ func (c *CalicoCNI) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCNIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -107,6 +112,7 @@ func (c *CalicoCNI) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCNIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -166,7 +172,7 @@ func (c *CalicoCNI) apply(
 	case v1alpha1.AddonStrategyHelmAddon:
 		// this is tigera and not calico because we deploy calico via operataor
 		log.Info("fetching settings for tigera-operator-config")
-		helmChart, err := c.helmChartInfoGetter.For(ctx, log, config.Tigera)
+		helmChart, err := c.helmChartInfoGetter.For(ctx, log, cluster, config.Tigera)
 		if err != nil {
 			log.Error(
 				err,
@@ -101,8 +101,11 @@ func New(
 	}
 }
 
+// HandlerName is the name of the Cilium CNI lifecycle handler.
+const HandlerName = "CiliumCNI"
+
 func (c *CiliumCNI) Name() string {
-	return "CiliumCNI"
+	return HandlerName
 }
 
 func (c *CiliumCNI) AfterControlPlaneInitialized(
@@ -118,6 +121,7 @@ func (c *CiliumCNI) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse, applyOpts{})
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCNIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -136,6 +140,7 @@ func (c *CiliumCNI) BeforeClusterUpgrade(
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	shouldRunPreflight := !skipCiliumPreflight(cluster)
 	c.apply(ctx, cluster, commonResponse, applyOpts{shouldRunPreflight: shouldRunPreflight})
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCNIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -211,7 +216,7 @@ func (c *CiliumCNI) apply(
 			client: c.client,
 		}
 	case v1alpha1.AddonStrategyHelmAddon:
-		helmChart, err := c.helmChartInfoGetter.For(ctx, log, config.Cilium)
+		helmChart, err := c.helmChartInfoGetter.For(ctx, log, cluster, config.Cilium)
 		if err != nil {
 			log.Error(
 				err,
@@ -226,44 +231,18 @@ func (c *CiliumCNI) apply(
 			return
 		}
 
-		helmValuesSourceRefName := c.config.helmAddonConfig.defaultValuesTemplateConfigMapName
-		if cniVar.Values != nil && cniVar.Values.SourceRef != nil {
-			helmValuesSourceRefName = cniVar.Values.SourceRef.Name
-			// Use cluster's namespace since Values.SourceRef is always a LocalObjectReference
-			targetNamespace = cluster.Namespace
-
-			err := handlersutils.EnsureClusterOwnerReferenceForObject(
-				ctx,
-				c.client,
-				corev1.TypedLocalObjectReference{
-					Kind: cniVar.Values.SourceRef.Kind,
-					Name: cniVar.Values.SourceRef.Name,
-				},
-				cluster,
-			)
-			if err != nil {
-				log.Error(
-					err,
-					"error updating Cluster's owner reference on Cilium helm values source object",
-					"name",
-					cniVar.Values.SourceRef.Name,
-					"kind",
-					cniVar.Values.SourceRef.Kind,
-				)
-				resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
-				resp.SetMessage(
-					fmt.Sprintf(
-						"failed to set Cluster's owner reference on Cilium helm values source object: %v",
-						err,
-					),
-				)
-			}
+		// Cilium reads the IPsec keys from a Secret in its namespace, so copy the Secret to the workload cluster.
+		if err := copyIPsecKeySecret(ctx, c.client, cluster, cniVar.Cilium); err != nil {
+			log.Error(err, "failed to copy Cilium IPsec key Secret to the workload cluster")
+			resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+			resp.SetMessage(err.Error())
+			return
 		}
 
 		if opts.shouldRunPreflight {
 			preflightStrategy := addons.NewHelmAddonApplier(
 				addons.NewHelmAddonConfig(
-					helmValuesSourceRefName,
+					c.config.helmAddonConfig.defaultValuesTemplateConfigMapName,
 					defaultCiliumNamespace,
 					defaultCiliumPreflightReleaseName,
 				),
@@ -271,6 +250,7 @@ func (c *CiliumCNI) apply(
 				helmChart,
 			).
 				WithValueTemplater(preflightTemplateValues).
+				WithValuesSource(cniVar.Values).
 				WithPreflightEnabled().
 				WithDefaultWaiter()
 			preflightDeleter = preflightStrategy
@@ -291,7 +271,7 @@ func (c *CiliumCNI) apply(
 
 		strategy = addons.NewHelmAddonApplier(
 			addons.NewHelmAddonConfig(
-				helmValuesSourceRefName,
+				c.config.helmAddonConfig.defaultValuesTemplateConfigMapName,
 				defaultCiliumNamespace,
 				defaultCiliumReleaseName,
 			),
@@ -299,6 +279,7 @@ func (c *CiliumCNI) apply(
 			helmChart,
 		).
 			WithValueTemplater(templateValues).
+			WithValuesSource(cniVar.Values).
 			WithDefaultWaiter()
 	case "":
 		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
@@ -328,6 +309,34 @@ func (c *CiliumCNI) apply(
 	resp.SetStatus(runtimehooksv1.ResponseStatusSuccess)
 }
 
+func copyIPsecKeySecret(
+	ctx context.Context,
+	client ctrlclient.Client,
+	cluster *clusterv1.Cluster,
+	cilium *v1alpha1.CiliumConfiguration,
+) error {
+	if cilium == nil || cilium.Encryption == nil || cilium.Encryption.IPsecKeySecretRef == nil {
+		return nil
+	}
+
+	secretName := cilium.Encryption.IPsecKeySecretRef.Name
+	err := handlersutils.CopySecretToRemoteCluster(
+		ctx,
+		client,
+		secretName,
+		ctrlclient.ObjectKey{
+			Name:      secretName,
+			Namespace: defaultCiliumNamespace,
+		},
+		cluster,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to copy Cilium IPsec key Secret %q to the workload cluster: %w", secretName, err)
+	}
+
+	return nil
+}
+
 func runApply(
 	ctx context.Context,
 	client ctrlclient.Client,
@@ -51,7 +51,15 @@ func templateValues(cluster *clusterv1.Cluster, text string) (string, error) {
 		)
 	}
 
-	return b.String(), nil
+	ciliumConfiguration, err := apivariables.CiliumConfiguration(cluster)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Cilium configuration: %w", err)
+	}
+	if ciliumConfiguration == nil {
+		return b.String(), nil
+	}
+
+	return mergeCiliumConfiguration(b.String(), ciliumConfiguration)
 }
 
 // https://docs.cilium.io/en/stable/operations/upgrade/#running-pre-flight-check-required
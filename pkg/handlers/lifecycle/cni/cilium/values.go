@@ -0,0 +1,106 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cilium
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+// ciliumValue is a Helm value of the Cilium chart, set from the Cilium configuration.
+type ciliumValue struct {
+	path  []string
+	value any
+}
+
+// mergeCiliumConfiguration sets the Helm values for the Cilium configuration, overriding the same values in the
+// values. The other values are kept.
+func mergeCiliumConfiguration(values string, cilium *v1alpha1.CiliumConfiguration) (string, error) {
+	valuesObj := map[string]any{}
+	if err := yaml.Unmarshal([]byte(values), &valuesObj); err != nil {
+		return "", fmt.Errorf("failed to parse Cilium values: %w", err)
+	}
+	if valuesObj == nil {
+		valuesObj = map[string]any{}
+	}
+
+	for _, v := range ciliumConfigurationValues(cilium) {
+		if err := unstructured.SetNestedField(valuesObj, v.value, v.path...); err != nil {
+			return "", fmt.Errorf("failed to set Cilium value %q: %w", strings.Join(v.path, "."), err)
+		}
+	}
+
+	merged, err := yaml.Marshal(valuesObj)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal Cilium values: %w", err)
+	}
+	return string(merged), nil
+}
+
+// ciliumConfigurationValues returns the Helm values of the Cilium chart for the settings of the Cilium configuration.
+func ciliumConfigurationValues(cilium *v1alpha1.CiliumConfiguration) []ciliumValue {
+	var values []ciliumValue
+
+	if hubble := cilium.Hubble; hubble != nil {
+		if hubble.Enabled != nil {
+			values = append(values, ciliumValue{[]string{"hubble", "enabled"}, *hubble.Enabled})
+		}
+		if hubble.Relay != nil {
+			values = append(values, ciliumValue{[]string{"hubble", "relay", "enabled"}, *hubble.Relay})
+		}
+		if hubble.UI != nil {
+			values = append(values, ciliumValue{[]string{"hubble", "ui", "enabled"}, *hubble.UI})
+		}
+	}
+
+	if encryption := cilium.Encryption; encryption != nil {
+		values = append(values,
+			ciliumValue{[]string{"encryption", "enabled"}, true},
+			ciliumValue{[]string{"encryption", "type"}, strings.ToLower(encryption.Type)},
+			ciliumValue{[]string{"encryption", "nodeEncryption"}, encryption.NodeEncryption},
+		)
+		if encryption.IPsecKeySecretRef != nil {
+			values = append(values,
+				ciliumValue{[]string{"encryption", "ipsec", "secretName"}, encryption.IPsecKeySecretRef.Name},
+			)
+		}
+	}
+
+	if routing := cilium.Routing; routing != nil {
+		values = append(values, ciliumValue{[]string{"routingMode"}, strings.ToLower(routing.Mode)})
+		if routing.TunnelProtocol != "" {
+			values = append(values,
+				ciliumValue{[]string{"tunnelProtocol"}, strings.ToLower(routing.TunnelProtocol)},
+			)
+		}
+		if routing.IPv4NativeRoutingCIDR != "" {
+			values = append(values,
+				ciliumValue{[]string{"ipv4NativeRoutingCIDR"}, routing.IPv4NativeRoutingCIDR},
+			)
+		}
+		if routing.Mode == v1alpha1.CiliumRoutingModeNative {
+			values = append(values,
+				ciliumValue{[]string{"autoDirectNodeRoutes"}, routing.AutoDirectNodeRoutes},
+			)
+		}
+	}
+
+	if bandwidthManager := cilium.BandwidthManager; bandwidthManager != nil {
+		values = append(values,
+			ciliumValue{[]string{"bandwidthManager", "enabled"}, bandwidthManager.Enabled},
+			ciliumValue{[]string{"bandwidthManager", "bbr"}, bandwidthManager.BBR},
+		)
+	}
+
+	if cilium.BPFMasquerade != nil {
+		values = append(values, ciliumValue{[]string{"bpf", "masquerade"}, *cilium.BPFMasquerade})
+	}
+
+	return values
+}
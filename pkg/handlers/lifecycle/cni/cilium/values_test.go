@@ -0,0 +1,192 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cilium
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	apivariables "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+func Test_mergeCiliumConfiguration(t *testing.T) {
+	tests := []struct {
+		name           string
+		values         string
+		cilium         *carenv1.CiliumConfiguration
+		expectedValues string
+	}{
+		{
+			name: "empty configuration keeps the values",
+			values: `
+hubble:
+  enabled: true
+  relay:
+    enabled: true
+k8sServicePort: "6443"
+`,
+			cilium: &carenv1.CiliumConfiguration{},
+			expectedValues: `hubble:
+  enabled: true
+  relay:
+    enabled: true
+k8sServicePort: "6443"
+`,
+		},
+		{
+			name: "hubble settings override the values",
+			values: `
+hubble:
+  enabled: true
+  relay:
+    enabled: true
+    priorityClassName: system-cluster-critical
+`,
+			cilium: &carenv1.CiliumConfiguration{
+				Hubble: &carenv1.CiliumHubble{
+					Relay: ptr.To(true),
+					UI:    ptr.To(true),
+				},
+			},
+			expectedValues: `hubble:
+  enabled: true
+  relay:
+    enabled: true
+    priorityClassName: system-cluster-critical
+  ui:
+    enabled: true
+`,
+		},
+		{
+			name:   "WireGuard encryption with node encryption",
+			values: "",
+			cilium: &carenv1.CiliumConfiguration{
+				Encryption: &carenv1.CiliumEncryption{
+					Type:           carenv1.CiliumEncryptionTypeWireGuard,
+					NodeEncryption: true,
+				},
+			},
+			expectedValues: `encryption:
+  enabled: true
+  nodeEncryption: true
+  type: wireguard
+`,
+		},
+		{
+			name:   "IPsec encryption with the key Secret",
+			values: "",
+			cilium: &carenv1.CiliumConfiguration{
+				Encryption: &carenv1.CiliumEncryption{
+					Type:              carenv1.CiliumEncryptionTypeIPsec,
+					IPsecKeySecretRef: &carenv1.LocalObjectReference{Name: "cilium-ipsec-keys"},
+				},
+			},
+			expectedValues: `encryption:
+  enabled: true
+  ipsec:
+    secretName: cilium-ipsec-keys
+  nodeEncryption: false
+  type: ipsec
+`,
+		},
+		{
+			name: "native routing replaces the tunnel routing",
+			values: `
+routingMode: tunnel
+tunnelProtocol: geneve
+`,
+			cilium: &carenv1.CiliumConfiguration{
+				Routing: &carenv1.CiliumRouting{
+					Mode:                  carenv1.CiliumRoutingModeNative,
+					IPv4NativeRoutingCIDR: "10.0.0.0/8",
+					AutoDirectNodeRoutes:  true,
+				},
+			},
+			expectedValues: `autoDirectNodeRoutes: true
+ipv4NativeRoutingCIDR: 10.0.0.0/8
+routingMode: native
+tunnelProtocol: geneve
+`,
+		},
+		{
+			name:   "tunnel routing with the VXLAN protocol",
+			values: "",
+			cilium: &carenv1.CiliumConfiguration{
+				Routing: &carenv1.CiliumRouting{
+					Mode:           carenv1.CiliumRoutingModeTunnel,
+					TunnelProtocol: carenv1.CiliumTunnelProtocolVXLAN,
+				},
+			},
+			expectedValues: `routingMode: tunnel
+tunnelProtocol: vxlan
+`,
+		},
+		{
+			name: "bandwidth manager and BPF masquerade",
+			values: `
+bpf:
+  preallocateMaps: true
+`,
+			cilium: &carenv1.CiliumConfiguration{
+				BandwidthManager: &carenv1.CiliumBandwidthManager{
+					Enabled: true,
+					BBR:     true,
+				},
+				BPFMasquerade: ptr.To(true),
+			},
+			expectedValues: `bandwidthManager:
+  bbr: true
+  enabled: true
+bpf:
+  masquerade: true
+  preallocateMaps: true
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergeCiliumConfiguration(tt.values, tt.cilium)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedValues, got)
+		})
+	}
+}
+
+func Test_templateValues_CiliumConfiguration(t *testing.T) {
+	cluster := createTestCluster(t, "test-cluster", "test-namespace", "nutanix", "192.168.1.100", 6443)
+	variable, err := apivariables.MarshalToClusterVariable(
+		carenv1.ClusterConfigVariableName,
+		&apivariables.ClusterConfigSpec{
+			KubeProxy: &carenv1.KubeProxy{
+				Mode: carenv1.KubeProxyModeDisabled,
+			},
+			Addons: &apivariables.Addons{
+				CNI: &carenv1.CNI{
+					Provider: carenv1.CNIProviderCilium,
+					Cilium: &carenv1.CiliumConfiguration{
+						BPFMasquerade: ptr.To(true),
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	cluster.Spec.Topology.Variables = []clusterv1beta2.ClusterVariable{*variable}
+
+	got, err := templateValues(cluster, ciliumTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, `bpf:
+  masquerade: true
+ipam:
+  mode: kubernetes
+k8sServiceHost: 192.168.1.100
+k8sServicePort: "6443"
+kubeProxyReplacement: true
+`, got)
+}
@@ -91,6 +91,7 @@ func (m *MultusHandler) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	m.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, m.client, cluster, v1alpha1.AddonMultusReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -108,6 +109,7 @@ func (m *MultusHandler) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	m.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, m.client, cluster, v1alpha1.AddonMultusReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -156,7 +158,7 @@ func (m *MultusHandler) apply(
 	log.Info(fmt.Sprintf("Auto-deploying Multus for %s cluster with %s CNI", provider, cniVar.Provider))
 
 	// Get helm chart configuration
-	helmChart, err := m.helmChartInfoGetter.For(ctx, log, config.Multus)
+	helmChart, err := m.helmChartInfoGetter.For(ctx, log, cluster, config.Multus)
 	if err != nil {
 		log.Error(
 			err,
@@ -90,8 +90,11 @@ func New(
 	}
 }
 
+// HandlerName is the name of the Nutanix Flow CNI lifecycle handler.
+const HandlerName = "NutanixFlowCNI"
+
 func (c *NutanixFlowCNI) Name() string {
-	return "NutanixFlowCNI"
+	return HandlerName
 }
 
 func (c *NutanixFlowCNI) AfterControlPlaneInitialized(
@@ -107,6 +110,7 @@ func (c *NutanixFlowCNI) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCNIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -124,6 +128,7 @@ func (c *NutanixFlowCNI) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCNIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -187,7 +192,7 @@ func (c *NutanixFlowCNI) apply(
 		return
 	}
 
-	helmChart, err := c.helmChartInfoGetter.For(ctx, log, config.NutanixFlowCNI)
+	helmChart, err := c.helmChartInfoGetter.For(ctx, log, cluster, config.NutanixFlowCNI)
 	if err != nil {
 		log.Error(err, "failed to get configmap with helm settings")
 		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
@@ -253,41 +258,9 @@ func (c *NutanixFlowCNI) apply(
 		imagePullSecretName = defaultImagePullSecretName
 	}
 
-	targetNamespace := c.config.DefaultsNamespace()
-
-	helmValuesSourceRefName := c.config.helmAddonConfig.defaultValuesTemplateConfigMapName
-	if cniVar.Values != nil && cniVar.Values.SourceRef != nil {
-		helmValuesSourceRefName = cniVar.Values.SourceRef.Name
-		targetNamespace = cluster.Namespace
-
-		err := handlersutils.EnsureClusterOwnerReferenceForObject(
-			ctx,
-			c.client,
-			corev1.TypedLocalObjectReference{
-				Kind: cniVar.Values.SourceRef.Kind,
-				Name: cniVar.Values.SourceRef.Name,
-			},
-			cluster,
-		)
-		if err != nil {
-			log.Error(
-				err,
-				"error updating Cluster's owner reference on Flow CNI helm values source object",
-				"name", cniVar.Values.SourceRef.Name,
-				"kind", cniVar.Values.SourceRef.Kind,
-			)
-			resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
-			resp.SetMessage(fmt.Sprintf(
-				"failed to set Cluster's owner reference on Flow CNI helm values source object: %v",
-				err,
-			))
-			return
-		}
-	}
-
 	strategy := addons.NewHelmAddonApplier(
 		addons.NewHelmAddonConfig(
-			helmValuesSourceRefName,
+			c.config.helmAddonConfig.defaultValuesTemplateConfigMapName,
 			defaultNutanixFlowNamespace,
 			defaultNutanixFlowReleaseName,
 		),
@@ -297,10 +270,11 @@ func (c *NutanixFlowCNI) apply(
 		WithValueTemplater(func(cluster *clusterv1.Cluster, text string) (string, error) {
 			return templateValues(cluster, text, imagePullSecretName)
 		}).
+		WithValuesSource(cniVar.Values).
 		WithDefaultWaiter().
 		WithTakeOwnership()
 
-	if err := strategy.Apply(ctx, cluster, targetNamespace, log); err != nil {
+	if err := strategy.Apply(ctx, cluster, c.config.DefaultsNamespace(), log); err != nil {
 		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
 		resp.SetMessage(err.Error())
 		return
@@ -6,12 +6,17 @@ package config
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/go-logr/logr"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	apivariables "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
 )
 
 type Component string
@@ -37,6 +42,38 @@ const (
 	NutanixFlowCNI            Component = "nutanix-flow-cni"
 )
 
+// components is the allow-list of the components whose Helm charts can be overridden per cluster.
+var components = []Component{
+	Autoscaler,
+	Tigera,
+	Cilium,
+	NFD,
+	NutanixStorageCSI,
+	SnapshotController,
+	NutanixCCM,
+	MetalLB,
+	LocalPathProvisionerCSI,
+	AWSEBSCSI,
+	AWSCCM,
+	AWSLoadBalancerController,
+	COSIController,
+	CNCFDistributionRegistry,
+	RegistrySyncer,
+	KonnectorAgent,
+	Multus,
+	NutanixFlowCNI,
+}
+
+// Components returns the names of the components whose Helm charts can be overridden per cluster.
+func Components() []Component {
+	return slices.Clone(components)
+}
+
+// IsComponent returns true if name is the name of a component whose Helm chart can be overridden per cluster.
+func IsComponent(name string) bool {
+	return slices.Contains(components, Component(name))
+}
+
 type HelmChartGetter struct {
 	cl          ctrlclient.Reader
 	cmName      string
@@ -47,6 +84,9 @@ type HelmChart struct {
 	Name       string `yaml:"ChartName"`
 	Version    string `yaml:"ChartVersion"`
 	Repository string `yaml:"RepositoryURL"`
+
+	// Source describes where the Helm chart was read from, including any per-cluster override.
+	Source string `yaml:"-"`
 }
 
 func NewHelmChartGetterFromConfigMap(
@@ -81,9 +121,13 @@ func (h *HelmChartGetter) get(
 	return cm, err
 }
 
+// For returns the Helm chart of the component for the cluster. The Helm chart is read from the default Helm addons
+// ConfigMap, and is overridden by the ConfigMap in the namespace of the cluster annotated with the name of the cluster,
+// then by the Helm chart overrides of the cluster configuration.
 func (h *HelmChartGetter) For(
 	ctx context.Context,
 	log logr.Logger,
+	cluster *clusterv1.Cluster,
 	name Component,
 ) (*HelmChart, error) {
 	log.Info(
@@ -107,5 +151,115 @@ func (h *HelmChartGetter) For(
 	}
 	var settings HelmChart
 	err = yaml.Unmarshal([]byte(d), &settings)
-	return &settings, err
+	if err != nil {
+		return nil, err
+	}
+	settings.Source = fmt.Sprintf("ConfigMap %s/%s", h.cmNamespace, h.cmName)
+
+	overridesCM, err := h.overridesConfigMap(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if overridesCM != nil {
+		if d, ok := overridesCM.Data[string(name)]; ok {
+			log.Info(
+				fmt.Sprintf("Overriding HelmChart info for %q from configmap %s/%s",
+					string(name),
+					overridesCM.Namespace,
+					overridesCM.Name),
+			)
+			var override HelmChart
+			if err := yaml.Unmarshal([]byte(d), &override); err != nil {
+				return nil, fmt.Errorf(
+					"failed to parse key %q in configmap %s/%s: %w",
+					name,
+					overridesCM.Namespace,
+					overridesCM.Name,
+					err,
+				)
+			}
+			settings.override(
+				override,
+				fmt.Sprintf("ConfigMap %s/%s", overridesCM.Namespace, overridesCM.Name),
+			)
+		}
+	}
+
+	overrides, err := apivariables.HelmChartOverrides(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Helm chart overrides from cluster variables: %w", err)
+	}
+	for _, override := range overrides {
+		if override.Component != string(name) {
+			continue
+		}
+		log.Info(fmt.Sprintf("Overriding HelmChart info for %q from cluster variables", string(name)))
+		settings.override(
+			HelmChart{Version: override.Version, Repository: override.RepositoryURL},
+			"Cluster variable clusterConfig.addons.helmChartOverrides",
+		)
+	}
+
+	return &settings, nil
+}
+
+// overridesConfigMap returns the ConfigMap in the namespace of the cluster that overrides the Helm charts of its
+// addons, or nil if there is none. Every key of the ConfigMap must be the name of a component.
+func (h *HelmChartGetter) overridesConfigMap(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+) (*corev1.ConfigMap, error) {
+	cms := &corev1.ConfigMapList{}
+	if err := h.cl.List(ctx, cms, ctrlclient.InNamespace(cluster.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list configmaps in namespace %s: %w", cluster.Namespace, err)
+	}
+
+	var overridesCM *corev1.ConfigMap
+	for i := range cms.Items {
+		cm := &cms.Items[i]
+		if cm.Annotations[v1alpha1.HelmChartOverridesForClusterAnnotationKey] != cluster.Name {
+			continue
+		}
+		if overridesCM != nil {
+			return nil, fmt.Errorf(
+				"found multiple configmaps overriding the Helm charts of cluster %s/%s: %s and %s",
+				cluster.Namespace,
+				cluster.Name,
+				overridesCM.Name,
+				cm.Name,
+			)
+		}
+		overridesCM = cm
+	}
+	if overridesCM == nil {
+		return nil, nil
+	}
+
+	for key := range overridesCM.Data {
+		if !IsComponent(key) {
+			return nil, fmt.Errorf(
+				"configmap %s/%s overrides the Helm chart of unknown component %q, must be one of %v",
+				overridesCM.Namespace,
+				overridesCM.Name,
+				key,
+				components,
+			)
+		}
+	}
+
+	return overridesCM, nil
+}
+
+// override sets the fields of the Helm chart that are set in the override, and records the source of the override.
+func (c *HelmChart) override(override HelmChart, source string) {
+	if override.Name != "" {
+		c.Name = override.Name
+	}
+	if override.Version != "" {
+		c.Version = override.Version
+	}
+	if override.Repository != "" {
+		c.Repository = override.Repository
+	}
+	c.Source = fmt.Sprintf("%s, overridden by %s", c.Source, source)
 }
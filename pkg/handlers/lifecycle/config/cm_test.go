@@ -0,0 +1,184 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	apivariables "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+func TestHelmChartGetterFor(t *testing.T) {
+	t.Parallel()
+
+	defaultsCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "default-helm-addons-config",
+			Namespace: "caren-system",
+		},
+		Data: map[string]string{
+			"cilium": `ChartName: cilium
+ChartVersion: 1.16.0
+RepositoryURL: https://helm.cilium.io/
+`,
+			"nfd": `ChartName: node-feature-discovery
+ChartVersion: 0.16.0
+RepositoryURL: https://kubernetes-sigs.github.io/node-feature-discovery/charts
+`,
+		},
+	}
+	overridesCM := func(name, clusterName string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Annotations: map[string]string{
+					v1alpha1.HelmChartOverridesForClusterAnnotationKey: clusterName,
+				},
+			},
+			Data: data,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		objects       []ctrlclient.Object
+		overrides     []v1alpha1.HelmChartOverride
+		expected      *HelmChart
+		expectedError string
+	}{{
+		name:    "default Helm chart",
+		objects: []ctrlclient.Object{defaultsCM},
+		expected: &HelmChart{
+			Name:       "cilium",
+			Version:    "1.16.0",
+			Repository: "https://helm.cilium.io/",
+			Source:     "ConfigMap caren-system/default-helm-addons-config",
+		},
+	}, {
+		name: "ConfigMap annotated for another cluster is ignored",
+		objects: []ctrlclient.Object{
+			defaultsCM,
+			overridesCM("other-overrides", "other-cluster", map[string]string{"cilium": "ChartVersion: 1.17.0"}),
+		},
+		expected: &HelmChart{
+			Name:       "cilium",
+			Version:    "1.16.0",
+			Repository: "https://helm.cilium.io/",
+			Source:     "ConfigMap caren-system/default-helm-addons-config",
+		},
+	}, {
+		name: "ConfigMap annotated for the cluster overrides the Helm chart",
+		objects: []ctrlclient.Object{
+			defaultsCM,
+			overridesCM("overrides", "test-cluster", map[string]string{
+				"cilium": "ChartVersion: 1.17.0\nRepositoryURL: https://example.com/charts\n",
+			}),
+		},
+		expected: &HelmChart{
+			Name:       "cilium",
+			Version:    "1.17.0",
+			Repository: "https://example.com/charts",
+			Source: "ConfigMap caren-system/default-helm-addons-config, " +
+				"overridden by ConfigMap test-namespace/overrides",
+		},
+	}, {
+		name: "cluster variables override the ConfigMap annotated for the cluster",
+		objects: []ctrlclient.Object{
+			defaultsCM,
+			overridesCM("overrides", "test-cluster", map[string]string{
+				"cilium": "ChartVersion: 1.17.0\nRepositoryURL: https://example.com/charts\n",
+			}),
+		},
+		overrides: []v1alpha1.HelmChartOverride{
+			{Component: "nfd", Version: "0.17.0"},
+			{Component: "cilium", Version: "1.17.1"},
+		},
+		expected: &HelmChart{
+			Name:       "cilium",
+			Version:    "1.17.1",
+			Repository: "https://example.com/charts",
+			Source: "ConfigMap caren-system/default-helm-addons-config, " +
+				"overridden by ConfigMap test-namespace/overrides, " +
+				"overridden by Cluster variable clusterConfig.addons.helmChartOverrides",
+		},
+	}, {
+		name: "ConfigMap overriding the Helm chart of an unknown component",
+		objects: []ctrlclient.Object{
+			defaultsCM,
+			overridesCM("overrides", "test-cluster", map[string]string{"calico": "ChartVersion: 3.29.0"}),
+		},
+		expectedError: `configmap test-namespace/overrides overrides the Helm chart of unknown component "calico"`,
+	}, {
+		name: "multiple ConfigMaps annotated for the cluster",
+		objects: []ctrlclient.Object{
+			defaultsCM,
+			overridesCM("overrides-a", "test-cluster", map[string]string{"cilium": "ChartVersion: 1.17.0"}),
+			overridesCM("overrides-b", "test-cluster", map[string]string{"nfd": "ChartVersion: 0.17.0"}),
+		},
+		expectedError: "found multiple configmaps overriding the Helm charts of cluster test-namespace/test-cluster",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "test-namespace",
+				},
+			}
+			if tt.overrides != nil {
+				variable, err := apivariables.MarshalToClusterVariable(
+					v1alpha1.ClusterConfigVariableName,
+					&apivariables.ClusterConfigSpec{
+						Addons: &apivariables.Addons{
+							GenericAddons: v1alpha1.GenericAddons{
+								HelmChartOverrides: tt.overrides,
+							},
+						},
+					},
+				)
+				require.NoError(t, err)
+				cluster.Spec.Topology.Variables = []clusterv1.ClusterVariable{*variable}
+			}
+
+			getter := NewHelmChartGetterFromConfigMap(
+				"default-helm-addons-config",
+				"caren-system",
+				fake.NewClientBuilder().WithObjects(tt.objects...).Build(),
+			)
+
+			helmChart, err := getter.For(context.Background(), logr.Discard(), cluster, Cilium)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, helmChart)
+		})
+	}
+}
+
+func TestIsComponent(t *testing.T) {
+	t.Parallel()
+
+	for _, component := range Components() {
+		assert.True(t, IsComponent(string(component)), component)
+	}
+	assert.False(t, IsComponent("calico"))
+	assert.False(t, IsComponent(""))
+}
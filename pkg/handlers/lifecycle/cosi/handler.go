@@ -21,6 +21,7 @@ import (
 	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/addons"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/config"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/csi"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/options"
 )
 
@@ -63,6 +64,7 @@ var (
 	_ commonhandlers.Named                   = &DefaultCOSIController{}
 	_ lifecycle.AfterControlPlaneInitialized = &DefaultCOSIController{}
 	_ lifecycle.BeforeClusterUpgrade         = &DefaultCOSIController{}
+	_ lifecycle.Dependent                    = &DefaultCOSIController{}
 )
 
 func New(
@@ -83,6 +85,13 @@ func (n *DefaultCOSIController) Name() string {
 	return "COSIControllerHandler"
 }
 
+// Dependencies returns the CSI handler so that object storage is installed after block storage.
+func (n *DefaultCOSIController) Dependencies() []string {
+	return []string{
+		csi.HandlerName,
+	}
+}
+
 func (n *DefaultCOSIController) AfterControlPlaneInitialized(
 	ctx context.Context,
 	req *runtimehooksv1.AfterControlPlaneInitializedRequest,
@@ -96,6 +105,7 @@ func (n *DefaultCOSIController) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonCOSIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -113,6 +123,7 @@ func (n *DefaultCOSIController) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonCOSIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -156,7 +167,7 @@ func (n *DefaultCOSIController) apply(
 	var strategy addons.Applier
 	switch cosiVar.Strategy {
 	case v1alpha1.AddonStrategyHelmAddon:
-		helmChart, err := n.helmChartInfoGetter.For(ctx, log, config.COSIController)
+		helmChart, err := n.helmChartInfoGetter.For(ctx, log, cluster, config.COSIController)
 		if err != nil {
 			log.Error(
 				err,
@@ -174,7 +185,7 @@ func (n *DefaultCOSIController) apply(
 			n.config.helmAddonConfig,
 			n.client,
 			helmChart,
-		)
+		).WithValuesSource(cosiVar.Values)
 	case v1alpha1.AddonStrategyClusterResourceSet:
 		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
 		resp.SetMessage(
@@ -81,7 +81,7 @@ func (a *AWSEBS) Apply(
 	var strategy addons.Applier
 	switch provider.Strategy {
 	case v1alpha1.AddonStrategyHelmAddon:
-		helmChart, err := a.helmChartInfoGetter.For(ctx, log, config.AWSEBSCSI)
+		helmChart, err := a.helmChartInfoGetter.For(ctx, log, cluster, config.AWSEBSCSI)
 		if err != nil {
 			return fmt.Errorf("failed to get configuration to create helm addon: %w", err)
 		}
@@ -89,7 +89,7 @@ func (a *AWSEBS) Apply(
 			a.config.helmAddonConfig,
 			a.client,
 			helmChart,
-		)
+		).WithValuesSource(provider.Values)
 	case v1alpha1.AddonStrategyClusterResourceSet:
 		strategy = crsStrategy{
 			config: a.config.crsConfig,
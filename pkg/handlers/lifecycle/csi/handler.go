@@ -19,6 +19,8 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/lifecycle"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
 	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/addons"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/csi/snapshotcontroller"
 )
 
 const (
@@ -46,6 +48,7 @@ var (
 	_ commonhandlers.Named                   = &CSIHandler{}
 	_ lifecycle.AfterControlPlaneInitialized = &CSIHandler{}
 	_ lifecycle.BeforeClusterUpgrade         = &CSIHandler{}
+	_ lifecycle.Dependent                    = &CSIHandler{}
 )
 
 func New(
@@ -60,8 +63,19 @@ func New(
 	}
 }
 
+// HandlerName is the name of the CSI lifecycle handler.
+const HandlerName = "CSIHandler"
+
 func (c *CSIHandler) Name() string {
-	return "CSIHandler"
+	return HandlerName
+}
+
+// Dependencies returns the snapshot controller handler, as CSI providers may create snapshot
+// resources that require the snapshot CRDs and controller to be installed.
+func (c *CSIHandler) Dependencies() []string {
+	return []string{
+		snapshotcontroller.HandlerName,
+	}
 }
 
 func (c *CSIHandler) AfterControlPlaneInitialized(
@@ -77,6 +91,7 @@ func (c *CSIHandler) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCSIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -94,6 +109,7 @@ func (c *CSIHandler) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	c.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, c.client, cluster, v1alpha1.AddonCSIReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
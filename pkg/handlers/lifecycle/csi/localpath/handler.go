@@ -77,7 +77,7 @@ func (l *LocalPathProvisionerCSI) Apply(
 	var strategy addons.Applier
 	switch provider.Strategy {
 	case v1alpha1.AddonStrategyHelmAddon:
-		helmChart, err := l.helmChartInfoGetter.For(ctx, log, config.LocalPathProvisionerCSI)
+		helmChart, err := l.helmChartInfoGetter.For(ctx, log, cluster, config.LocalPathProvisionerCSI)
 		if err != nil {
 			return fmt.Errorf("failed to get configuration to create helm addon: %w", err)
 		}
@@ -85,7 +85,7 @@ func (l *LocalPathProvisionerCSI) Apply(
 			l.config.helmAddonConfig,
 			l.client,
 			helmChart,
-		)
+		).WithValuesSource(provider.Values)
 	case v1alpha1.AddonStrategyClusterResourceSet:
 		strategy = crsStrategy{
 			config: l.config.crsConfig,
@@ -100,7 +100,7 @@ func (n *NutanixCSI) Apply(
 	var strategy addons.Applier
 	switch provider.Strategy {
 	case v1alpha1.AddonStrategyHelmAddon:
-		helmChart, err := n.helmChartInfoGetter.For(ctx, log, config.NutanixStorageCSI)
+		helmChart, err := n.helmChartInfoGetter.For(ctx, log, cluster, config.NutanixStorageCSI)
 		if err != nil {
 			return fmt.Errorf(
 				"failed to get configuration for Nutanix storage chart to create helm addon: %w",
@@ -111,7 +111,9 @@ func (n *NutanixCSI) Apply(
 			n.config.helmAddonConfig,
 			n.client,
 			helmChart,
-		).WithValueTemplater(templateValuesFunc(cluster))
+		).
+			WithValueTemplater(templateValuesFunc(cluster)).
+			WithValuesSource(provider.Values)
 	case "":
 		return fmt.Errorf("strategy not provided for Nutanix CSI driver")
 	default:
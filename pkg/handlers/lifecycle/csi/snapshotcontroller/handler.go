@@ -67,8 +67,11 @@ type SnapshotControllerHandler struct {
 	helmChartInfoGetter *config.HelmChartGetter
 }
 
+// HandlerName is the name of the snapshot controller lifecycle handler.
+const HandlerName = "SnapshotControllerHandler"
+
 func (s *SnapshotControllerHandler) Name() string {
-	return "SnapshotControllerHandler"
+	return HandlerName
 }
 
 func (s *SnapshotControllerHandler) AfterControlPlaneInitialized(
@@ -84,6 +87,7 @@ func (s *SnapshotControllerHandler) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	s.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, s.client, cluster, v1alpha1.AddonSnapshotControllerReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -101,6 +105,7 @@ func (s *SnapshotControllerHandler) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	s.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, s.client, cluster, v1alpha1.AddonSnapshotControllerReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -152,7 +157,7 @@ func (s *SnapshotControllerHandler) apply(
 	var strategy addons.Applier
 	switch snapshotControllerVar.Strategy {
 	case v1alpha1.AddonStrategyHelmAddon:
-		helmChart, err := s.helmChartInfoGetter.For(ctx, log, config.SnapshotController)
+		helmChart, err := s.helmChartInfoGetter.For(ctx, log, cluster, config.SnapshotController)
 		if err != nil {
 			msg := "failed to get configuration to create helm addon"
 			log.Error(err, msg)
@@ -31,6 +31,7 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/nfd"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/registry"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/registry/cncfdistribution"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/resourcegc"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/servicelbgc"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/serviceloadbalancer"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/serviceloadbalancer/metallb"
@@ -130,6 +131,8 @@ func (h *Handlers) AllHandlers(mgr manager.Manager) []handlers.Named {
 			helmChartInfoGetter,
 		),
 	}
+	// Handlers are run in parallel, except where a handler declares dependencies on other handlers
+	// via lifecycle.Dependent, in which case it is only run once its dependencies have succeeded.
 	allHandlers := []handlers.Named{
 		calico.New(mgr.GetClient(), h.calicoCNIConfig, helmChartInfoGetter),
 		cilium.New(mgr.GetClient(), h.ciliumCNIConfig, helmChartInfoGetter),
@@ -144,12 +147,13 @@ func (h *Handlers) AllHandlers(mgr manager.Manager) []handlers.Named {
 		konnectoragent.New(mgr.GetClient(), h.konnectorAgentConfig, helmChartInfoGetter),
 		awsloadbalancercontroller.New(mgr.GetClient(), h.awsLoadBalancerControllerConfig, helmChartInfoGetter),
 		servicelbgc.New(mgr.GetClient()),
+		resourcegc.New(
+			mgr.GetClient(),
+			resourcegc.PersistentVolumeClaims{},
+			resourcegc.Ingresses{},
+			resourcegc.BucketClaims{},
+		),
 		registry.New(mgr.GetClient(), registryHandlers),
-		// The order of the handlers in the list is important and are called consecutively.
-		// The MetalLB provider may be configured to create a IPAddressPool on the remote cluster.
-		// However, the MetalLB provider also has a webhook that validates IPAddressPool requests.
-		// Because this webhook relies on CNI and CCM to already be installed on the remote cluster,
-		// we are placing this handler after the CNI and CCM handlers.
 		serviceloadbalancer.New(mgr.GetClient(), serviceLoadBalancerHandlers),
 	}
 
@@ -215,6 +219,66 @@ func (h *Handlers) AllHandlers(mgr manager.Manager) []handlers.Named {
 		)
 	}
 
+	bcpuHandlers := lo.FlatMap(
+		allHandlers,
+		func(h handlers.Named, _ int) []lifecycle.BeforeControlPlaneUpgrade {
+			if h, ok := h.(lifecycle.BeforeControlPlaneUpgrade); ok {
+				return []lifecycle.BeforeControlPlaneUpgrade{h}
+			}
+			return nil
+		})
+	if len(bcpuHandlers) > 0 {
+		orderedHandlers = append(
+			orderedHandlers,
+			lifecycle.ParallelBeforeControlPlaneUpgradeHook("caren", bcpuHandlers...),
+		)
+	}
+
+	bwuHandlers := lo.FlatMap(
+		allHandlers,
+		func(h handlers.Named, _ int) []lifecycle.BeforeWorkersUpgrade {
+			if h, ok := h.(lifecycle.BeforeWorkersUpgrade); ok {
+				return []lifecycle.BeforeWorkersUpgrade{h}
+			}
+			return nil
+		})
+	if len(bwuHandlers) > 0 {
+		orderedHandlers = append(
+			orderedHandlers,
+			lifecycle.ParallelBeforeWorkersUpgradeHook("caren", bwuHandlers...),
+		)
+	}
+
+	awuHandlers := lo.FlatMap(
+		allHandlers,
+		func(h handlers.Named, _ int) []lifecycle.AfterWorkersUpgrade {
+			if h, ok := h.(lifecycle.AfterWorkersUpgrade); ok {
+				return []lifecycle.AfterWorkersUpgrade{h}
+			}
+			return nil
+		})
+	if len(awuHandlers) > 0 {
+		orderedHandlers = append(
+			orderedHandlers,
+			lifecycle.ParallelAfterWorkersUpgradeHook("caren", awuHandlers...),
+		)
+	}
+
+	acuHandlers := lo.FlatMap(
+		allHandlers,
+		func(h handlers.Named, _ int) []lifecycle.AfterClusterUpgrade {
+			if h, ok := h.(lifecycle.AfterClusterUpgrade); ok {
+				return []lifecycle.AfterClusterUpgrade{h}
+			}
+			return nil
+		})
+	if len(acuHandlers) > 0 {
+		orderedHandlers = append(
+			orderedHandlers,
+			lifecycle.ParallelAfterClusterUpgradeHook("caren", acuHandlers...),
+		)
+	}
+
 	bcdHandlers := lo.FlatMap(
 		allHandlers,
 		func(h handlers.Named, _ int) []lifecycle.BeforeClusterDelete {
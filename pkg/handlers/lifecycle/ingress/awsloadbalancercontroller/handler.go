@@ -96,6 +96,7 @@ func (n *DefaultAWSLoadBalancerController) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonAWSLoadBalancerControllerReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -113,6 +114,7 @@ func (n *DefaultAWSLoadBalancerController) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonAWSLoadBalancerControllerReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -163,7 +165,7 @@ func (n *DefaultAWSLoadBalancerController) apply(
 
 	log.Info("Installing AWS Load Balancer Controller addon")
 
-	helmChart, err := n.helmChartInfoGetter.For(ctx, log, config.AWSLoadBalancerController)
+	helmChart, err := n.helmChartInfoGetter.For(ctx, log, cluster, config.AWSLoadBalancerController)
 	if err != nil {
 		log.Error(
 			err,
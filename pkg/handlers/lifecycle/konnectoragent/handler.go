@@ -129,6 +129,7 @@ func (n *DefaultKonnectorAgent) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonKonnectorAgentReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -146,6 +147,7 @@ func (n *DefaultKonnectorAgent) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonKonnectorAgentReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -269,7 +271,7 @@ func (n *DefaultKonnectorAgent) apply(
 	}
 
 	var strategy addons.Applier
-	helmChart, err := n.helmChartInfoGetter.For(ctx, log, config.KonnectorAgent)
+	helmChart, err := n.helmChartInfoGetter.For(ctx, log, cluster, config.KonnectorAgent)
 	if err != nil {
 		log.Error(
 			err,
@@ -99,6 +99,7 @@ func (n *DefaultNFD) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonNFDReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -116,6 +117,7 @@ func (n *DefaultNFD) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	n.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, n.client, cluster, v1alpha1.AddonNFDReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -162,7 +164,7 @@ func (n *DefaultNFD) apply(
 			client: n.client,
 		}
 	case v1alpha1.AddonStrategyHelmAddon:
-		helmChart, err := n.helmChartInfoGetter.For(ctx, log, config.NFD)
+		helmChart, err := n.helmChartInfoGetter.For(ctx, log, cluster, config.NFD)
 		if err != nil {
 			log.Error(
 				err,
@@ -210,7 +212,7 @@ func (n *DefaultNFD) apply(
 			n.config.helmAddonConfig,
 			n.client,
 			helmChart,
-		)
+		).WithValuesSource(cniVar.Values)
 	case "":
 		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
 		resp.SetMessage("strategy not provided for NFD")
@@ -122,7 +122,7 @@ func (n *CNCFDistribution) Apply(
 	}
 
 	log.Info("Applying CNCF Distribution registry installation")
-	helmChartInfo, err := n.helmChartInfoGetter.For(ctx, log, config.CNCFDistributionRegistry)
+	helmChartInfo, err := n.helmChartInfoGetter.For(ctx, log, cluster, config.CNCFDistributionRegistry)
 	if err != nil {
 		return fmt.Errorf("failed to get CNCF Distribution registry helm chart: %w", err)
 	}
@@ -77,7 +77,7 @@ func (n *RegistrySyncer) Apply(
 	}
 
 	log.Info("Applying registry syncer for cluster")
-	helmChartInfo, err := n.helmChartInfoGetter.For(ctx, log, config.RegistrySyncer)
+	helmChartInfo, err := n.helmChartInfoGetter.For(ctx, log, cluster, config.RegistrySyncer)
 	if err != nil {
 		return fmt.Errorf("failed to get registry syncer helm chart: %w", err)
 	}
@@ -0,0 +1,84 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// cosiGroupVersion is the API group and version of the Container Object Storage Interface (COSI) API.
+// The API types are read as unstructured objects, so that the COSI API types are not a dependency.
+var cosiGroupVersion = schema.GroupVersion{Group: "objectstorage.k8s.io", Version: "v1alpha1"}
+
+// BucketClaims deletes the COSI BucketClaims bound to Buckets with the Delete deletion policy, so that
+// the COSI driver deletes the buckets. It waits for the Buckets to be deleted. It does nothing if the
+// COSI API is not installed.
+type BucketClaims struct{}
+
+var _ Deleter = BucketClaims{}
+
+func (BucketClaims) Name() string {
+	return "BucketClaims"
+}
+
+func (BucketClaims) Delete(ctx context.Context, c ctrlclient.Client, log logr.Logger) error {
+	log.Info("Listing COSI Buckets with deletion policy Delete")
+	buckets := &unstructured.UnstructuredList{}
+	buckets.SetGroupVersionKind(cosiGroupVersion.WithKind("BucketList"))
+	if err := c.List(ctx, buckets); err != nil {
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			log.V(5).Info("COSI API is not installed, skipping deleting BucketClaims")
+			return nil
+		}
+		return fmt.Errorf("error listing Buckets: %w", err)
+	}
+
+	var d deletion
+	for idx := range buckets.Items {
+		bucket := &buckets.Items[idx]
+		claimRef, needsDelete := bucketNeedsDelete(bucket)
+		if !needsDelete {
+			continue
+		}
+		// The COSI controller deletes the Bucket once the bucket is deleted.
+		d.waitFor("Bucket", bucket)
+
+		bucketClaim := &unstructured.Unstructured{}
+		bucketClaim.SetGroupVersionKind(cosiGroupVersion.WithKind("BucketClaim"))
+		err := c.Get(ctx, claimRef, bucketClaim)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error getting BucketClaim for Bucket %s: %w", bucket.GetName(), err)
+		}
+
+		d.deleteObject(ctx, c, log, "BucketClaim", bucketClaim)
+	}
+
+	return d.err()
+}
+
+// bucketNeedsDelete returns the key of the BucketClaim that the Bucket is bound to, and true, if
+// the bucket is deleted when the BucketClaim is deleted.
+func bucketNeedsDelete(bucket *unstructured.Unstructured) (ctrlclient.ObjectKey, bool) {
+	deletionPolicy, _, _ := unstructured.NestedString(bucket.Object, "spec", "deletionPolicy")
+	if deletionPolicy != "Delete" {
+		return ctrlclient.ObjectKey{}, false
+	}
+	name, _, _ := unstructured.NestedString(bucket.Object, "spec", "bucketClaim", "name")
+	namespace, _, _ := unstructured.NestedString(bucket.Object, "spec", "bucketClaim", "namespace")
+	if name == "" || namespace == "" {
+		return ctrlclient.ObjectKey{}, false
+	}
+	return ctrlclient.ObjectKey{Namespace: namespace, Name: name}, true
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testBucket(name, deletionPolicy, claimNamespace, claimName string) *unstructured.Unstructured {
+	bucket := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": name},
+		"spec": map[string]any{
+			"deletionPolicy": deletionPolicy,
+			"bucketClaim": map[string]any{
+				"namespace": claimNamespace,
+				"name":      claimName,
+			},
+		},
+	}}
+	bucket.SetGroupVersionKind(cosiGroupVersion.WithKind("Bucket"))
+	return bucket
+}
+
+func testBucketClaim(namespace, name string) *unstructured.Unstructured {
+	bucketClaim := &unstructured.Unstructured{}
+	bucketClaim.SetGroupVersionKind(cosiGroupVersion.WithKind("BucketClaim"))
+	bucketClaim.SetNamespace(namespace)
+	bucketClaim.SetName(name)
+	return bucketClaim
+}
+
+func cosiRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(cosiGroupVersion.WithKind("Bucket"), meta.RESTScopeRoot)
+	mapper.Add(cosiGroupVersion.WithKind("BucketClaim"), meta.RESTScopeNamespace)
+	return mapper
+}
+
+func TestBucketClaimsDelete(t *testing.T) {
+	t.Parallel()
+
+	c := fake.NewClientBuilder().
+		WithRESTMapper(cosiRESTMapper()).
+		WithObjects(
+			testBucket("bucket-1", "Delete", "ns-1", "claim-1"),
+			testBucket("bucket-2", "Retain", "ns-1", "claim-2"),
+			testBucketClaim("ns-1", "claim-1"),
+			testBucketClaim("ns-1", "claim-2"),
+		).
+		Build()
+
+	err := BucketClaims{}.Delete(context.Background(), c, logr.Discard())
+	require.ErrorIs(t, err, ErrResourcesStillExist)
+	assert.EqualError(
+		t,
+		err,
+		"waiting for kubernetes resources to be fully deleted: "+
+			"waiting for the following resources to be fully deleted: "+
+			"Bucket bucket-1, BucketClaim ns-1/claim-1",
+	)
+
+	err = c.Get(context.Background(), ctrlclient.ObjectKey{Namespace: "ns-1", Name: "claim-1"}, testBucketClaim("", ""))
+	assert.True(t, apierrors.IsNotFound(err), "BucketClaim should be deleted")
+	require.NoError(
+		t,
+		c.Get(context.Background(), ctrlclient.ObjectKey{Namespace: "ns-1", Name: "claim-2"}, testBucketClaim("", "")),
+		"BucketClaim of retained Bucket should not be deleted",
+	)
+}
+
+func TestBucketClaimsDelete_COSINotInstalled(t *testing.T) {
+	t.Parallel()
+
+	c := fake.NewClientBuilder().Build()
+
+	require.NoError(t, BucketClaims{}.Delete(context.Background(), c, logr.Discard()))
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	ErrFailedToDeleteResources = errors.New("failed to delete kubernetes resources")
+	ErrResourcesStillExist     = errors.New("waiting for kubernetes resources to be fully deleted")
+)
+
+// Deleter deletes resources of one kind from the workload cluster, so that the infrastructure resources
+// backing them are cleaned up before the cluster is deleted.
+type Deleter interface {
+	// Name returns the name of the resources that the deleter deletes, used in logs and messages.
+	Name() string
+
+	// Delete deletes the resources. It returns an error wrapping ErrResourcesStillExist until the
+	// resources, and the resources backing them, are fully deleted, and an error wrapping
+	// ErrFailedToDeleteResources if any resource could not be deleted.
+	Delete(ctx context.Context, c ctrlclient.Client, log logr.Logger) error
+}
+
+// deletion tracks the resources that a deleter failed to delete, and that still exist.
+type deletion struct {
+	failedToDelete []string
+	stillExisting  []string
+	recorded       map[string]struct{}
+}
+
+// deleteObject deletes the object, unless it is already being deleted, and waits for it to be fully
+// deleted. The kind is used in logs and messages.
+func (d *deletion) deleteObject(
+	ctx context.Context,
+	c ctrlclient.Client,
+	log logr.Logger,
+	kind string,
+	obj ctrlclient.Object,
+) {
+	// An object, for example, a Pod using many claims, is deleted only once.
+	if !d.waitFor(kind, obj) || obj.GetDeletionTimestamp() != nil {
+		return
+	}
+
+	name := objectName(obj)
+	log.Info(fmt.Sprintf("Deleting %s %s", kind, name))
+	if err := c.Delete(ctx, obj); ctrlclient.IgnoreNotFound(err) != nil {
+		log.Error(err, fmt.Sprintf("Error deleting %s %s", kind, name))
+		d.failedToDelete = append(d.failedToDelete, kind+" "+name)
+	}
+}
+
+// waitFor records that the object still exists, for example, because it is deleted by a controller
+// once the resources using it are deleted. It returns false if the object was already recorded.
+func (d *deletion) waitFor(kind string, obj ctrlclient.Object) bool {
+	resource := kind + " " + objectName(obj)
+	if _, ok := d.recorded[resource]; ok {
+		return false
+	}
+	if d.recorded == nil {
+		d.recorded = make(map[string]struct{})
+	}
+	d.recorded[resource] = struct{}{}
+	d.stillExisting = append(d.stillExisting, resource)
+	return true
+}
+
+// err returns an error if any resource could not be deleted, or still exists.
+func (d *deletion) err() error {
+	if len(d.failedToDelete) > 0 {
+		return fmt.Errorf("%w: the following resources could not be deleted "+
+			"and must be cleaned up manually before deleting the cluster: %s",
+			ErrFailedToDeleteResources,
+			strings.Join(d.failedToDelete, ", "),
+		)
+	}
+	if len(d.stillExisting) > 0 {
+		return fmt.Errorf("%w: waiting for the following resources to be fully deleted: %s",
+			ErrResourcesStillExist,
+			strings.Join(d.stillExisting, ", "),
+		)
+	}
+	return nil
+}
+
+// objectName returns the namespaced name of a namespaced object, and the name of a cluster-scoped
+// object.
+func objectName(obj ctrlclient.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return ctrlclient.ObjectKeyFromObject(obj).String()
+}
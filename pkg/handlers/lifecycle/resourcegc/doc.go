@@ -0,0 +1,9 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package resourcegc deletes resources from the workload cluster, whose backing infrastructure resources
+// would otherwise leak, before the cluster is deleted. For example, deleting a PersistentVolumeClaim
+// triggers the CSI driver to delete the volume it provisioned.
+//
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=watch;list;get
+package resourcegc
@@ -0,0 +1,60 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// isV1Beta2ConditionTrue checks if a v1beta2 condition is True on the cluster.
+// This is a local equivalent of conditions.IsTrue that works with v1beta2 Cluster types,
+// since the upstream util/conditions package now requires metav1.Condition getters.
+func isV1Beta2ConditionTrue(cluster *clusterv1.Cluster, condType string) bool {
+	for _, c := range cluster.GetConditions() {
+		if c.Type == condType && c.Status == metav1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldGarbageCollect returns true if resources should be deleted from the workload cluster before
+// the cluster is deleted. It returns false if the cluster opted out by setting the annotation to false,
+// or if the cluster is in a phase where resources cannot, or need not, be deleted.
+func ShouldGarbageCollect(cluster *clusterv1.Cluster, annotationKey string) (bool, error) {
+	// Use the Cluster annotations to skip deleting
+	val, found := cluster.GetAnnotations()[annotationKey]
+	if !found {
+		val = "true"
+	}
+	shouldDeleteBasedOnAnnotation, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, fmt.Errorf(
+			"failed to convert value %s of annotation %s to bool: %w",
+			val,
+			annotationKey,
+			err,
+		)
+	}
+
+	// Use the Cluster phase to determine if it's safe to skip deleting:
+	//
+	// - when ClusterPhasePending or ClusterPhaseProvisioning Kubernetes API has not been created
+	// and the user would not have been able to create any Kubernetes resources that would prevent cleanup
+	//
+	// - when ClusterPhaseDeleting it's too late to try to cleanup.
+	phase := cluster.Status.GetTypedPhase()
+	skipDeleteBasedOnPhase := phase == clusterv1.ClusterPhasePending ||
+		phase == clusterv1.ClusterPhaseProvisioning ||
+		phase == clusterv1.ClusterPhaseDeleting
+
+	// use the Cluster conditions to determine if the API server is even reachable
+	controlPlaneReachable := isV1Beta2ConditionTrue(cluster, clusterv1.ClusterControlPlaneInitializedCondition)
+
+	return shouldDeleteBasedOnAnnotation && controlPlaneReachable && !skipDeleteBasedOnPhase, nil
+}
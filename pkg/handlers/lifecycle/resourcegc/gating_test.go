@@ -0,0 +1,86 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestShouldGarbageCollect(t *testing.T) {
+	t.Parallel()
+
+	initialized := []metav1.Condition{{
+		Type:   clusterv1.ClusterControlPlaneInitializedCondition,
+		Status: metav1.ConditionTrue,
+	}}
+
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		status       clusterv1.ClusterStatus
+		shouldDelete bool
+		expectedErr  string
+	}{{
+		name: "should delete",
+		status: clusterv1.ClusterStatus{
+			Conditions: initialized,
+			Phase:      string(clusterv1.ClusterPhaseProvisioned),
+		},
+		shouldDelete: true,
+	}, {
+		name:        "should not delete: annotation is set to false",
+		annotations: map[string]string{ResourceGCAnnotation: "false"},
+		status: clusterv1.ClusterStatus{
+			Conditions: initialized,
+			Phase:      string(clusterv1.ClusterPhaseProvisioned),
+		},
+		shouldDelete: false,
+	}, {
+		name:        "should delete: other annotation is set to false",
+		annotations: map[string]string{"caren.nutanix.com/loadbalancer-gc": "false"},
+		status: clusterv1.ClusterStatus{
+			Conditions: initialized,
+			Phase:      string(clusterv1.ClusterPhaseProvisioned),
+		},
+		shouldDelete: true,
+	}, {
+		name: "should not delete: phase is Provisioning",
+		status: clusterv1.ClusterStatus{
+			Conditions: initialized,
+			Phase:      string(clusterv1.ClusterPhaseProvisioning),
+		},
+		shouldDelete: false,
+	}, {
+		name: "should not delete: ControlPlaneInitialized condition is not set",
+		status: clusterv1.ClusterStatus{
+			Phase: string(clusterv1.ClusterPhaseProvisioned),
+		},
+		shouldDelete: false,
+	}, {
+		name:        "invalid annotation",
+		annotations: map[string]string{ResourceGCAnnotation: "maybe"},
+		expectedErr: "failed to convert value maybe of annotation caren.nutanix.com/resource-gc to bool",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Annotations: tt.annotations},
+				Status:     tt.status,
+			}
+			shouldDelete, err := ShouldGarbageCollect(cluster, ResourceGCAnnotation)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.shouldDelete, shouldDelete)
+		})
+	}
+}
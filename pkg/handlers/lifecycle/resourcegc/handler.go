@@ -0,0 +1,130 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/lifecycle"
+	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+	carenhandlers "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers"
+)
+
+const (
+	// ResourceGCAnnotation opts a cluster out of deleting resources from the workload cluster before the
+	// cluster is deleted, when set to false.
+	ResourceGCAnnotation = carenhandlers.MetadataDomain + "/resource-gc"
+)
+
+type ResourceGC struct {
+	client              ctrlclient.Client
+	clusterClientGetter remote.ClusterClientGetter
+	deleters            []Deleter
+}
+
+var (
+	_ handlers.Named                = &ResourceGC{}
+	_ lifecycle.BeforeClusterDelete = &ResourceGC{}
+)
+
+// New returns a handler that runs the deleters against the workload cluster before the cluster is
+// deleted.
+func New(client ctrlclient.Client, deleters ...Deleter) *ResourceGC {
+	return &ResourceGC{
+		client:              client,
+		clusterClientGetter: remote.NewClusterClient,
+		deleters:            deleters,
+	}
+}
+
+func (g *ResourceGC) Name() string {
+	return "ResourceGC"
+}
+
+func (g *ResourceGC) BeforeClusterDelete(
+	ctx context.Context,
+	req *runtimehooksv1.BeforeClusterDeleteRequest,
+	resp *runtimehooksv1.BeforeClusterDeleteResponse,
+) {
+	cluster, err := capiutils.ConvertV1Beta1ClusterToV1Beta2(&req.Cluster)
+	if err != nil {
+		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+		resp.SetMessage(fmt.Sprintf("failed to convert cluster: %v", err))
+		return
+	}
+	clusterKey := ctrlclient.ObjectKeyFromObject(cluster)
+
+	log := ctrl.LoggerFrom(ctx).WithValues(
+		"cluster",
+		clusterKey,
+	)
+
+	// CAPI 1.12+ strips cluster status from the hook request. Fetch the full Cluster from the API
+	// so we have phase and conditions for the cleanup decision.
+	clusterWithStatus := &clusterv1.Cluster{}
+	if err := g.client.Get(ctx, clusterKey, clusterWithStatus); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Cluster not found (may already be deleted), allowing deletion")
+			resp.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+			return
+		}
+		log.Error(err, "Failed to get cluster with status for resource GC decision, will retry")
+		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+		resp.SetMessage(fmt.Sprintf("failed to get cluster with status: %v", err))
+		resp.SetRetryAfterSeconds(5)
+		return
+	}
+
+	shouldDelete, err := ShouldGarbageCollect(clusterWithStatus, ResourceGCAnnotation)
+	if err != nil {
+		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+		resp.SetMessage(fmt.Sprintf("error determining if resources should be deleted: %v", err))
+		return
+	}
+
+	if !shouldDelete {
+		resp.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+		return
+	}
+
+	remoteClient, err := g.clusterClientGetter(
+		ctx,
+		"",
+		g.client,
+		clusterKey,
+	)
+	if err != nil {
+		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+		resp.SetMessage(fmt.Sprintf("error creating remote cluster client: %v", err))
+		resp.SetRetryAfterSeconds(5)
+		return
+	}
+
+	// All deleters run, even if one fails, so that all resources are deleted as soon as possible.
+	var errs []error
+	for _, deleter := range g.deleters {
+		log.Info(fmt.Sprintf("Will attempt to delete %s", deleter.Name()))
+		if err := deleter.Delete(ctx, remoteClient, log.WithValues("deleter", deleter.Name())); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		resp.SetStatus(runtimehooksv1.ResponseStatusFailure)
+		resp.SetMessage(err.Error())
+		resp.SetRetryAfterSeconds(5)
+		return
+	}
+
+	resp.SetStatus(runtimehooksv1.ResponseStatusSuccess)
+}
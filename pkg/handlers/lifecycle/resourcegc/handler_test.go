@@ -0,0 +1,140 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	remotefake "sigs.k8s.io/cluster-api/controllers/remote/fake"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type mockDeleter struct {
+	err    error
+	called bool
+}
+
+func (m *mockDeleter) Name() string {
+	return "Mock"
+}
+
+func (m *mockDeleter) Delete(_ context.Context, _ ctrlclient.Client, _ logr.Logger) error {
+	m.called = true
+	return m.err
+}
+
+//nolint:funlen // Long tests are OK
+func TestBeforeClusterDelete(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1beta2.AddToScheme(scheme))
+
+	const (
+		clusterName      = "test-cluster"
+		clusterNamespace = "default"
+	)
+
+	tests := []struct {
+		name            string
+		annotations     map[string]string
+		deleterErrs     []error
+		wantStatus      runtimehooksv1.ResponseStatus
+		wantRetryAfter  bool
+		wantDeleterCall bool
+	}{{
+		name:            "all resources deleted: success",
+		deleterErrs:     []error{nil, nil},
+		wantStatus:      runtimehooksv1.ResponseStatusSuccess,
+		wantDeleterCall: true,
+	}, {
+		name:            "resources still exist: failure with retry",
+		deleterErrs:     []error{ErrResourcesStillExist, nil},
+		wantStatus:      runtimehooksv1.ResponseStatusFailure,
+		wantRetryAfter:  true,
+		wantDeleterCall: true,
+	}, {
+		name:            "deleter error: failure with retry, and other deleters run",
+		deleterErrs:     []error{nil, errors.New("injected failure")},
+		wantStatus:      runtimehooksv1.ResponseStatusFailure,
+		wantRetryAfter:  true,
+		wantDeleterCall: true,
+	}, {
+		name:            "opted out: success without deleting",
+		annotations:     map[string]string{ResourceGCAnnotation: "false"},
+		deleterErrs:     []error{ErrResourcesStillExist},
+		wantStatus:      runtimehooksv1.ResponseStatusSuccess,
+		wantDeleterCall: false,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := &clusterv1beta2.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        clusterName,
+					Namespace:   clusterNamespace,
+					Annotations: tt.annotations,
+				},
+				Status: clusterv1beta2.ClusterStatus{
+					Conditions: []metav1.Condition{{
+						Type:   clusterv1beta2.ClusterControlPlaneInitializedCondition,
+						Status: metav1.ConditionTrue,
+					}},
+					Phase: string(clusterv1beta2.ClusterPhaseProvisioned),
+				},
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(cluster).
+				Build()
+
+			deleters := make([]Deleter, 0, len(tt.deleterErrs))
+			mocks := make([]*mockDeleter, 0, len(tt.deleterErrs))
+			for _, err := range tt.deleterErrs {
+				m := &mockDeleter{err: err}
+				deleters = append(deleters, m)
+				mocks = append(mocks, m)
+			}
+			handler := New(fakeClient, deleters...)
+			handler.clusterClientGetter = remotefake.NewClusterClient
+
+			req := &runtimehooksv1.BeforeClusterDeleteRequest{
+				Cluster: clusterv1beta1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      clusterName,
+						Namespace: clusterNamespace,
+					},
+				},
+			}
+			resp := &runtimehooksv1.BeforeClusterDeleteResponse{}
+
+			handler.BeforeClusterDelete(context.Background(), req, resp)
+
+			assert.Equal(t, tt.wantStatus, resp.Status)
+			if tt.wantRetryAfter {
+				assert.Greater(t, resp.RetryAfterSeconds, int32(0))
+			} else {
+				assert.Equal(t, int32(0), resp.RetryAfterSeconds)
+			}
+			for _, m := range mocks {
+				assert.Equal(t, tt.wantDeleterCall, m.called)
+			}
+		})
+	}
+}
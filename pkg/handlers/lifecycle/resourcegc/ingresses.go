@@ -0,0 +1,52 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	networkingv1 "k8s.io/api/networking/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Ingresses deletes the Ingresses that have a load balancer, so that the ingress controller, for
+// example, the AWS Load Balancer Controller, deletes the load balancer. It waits for the Ingresses to
+// be deleted, which ingress controllers delay, using finalizers, until the load balancer is deleted.
+type Ingresses struct{}
+
+var _ Deleter = Ingresses{}
+
+func (Ingresses) Name() string {
+	return "Ingresses"
+}
+
+func (Ingresses) Delete(ctx context.Context, c ctrlclient.Client, log logr.Logger) error {
+	log.Info("Listing Ingresses with a load balancer")
+	ingresses := &networkingv1.IngressList{}
+	if err := c.List(ctx, ingresses); err != nil {
+		return fmt.Errorf("error listing Ingresses: %w", err)
+	}
+
+	var d deletion
+	for idx := range ingresses.Items {
+		ingress := &ingresses.Items[idx]
+		if ingressNeedsDelete(ingress) {
+			d.deleteObject(ctx, c, log, "Ingress", ingress)
+		}
+	}
+
+	return d.err()
+}
+
+// ingressNeedsDelete returns true if the Ingress has been assigned a load balancer.
+func ingressNeedsDelete(ingress *networkingv1.Ingress) bool {
+	for _, lbIngress := range ingress.Status.LoadBalancer.Ingress {
+		if lbIngress.IP != "" || lbIngress.Hostname != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestIngressesDelete(t *testing.T) {
+	t.Parallel()
+
+	withLB := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "with-lb"},
+		Status: networkingv1.IngressStatus{
+			LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+				Ingress: []networkingv1.IngressLoadBalancerIngress{{Hostname: "alb-123.example.com"}},
+			},
+		},
+	}
+	withoutLB := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "without-lb"},
+	}
+	c := fake.NewClientBuilder().WithObjects(withLB, withoutLB).Build()
+
+	err := Ingresses{}.Delete(context.Background(), c, logr.Discard())
+	require.ErrorIs(t, err, ErrResourcesStillExist)
+	assert.ErrorContains(t, err, "Ingress ns-1/with-lb")
+
+	ingresses := &networkingv1.IngressList{}
+	require.NoError(t, c.List(context.Background(), ingresses))
+	require.Len(t, ingresses.Items, 1)
+	assert.Equal(t, "without-lb", ingresses.Items[0].Name)
+
+	// Once the Ingress is deleted, there is nothing to wait for.
+	require.NoError(t, Ingresses{}.Delete(context.Background(), c, logr.Discard()))
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PersistentVolumeClaims deletes the PersistentVolumeClaims bound to PersistentVolumes with the Delete
+// reclaim policy, so that the provisioner deletes the volumes. It deletes the Pods that use the claims,
+// because a claim in use is not deleted. It waits for the PersistentVolumes to be deleted.
+type PersistentVolumeClaims struct{}
+
+var _ Deleter = PersistentVolumeClaims{}
+
+func (PersistentVolumeClaims) Name() string {
+	return "PersistentVolumeClaims"
+}
+
+func (PersistentVolumeClaims) Delete(ctx context.Context, c ctrlclient.Client, log logr.Logger) error {
+	log.Info("Listing PersistentVolumes with reclaim policy Delete")
+	pvs := &corev1.PersistentVolumeList{}
+	if err := c.List(ctx, pvs); err != nil {
+		return fmt.Errorf("error listing PersistentVolumes: %w", err)
+	}
+
+	var (
+		d    deletion
+		pods *corev1.PodList
+	)
+	for idx := range pvs.Items {
+		pv := &pvs.Items[idx]
+		if !persistentVolumeNeedsDelete(pv) {
+			continue
+		}
+		// The provisioner deletes the PersistentVolume once the volume is deleted.
+		d.waitFor("PersistentVolume", pv)
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		err := c.Get(
+			ctx,
+			ctrlclient.ObjectKey{Namespace: pv.Spec.ClaimRef.Namespace, Name: pv.Spec.ClaimRef.Name},
+			pvc,
+		)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error getting PersistentVolumeClaim for PersistentVolume %s: %w", pv.Name, err)
+		}
+		// The claim was deleted, and a claim with the same name was created, that is not bound to the volume.
+		if pv.Spec.ClaimRef.UID != "" && pvc.UID != pv.Spec.ClaimRef.UID {
+			continue
+		}
+
+		if pods == nil {
+			pods = &corev1.PodList{}
+			if err := c.List(ctx, pods); err != nil {
+				return fmt.Errorf("error listing Pods: %w", err)
+			}
+		}
+		for podIdx := range pods.Items {
+			pod := &pods.Items[podIdx]
+			if podUsesClaim(pod, pvc) {
+				d.deleteObject(ctx, c, log, "Pod", pod)
+			}
+		}
+
+		d.deleteObject(ctx, c, log, "PersistentVolumeClaim", pvc)
+	}
+
+	return d.err()
+}
+
+// persistentVolumeNeedsDelete returns true if the PersistentVolume is bound to a claim, and its
+// volume is deleted when the claim is deleted.
+func persistentVolumeNeedsDelete(pv *corev1.PersistentVolume) bool {
+	return pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimDelete &&
+		pv.Spec.ClaimRef != nil
+}
+
+func podUsesClaim(pod *corev1.Pod, pvc *corev1.PersistentVolumeClaim) bool {
+	if pod.Namespace != pvc.Namespace {
+		return false
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvc.Name {
+			return true
+		}
+		// Generic ephemeral volumes use a claim named after the Pod and the volume.
+		if volume.Ephemeral != nil && pod.Name+"-"+volume.Name == pvc.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcegc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testPV(
+	name string,
+	policy corev1.PersistentVolumeReclaimPolicy,
+	claim *corev1.ObjectReference,
+) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: policy,
+			ClaimRef:                      claim,
+		},
+	}
+}
+
+func testPVC(namespace, name string, uid types.UID) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: uid},
+	}
+}
+
+func testPodWithClaims(namespace, name string, claimNames ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+	for _, claimName := range claimNames {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: claimName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+			},
+		})
+	}
+	return pod
+}
+
+func TestPersistentVolumeClaimsDelete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		objects           []ctrlclient.Object
+		expectedErr       error
+		expectedErrMsg    string
+		expectedRemaining []string
+	}{{
+		name:              "no persistent volumes",
+		expectedRemaining: []string{},
+	}, {
+		name: "retained volume is not deleted",
+		objects: []ctrlclient.Object{
+			testPV("pv-1", corev1.PersistentVolumeReclaimRetain, &corev1.ObjectReference{
+				Namespace: "ns-1", Name: "pvc-1", UID: "uid-1",
+			}),
+			testPVC("ns-1", "pvc-1", "uid-1"),
+			testPodWithClaims("ns-1", "pod-1", "pvc-1"),
+		},
+		expectedRemaining: []string{"Pod ns-1/pod-1", "PersistentVolumeClaim ns-1/pvc-1"},
+	}, {
+		name: "claims of deleted volumes and pods using them are deleted",
+		objects: []ctrlclient.Object{
+			testPV("pv-1", corev1.PersistentVolumeReclaimDelete, &corev1.ObjectReference{
+				Namespace: "ns-1", Name: "pvc-1", UID: "uid-1",
+			}),
+			testPV("pv-2", corev1.PersistentVolumeReclaimDelete, &corev1.ObjectReference{
+				Namespace: "ns-1", Name: "pvc-2", UID: "uid-2",
+			}),
+			testPVC("ns-1", "pvc-1", "uid-1"),
+			testPVC("ns-1", "pvc-2", "uid-2"),
+			testPodWithClaims("ns-1", "pod-1", "pvc-1", "pvc-2"),
+			testPodWithClaims("ns-2", "pod-2", "pvc-1"),
+		},
+		expectedErr: ErrResourcesStillExist,
+		expectedErrMsg: "waiting for kubernetes resources to be fully deleted: " +
+			"waiting for the following resources to be fully deleted: " +
+			"PersistentVolume pv-1, Pod ns-1/pod-1, PersistentVolumeClaim ns-1/pvc-1, " +
+			"PersistentVolume pv-2, PersistentVolumeClaim ns-1/pvc-2",
+		expectedRemaining: []string{"Pod ns-2/pod-2"},
+	}, {
+		name: "claim with the same name as the deleted claim is not deleted",
+		objects: []ctrlclient.Object{
+			testPV("pv-1", corev1.PersistentVolumeReclaimDelete, &corev1.ObjectReference{
+				Namespace: "ns-1", Name: "pvc-1", UID: "uid-1",
+			}),
+			testPVC("ns-1", "pvc-1", "uid-other"),
+		},
+		expectedErr:       ErrResourcesStillExist,
+		expectedRemaining: []string{"PersistentVolumeClaim ns-1/pvc-1"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := fake.NewClientBuilder().WithObjects(tt.objects...).Build()
+
+			err := PersistentVolumeClaims{}.Delete(context.Background(), c, logr.Discard())
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				if tt.expectedErrMsg != "" {
+					assert.EqualError(t, err, tt.expectedErrMsg)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+
+			remaining := []string{}
+			pods := &corev1.PodList{}
+			require.NoError(t, c.List(context.Background(), pods))
+			for i := range pods.Items {
+				remaining = append(remaining, "Pod "+objectName(&pods.Items[i]))
+			}
+			pvcs := &corev1.PersistentVolumeClaimList{}
+			require.NoError(t, c.List(context.Background(), pvcs))
+			for i := range pvcs.Items {
+				remaining = append(remaining, "PersistentVolumeClaim "+objectName(&pvcs.Items[i]))
+			}
+			assert.Equal(t, tt.expectedRemaining, remaining)
+		})
+	}
+}
+
+func TestPodUsesClaim(t *testing.T) {
+	t.Parallel()
+
+	pvc := testPVC("ns-1", "pod-1-data", "")
+	ephemeralPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-1"},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{
+				Name:         "data",
+				VolumeSource: corev1.VolumeSource{Ephemeral: &corev1.EphemeralVolumeSource{}},
+			}},
+		},
+	}
+
+	assert.True(t, podUsesClaim(ephemeralPod, pvc))
+	assert.True(t, podUsesClaim(testPodWithClaims("ns-1", "pod-2", "pod-1-data"), pvc))
+	assert.False(t, podUsesClaim(testPodWithClaims("ns-2", "pod-2", "pod-1-data"), pvc))
+	assert.False(t, podUsesClaim(testPodWithClaims("ns-1", "pod-2", "other"), pvc))
+}
@@ -10,16 +10,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/resourcegc"
 )
 
 const (
@@ -118,47 +117,6 @@ func servicesStillExistError(svcsStillExisting []ctrlclient.ObjectKey) error {
 	)
 }
 
-// isV1Beta2ConditionTrue checks if a v1beta2 condition is True on the cluster.
-// This is a local equivalent of conditions.IsTrue that works with v1beta2 Cluster types,
-// since the upstream util/conditions package now requires metav1.Condition getters.
-func isV1Beta2ConditionTrue(cluster *clusterv1.Cluster, condType string) bool {
-	for _, c := range cluster.GetConditions() {
-		if c.Type == condType && c.Status == metav1.ConditionTrue {
-			return true
-		}
-	}
-	return false
-}
-
 func shouldDeleteServicesWithLoadBalancer(cluster *clusterv1.Cluster) (bool, error) {
-	// Use the Cluster annotations to skip deleting
-	val, found := cluster.GetAnnotations()[LoadBalancerGCAnnotation]
-	if !found {
-		val = "true"
-	}
-	shouldDeleteBasedOnAnnotation, err := strconv.ParseBool(val)
-	if err != nil {
-		return false, fmt.Errorf(
-			"failed to convert value %s of annotation %s to bool: %w",
-			val,
-			LoadBalancerGCAnnotation,
-			err,
-		)
-	}
-
-	// Use the Cluster phase to determine if it's safe to skip deleting:
-	//
-	// - when ClusterPhasePending or ClusterPhaseProvisioning Kubernetes API has not been created
-	// and the user would not have been able to create any Kubernetes resources that would prevent cleanup
-	//
-	// - when ClusterPhaseDeleting it's too late to try to cleanup.
-	phase := cluster.Status.GetTypedPhase()
-	skipDeleteBasedOnPhase := phase == clusterv1.ClusterPhasePending ||
-		phase == clusterv1.ClusterPhaseProvisioning ||
-		phase == clusterv1.ClusterPhaseDeleting
-
-	// use the Cluster conditions to determine if the API server is even reachable
-	controlPlaneReachable := isV1Beta2ConditionTrue(cluster, clusterv1.ClusterControlPlaneInitializedCondition)
-
-	return shouldDeleteBasedOnAnnotation && controlPlaneReachable && !skipDeleteBasedOnPhase, nil
+	return resourcegc.ShouldGarbageCollect(cluster, LoadBalancerGCAnnotation)
 }
@@ -18,6 +18,11 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/handlers/lifecycle"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/clustertopology/variables"
 	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/addons"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/ccm"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/cni/calico"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/cni/cilium"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/cni/nutanixflow"
 )
 
 type ServiceLoadBalancerProvider interface {
@@ -39,6 +44,7 @@ type ServiceLoadBalancerHandler struct {
 var (
 	_ commonhandlers.Named                   = &ServiceLoadBalancerHandler{}
 	_ lifecycle.AfterControlPlaneInitialized = &ServiceLoadBalancerHandler{}
+	_ lifecycle.Dependent                    = &ServiceLoadBalancerHandler{}
 )
 
 func New(
@@ -57,6 +63,17 @@ func (s *ServiceLoadBalancerHandler) Name() string {
 	return "ServiceLoadBalancerHandler"
 }
 
+// Dependencies returns the CNI and CCM handlers, as service load balancer providers such as
+// MetalLB run webhooks on the workload cluster that require the CNI and CCM to be installed.
+func (s *ServiceLoadBalancerHandler) Dependencies() []string {
+	return []string{
+		calico.HandlerName,
+		cilium.HandlerName,
+		nutanixflow.HandlerName,
+		ccm.HandlerName,
+	}
+}
+
 func (s *ServiceLoadBalancerHandler) AfterControlPlaneInitialized(
 	ctx context.Context,
 	req *runtimehooksv1.AfterControlPlaneInitializedRequest,
@@ -70,6 +87,7 @@ func (s *ServiceLoadBalancerHandler) AfterControlPlaneInitialized(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	s.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, s.client, cluster, v1alpha1.AddonServiceLoadBalancerReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -87,6 +105,7 @@ func (s *ServiceLoadBalancerHandler) BeforeClusterUpgrade(
 	}
 	commonResponse := &runtimehooksv1.CommonResponse{}
 	s.apply(ctx, cluster, commonResponse)
+	addons.SetReadyCondition(ctx, s.client, cluster, v1alpha1.AddonServiceLoadBalancerReadyCondition, commonResponse)
 	resp.Status = commonResponse.GetStatus()
 	resp.Message = commonResponse.GetMessage()
 }
@@ -3,59 +3,229 @@
 package metallb
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	metallbv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/go.universe.tf/metallb/api/v1beta1"
+	metallbv1beta2 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/go.universe.tf/metallb/api/v1beta2"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers"
 )
 
+// ConfigurationLabelKey is the label of the configuration objects generated from the Cluster configuration. Labelled
+// objects that are no longer generated are deleted.
+const ConfigurationLabelKey = handlers.MetadataDomain + "/metallb-configuration"
+
 type ConfigurationInput struct {
-	Name          string
-	Namespace     string
+	Name      string
+	Namespace string
+	// AddressRanges are added to a pool named Name, and advertised using L2.
 	AddressRanges []v1alpha1.AddressRange
+	AddressPools  []v1alpha1.ServiceLoadBalancerAddressPool
+	BGPPeers      []v1alpha1.ServiceLoadBalancerBGPPeer
 }
 
+// ConfigurationObjects returns an IPAddressPool, and an L2Advertisement or a BGPAdvertisement, for each address pool,
+// and a BGPPeer, and optionally a BFDProfile, for each BGP peer.
 func ConfigurationObjects(input *ConfigurationInput) ([]client.Object, error) {
-	if len(input.AddressRanges) == 0 {
-		return nil, fmt.Errorf("must define one or more AddressRanges")
+	pools := input.AddressPools
+	if len(input.AddressRanges) > 0 {
+		pools = append([]v1alpha1.ServiceLoadBalancerAddressPool{{
+			Name:              input.Name,
+			AddressRanges:     input.AddressRanges,
+			AdvertisementMode: v1alpha1.ServiceLoadBalancerAdvertisementModeL2,
+		}}, pools...)
+	}
+	if len(pools) == 0 {
+		return nil, fmt.Errorf("must define one or more AddressRanges or AddressPools")
 	}
 
-	ipAddressPool := &metallbv1.IPAddressPool{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "IPAddressPool",
-			APIVersion: metallbv1.GroupVersion.String(),
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      input.Name,
-			Namespace: input.Namespace,
-		},
-		Spec: metallbv1.IPAddressPoolSpec{
-			Addresses: lo.Map(input.AddressRanges, func(ar v1alpha1.AddressRange, _ int) string {
-				return fmt.Sprintf("%s-%s", ar.Start, ar.End)
-			}),
-		},
+	objs := make([]client.Object, 0, 2*len(pools)+2*len(input.BGPPeers))
+	for _, pool := range pools {
+		ipAddressPool := &metallbv1.IPAddressPool{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "IPAddressPool",
+				APIVersion: metallbv1.GroupVersion.String(),
+			},
+			ObjectMeta: configurationObjectMeta(pool.Name, input.Namespace),
+			Spec: metallbv1.IPAddressPoolSpec{
+				Addresses: lo.Map(pool.AddressRanges, func(ar v1alpha1.AddressRange, _ int) string {
+					return ar.String()
+				}),
+				AutoAssign: pool.AutoAssign,
+			},
+		}
+		objs = append(objs, ipAddressPool)
+
+		switch pool.AdvertisementMode {
+		case v1alpha1.ServiceLoadBalancerAdvertisementModeBGP:
+			if len(input.BGPPeers) == 0 {
+				return nil, fmt.Errorf(
+					"must define one or more BGPPeers to advertise AddressPool %q using BGP",
+					pool.Name,
+				)
+			}
+			objs = append(objs, &metallbv1.BGPAdvertisement{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "BGPAdvertisement",
+					APIVersion: metallbv1.GroupVersion.String(),
+				},
+				ObjectMeta: configurationObjectMeta(pool.Name, input.Namespace),
+				Spec: metallbv1.BGPAdvertisementSpec{
+					IPAddressPools: []string{ipAddressPool.GetName()},
+				},
+			})
+		case v1alpha1.ServiceLoadBalancerAdvertisementModeL2, "":
+			objs = append(objs, &metallbv1.L2Advertisement{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "L2Advertisement",
+					APIVersion: metallbv1.GroupVersion.String(),
+				},
+				ObjectMeta: configurationObjectMeta(pool.Name, input.Namespace),
+				Spec: metallbv1.L2AdvertisementSpec{
+					IPAddressPools: []string{ipAddressPool.GetName()},
+				},
+			})
+		default:
+			return nil, fmt.Errorf(
+				"unknown advertisement mode %q for AddressPool %q",
+				pool.AdvertisementMode,
+				pool.Name,
+			)
+		}
 	}
 
-	l2Advertisement := &metallbv1.L2Advertisement{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "L2Advertisement",
-			APIVersion: metallbv1.GroupVersion.String(),
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      input.Name,
-			Namespace: input.Namespace,
-		},
-		Spec: metallbv1.L2AdvertisementSpec{
-			IPAddressPools: []string{ipAddressPool.GetName()},
+	for _, peer := range input.BGPPeers {
+		bgpPeer := &metallbv1beta2.BGPPeer{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "BGPPeer",
+				APIVersion: metallbv1beta2.GroupVersion.String(),
+			},
+			ObjectMeta: configurationObjectMeta(peer.Name, input.Namespace),
+			Spec: metallbv1beta2.BGPPeerSpec{
+				MyASN:        uint32(peer.MyASN),   //nolint:gosec // The API limits the ASN to the uint32 range.
+				ASN:          uint32(peer.PeerASN), //nolint:gosec // The API limits the ASN to the uint32 range.
+				Address:      peer.PeerAddress,
+				Port:         uint16(peer.PeerPort), //nolint:gosec // The API limits the port to the uint16 range.
+				EBGPMultiHop: peer.EBGPMultiHop,
+			},
+		}
+		if peer.PasswordSecretRef != nil {
+			bgpPeer.Spec.PasswordSecret = corev1.SecretReference{
+				Name:      peer.PasswordSecretRef.Name,
+				Namespace: input.Namespace,
+			}
+		}
+
+		// The BFDProfile must exist before the BGPPeer that references it.
+		if peer.BFDProfile != nil {
+			bfdProfile := &metallbv1.BFDProfile{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "BFDProfile",
+					APIVersion: metallbv1.GroupVersion.String(),
+				},
+				ObjectMeta: configurationObjectMeta(peer.Name, input.Namespace),
+				Spec: metallbv1.BFDProfileSpec{
+					ReceiveInterval:  int32ToUint32(peer.BFDProfile.ReceiveInterval),
+					TransmitInterval: int32ToUint32(peer.BFDProfile.TransmitInterval),
+					DetectMultiplier: int32ToUint32(peer.BFDProfile.DetectMultiplier),
+					PassiveMode:      peer.BFDProfile.PassiveMode,
+				},
+			}
+			objs = append(objs, bfdProfile)
+			bgpPeer.Spec.BFDProfile = bfdProfile.GetName()
+		}
+
+		objs = append(objs, bgpPeer)
+	}
+
+	return objs, nil
+}
+
+func configurationObjectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels: map[string]string{
+			ConfigurationLabelKey: "true",
 		},
 	}
+}
+
+// deleteStaleConfigurationObjects deletes the labelled configuration objects in the namespace that are not desired.
+// The objects are deleted before the objects they reference.
+func deleteStaleConfigurationObjects(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	desired []client.Object,
+) error {
+	desiredKeys := sets.New[string]()
+	for _, o := range desired {
+		desiredKeys.Insert(configurationObjectKey(o))
+	}
+
+	for _, list := range []client.ObjectList{
+		&metallbv1.L2AdvertisementList{},
+		&metallbv1.BGPAdvertisementList{},
+		&metallbv1beta2.BGPPeerList{},
+		&metallbv1.BFDProfileList{},
+		&metallbv1.IPAddressPoolList{},
+	} {
+		if err := c.List(
+			ctx,
+			list,
+			client.InNamespace(namespace),
+			client.MatchingLabels{ConfigurationLabelKey: "true"},
+		); err != nil {
+			if apimeta.IsNoMatchError(err) {
+				// The CRD is not installed, so there are no objects to delete.
+				continue
+			}
+			return fmt.Errorf("failed to list MetalLB configuration objects: %w", err)
+		}
+
+		err := apimeta.EachListItem(list, func(item runtime.Object) error {
+			o, ok := item.(client.Object)
+			if !ok || desiredKeys.Has(configurationObjectKey(o)) {
+				return nil
+			}
+			if err := client.IgnoreNotFound(c.Delete(ctx, o)); err != nil {
+				return fmt.Errorf(
+					"failed to delete MetalLB configuration %T %s: %w",
+					o,
+					client.ObjectKeyFromObject(o),
+					err,
+				)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-	return []client.Object{
-		ipAddressPool,
-		l2Advertisement,
-	}, nil
+// configurationObjectKey identifies a configuration object by its type and name. The type is used instead of the kind,
+// because listed objects may not have their kind set.
+func configurationObjectKey(o client.Object) string {
+	return fmt.Sprintf("%T/%s", o, o.GetName())
+}
+
+func int32ToUint32(v *int32) *uint32 {
+	if v == nil {
+		return nil
+	}
+	return ptr.To(uint32(*v)) //nolint:gosec // The API only allows positive values.
 }
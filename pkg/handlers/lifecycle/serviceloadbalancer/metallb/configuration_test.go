@@ -0,0 +1,274 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+package metallb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	metallbv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/go.universe.tf/metallb/api/v1beta1"
+	metallbv1beta2 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/go.universe.tf/metallb/api/v1beta2"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func Test_ConfigurationObjects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        *ConfigurationInput
+		expectedObjs []client.Object
+		expectedErr  string
+	}{
+		{
+			name: "address ranges are advertised using L2",
+			input: &ConfigurationInput{
+				Name:      "metallb",
+				Namespace: "metallb-system",
+				AddressRanges: []v1alpha1.AddressRange{
+					{Start: "10.100.1.1", End: "10.100.1.20"},
+					{Start: "10.100.1.51", End: "10.100.1.70"},
+					{CIDR: "10.100.2.0/24"},
+					{Start: "fd00:100::1", End: "fd00:100::20"},
+					{CIDR: "fd00:200::/120"},
+				},
+			},
+			expectedObjs: []client.Object{
+				ipAddressPool(
+					"metallb",
+					nil,
+					"10.100.1.1-10.100.1.20",
+					"10.100.1.51-10.100.1.70",
+					"10.100.2.0/24",
+					"fd00:100::1-fd00:100::20",
+					"fd00:200::/120",
+				),
+				l2Advertisement("metallb"),
+			},
+		},
+		{
+			name: "address pools are advertised using their advertisement mode to the BGP peers",
+			input: &ConfigurationInput{
+				Name:      "metallb",
+				Namespace: "metallb-system",
+				AddressPools: []v1alpha1.ServiceLoadBalancerAddressPool{
+					{
+						Name: "l2",
+						AddressRanges: []v1alpha1.AddressRange{
+							{Start: "10.100.1.1", End: "10.100.1.20"},
+						},
+					},
+					{
+						Name: "bgp",
+						AddressRanges: []v1alpha1.AddressRange{
+							{Start: "10.200.1.1", End: "10.200.1.20"},
+						},
+						AdvertisementMode: v1alpha1.ServiceLoadBalancerAdvertisementModeBGP,
+						AutoAssign:        ptr.To(false),
+					},
+				},
+				BGPPeers: []v1alpha1.ServiceLoadBalancerBGPPeer{
+					{
+						Name:        "router-a",
+						MyASN:       64500,
+						PeerASN:     64501,
+						PeerAddress: "10.0.0.1",
+					},
+					{
+						Name:              "router-b",
+						MyASN:             64500,
+						PeerASN:           4200000000,
+						PeerAddress:       "10.0.0.2",
+						PeerPort:          1179,
+						PasswordSecretRef: &v1alpha1.LocalObjectReference{Name: "router-b-password"},
+						BFDProfile: &v1alpha1.ServiceLoadBalancerBFDProfile{
+							ReceiveInterval:  ptr.To[int32](100),
+							TransmitInterval: ptr.To[int32](200),
+							DetectMultiplier: ptr.To[int32](5),
+						},
+						EBGPMultiHop: true,
+					},
+				},
+			},
+			expectedObjs: []client.Object{
+				ipAddressPool("l2", nil, "10.100.1.1-10.100.1.20"),
+				l2Advertisement("l2"),
+				ipAddressPool("bgp", ptr.To(false), "10.200.1.1-10.200.1.20"),
+				&metallbv1.BGPAdvertisement{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "BGPAdvertisement",
+						APIVersion: metallbv1.GroupVersion.String(),
+					},
+					ObjectMeta: configurationObjectMeta("bgp", "metallb-system"),
+					Spec: metallbv1.BGPAdvertisementSpec{
+						IPAddressPools: []string{"bgp"},
+					},
+				},
+				&metallbv1beta2.BGPPeer{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "BGPPeer",
+						APIVersion: metallbv1beta2.GroupVersion.String(),
+					},
+					ObjectMeta: configurationObjectMeta("router-a", "metallb-system"),
+					Spec: metallbv1beta2.BGPPeerSpec{
+						MyASN:   64500,
+						ASN:     64501,
+						Address: "10.0.0.1",
+					},
+				},
+				&metallbv1.BFDProfile{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "BFDProfile",
+						APIVersion: metallbv1.GroupVersion.String(),
+					},
+					ObjectMeta: configurationObjectMeta("router-b", "metallb-system"),
+					Spec: metallbv1.BFDProfileSpec{
+						ReceiveInterval:  ptr.To[uint32](100),
+						TransmitInterval: ptr.To[uint32](200),
+						DetectMultiplier: ptr.To[uint32](5),
+					},
+				},
+				&metallbv1beta2.BGPPeer{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "BGPPeer",
+						APIVersion: metallbv1beta2.GroupVersion.String(),
+					},
+					ObjectMeta: configurationObjectMeta("router-b", "metallb-system"),
+					Spec: metallbv1beta2.BGPPeerSpec{
+						MyASN:   64500,
+						ASN:     4200000000,
+						Address: "10.0.0.2",
+						Port:    1179,
+						PasswordSecret: corev1.SecretReference{
+							Name:      "router-b-password",
+							Namespace: "metallb-system",
+						},
+						BFDProfile:   "router-b",
+						EBGPMultiHop: true,
+					},
+				},
+			},
+		},
+		{
+			name: "fails without addresses",
+			input: &ConfigurationInput{
+				Name:      "metallb",
+				Namespace: "metallb-system",
+			},
+			expectedErr: "must define one or more AddressRanges or AddressPools",
+		},
+		{
+			name: "fails to advertise using BGP without BGP peers",
+			input: &ConfigurationInput{
+				Name:      "metallb",
+				Namespace: "metallb-system",
+				AddressPools: []v1alpha1.ServiceLoadBalancerAddressPool{
+					{
+						Name: "bgp",
+						AddressRanges: []v1alpha1.AddressRange{
+							{Start: "10.200.1.1", End: "10.200.1.20"},
+						},
+						AdvertisementMode: v1alpha1.ServiceLoadBalancerAdvertisementModeBGP,
+					},
+				},
+			},
+			expectedErr: `must define one or more BGPPeers to advertise AddressPool "bgp" using BGP`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			objs, err := ConfigurationObjects(tt.input)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedObjs, objs)
+		})
+	}
+}
+
+func Test_deleteStaleConfigurationObjects(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, metallbv1.AddToScheme(scheme))
+	require.NoError(t, metallbv1beta2.AddToScheme(scheme))
+
+	pool := func(name string) v1alpha1.ServiceLoadBalancerAddressPool {
+		return v1alpha1.ServiceLoadBalancerAddressPool{
+			Name:          name,
+			AddressRanges: []v1alpha1.AddressRange{{CIDR: "10.100.1.0/24"}},
+		}
+	}
+	previous, err := ConfigurationObjects(&ConfigurationInput{
+		Namespace:    "metallb-system",
+		AddressPools: []v1alpha1.ServiceLoadBalancerAddressPool{pool("kept"), pool("removed")},
+	})
+	require.NoError(t, err)
+	desired, err := ConfigurationObjects(&ConfigurationInput{
+		Namespace:    "metallb-system",
+		AddressPools: []v1alpha1.ServiceLoadBalancerAddressPool{pool("kept")},
+	})
+	require.NoError(t, err)
+
+	// Objects without the label are not generated from the Cluster configuration, and are kept.
+	unlabelled := &metallbv1.IPAddressPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "unlabelled", Namespace: "metallb-system"},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(previous, unlabelled)...).Build()
+	require.NoError(t, deleteStaleConfigurationObjects(context.Background(), c, "metallb-system", desired))
+
+	pools := &metallbv1.IPAddressPoolList{}
+	require.NoError(t, c.List(context.Background(), pools))
+	poolNames := []string{}
+	for i := range pools.Items {
+		poolNames = append(poolNames, pools.Items[i].Name)
+	}
+	assert.ElementsMatch(t, []string{"kept", "unlabelled"}, poolNames)
+
+	advertisements := &metallbv1.L2AdvertisementList{}
+	require.NoError(t, c.List(context.Background(), advertisements))
+	require.Len(t, advertisements.Items, 1)
+	assert.Equal(t, "kept", advertisements.Items[0].Name)
+}
+
+func ipAddressPool(name string, autoAssign *bool, addresses ...string) *metallbv1.IPAddressPool {
+	return &metallbv1.IPAddressPool{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "IPAddressPool",
+			APIVersion: metallbv1.GroupVersion.String(),
+		},
+		ObjectMeta: configurationObjectMeta(name, "metallb-system"),
+		Spec: metallbv1.IPAddressPoolSpec{
+			Addresses:  addresses,
+			AutoAssign: autoAssign,
+		},
+	}
+}
+
+func l2Advertisement(name string) *metallbv1.L2Advertisement {
+	return &metallbv1.L2Advertisement{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "L2Advertisement",
+			APIVersion: metallbv1.GroupVersion.String(),
+		},
+		ObjectMeta: configurationObjectMeta(name, "metallb-system"),
+		Spec: metallbv1.L2AdvertisementSpec{
+			IPAddressPools: []string{name},
+		},
+	}
+}
@@ -18,6 +18,7 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	metallbv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/go.universe.tf/metallb/api/v1beta1"
+	metallbv1beta2 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/go.universe.tf/metallb/api/v1beta2"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/k8s/client"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/addons"
@@ -104,7 +105,7 @@ func (n *MetalLB) Apply(
 		)
 	}
 
-	helmChartInfo, err := n.helmChartInfoGetter.For(ctx, log, config.MetalLB)
+	helmChartInfo, err := n.helmChartInfoGetter.For(ctx, log, cluster, config.MetalLB)
 	if err != nil {
 		return fmt.Errorf("failed to get MetalLB helm chart: %w", err)
 	}
@@ -117,14 +118,19 @@ func (n *MetalLB) Apply(
 		),
 		n.client,
 		helmChartInfo,
-	).WithDefaultWaiter()
+	).
+		WithValuesSource(slb.Values).
+		WithDefaultWaiter()
 
 	if err := addonApplier.Apply(ctx, cluster, n.config.DefaultsNamespace(), log); err != nil {
 		return fmt.Errorf("failed to apply MetalLB addon: %w", err)
 	}
 
 	if slb.Configuration == nil {
-		// Nothing more to do.
+		// Delete the configuration objects generated from a previous configuration.
+		if err := deleteStaleConfigurationObjects(ctx, remoteClient, DefaultHelmReleaseNamespace, nil); err != nil {
+			return fmt.Errorf("failed to delete MetalLB configuration: %w", err)
+		}
 		return nil
 	}
 
@@ -138,12 +144,39 @@ func (n *MetalLB) Apply(
 		Name:          DefaultHelmReleaseName,
 		Namespace:     DefaultHelmReleaseNamespace,
 		AddressRanges: slb.Configuration.AddressRanges,
+		AddressPools:  slb.Configuration.AddressPools,
+		BGPPeers:      slb.Configuration.BGPPeers,
 	}
 	cos, err := ConfigurationObjects(configInput)
 	if err != nil {
 		return fmt.Errorf("failed to generate MetalLB configuration: %w", err)
 	}
 
+	// MetalLB rejects BGPPeers that reference a password Secret that does not exist,
+	// so copy the Secrets to the workload cluster first.
+	for _, peer := range slb.Configuration.BGPPeers {
+		if peer.PasswordSecretRef == nil {
+			continue
+		}
+		err = handlersutils.CopySecretToRemoteCluster(
+			ctx,
+			n.client,
+			peer.PasswordSecretRef.Name,
+			ctrlclient.ObjectKey{
+				Name:      peer.PasswordSecretRef.Name,
+				Namespace: DefaultHelmReleaseNamespace,
+			},
+			cluster,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to copy password Secret of MetalLB BGP peer %q to the workload cluster: %w",
+				peer.Name,
+				err,
+			)
+		}
+	}
+
 	var applyErr error
 	if waitErr := kwait.PollUntilContextTimeout(
 		ctx,
@@ -178,11 +211,17 @@ func (n *MetalLB) Apply(
 							"%w. This resource has been modified in the workload cluster: it must contain exactly the addresses listed in the Cluster configuration", //nolint:lll // Long error message,
 							err,
 						)
-					case *metallbv1.L2Advertisement:
+					case *metallbv1.L2Advertisement, *metallbv1.BGPAdvertisement:
 						err = fmt.Errorf(
 							"%w. This resource has been modified in the workload cluster, it must only contain the %q IP Address Pool", //nolint:lll // Long error message,
 							err,
-							configInput.Name,
+							o.GetName(),
+						)
+					case *metallbv1beta2.BGPPeer, *metallbv1.BFDProfile:
+						err = fmt.Errorf(
+							"%w. This resource has been modified in the workload cluster: it must match the %q BGP peer in the Cluster configuration", //nolint:lll // Long error message,
+							err,
+							o.GetName(),
 						)
 					}
 
@@ -211,5 +250,10 @@ func (n *MetalLB) Apply(
 		return fmt.Errorf("failed to apply MetalLB configuration: %w", waitErr)
 	}
 
+	// Delete the configuration objects that were removed from the Cluster configuration.
+	if err := deleteStaleConfigurationObjects(ctx, remoteClient, DefaultHelmReleaseNamespace, cos); err != nil {
+		return fmt.Errorf("failed to delete stale MetalLB configuration: %w", err)
+	}
+
 	return nil
 }
@@ -35,7 +35,7 @@ import (
 // MetaMutators returns all generic patch handlers.
 func MetaMutators(mgr manager.Manager) []mutation.MetaMutator {
 	return []mutation.MetaMutator{
-		auditpolicy.NewPatch(),
+		auditpolicy.NewPatch(mgr.GetClient()),
 		etcd.NewPatch(),
 		coredns.NewPatch(),
 		extraapiservercertsans.NewPatch(),
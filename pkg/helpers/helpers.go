@@ -5,6 +5,8 @@ package helpers
 import (
 	"fmt"
 	"net/netip"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 )
 
 // IsIPInRange checks if the target IP falls within the start and end IP range (inclusive).
@@ -24,3 +26,68 @@ func IsIPInRange(startIP, endIP, targetIP string) (bool, error) {
 
 	return start.Compare(target) <= 0 && end.Compare(target) >= 0, nil
 }
+
+// IPRange is an inclusive range of IP addresses of the same family.
+type IPRange struct {
+	Start netip.Addr
+	End   netip.Addr
+}
+
+// ParseIPRange parses the inclusive range between the start and end IPs.
+// It returns an error if the IPs are of different families, or if the start IP is after the end IP.
+func ParseIPRange(startIP, endIP string) (IPRange, error) {
+	start, err := netip.ParseAddr(startIP)
+	if err != nil {
+		return IPRange{}, fmt.Errorf("invalid start IP: %w", err)
+	}
+	end, err := netip.ParseAddr(endIP)
+	if err != nil {
+		return IPRange{}, fmt.Errorf("invalid end IP: %w", err)
+	}
+	start, end = start.Unmap(), end.Unmap()
+	if start.Is4() != end.Is4() {
+		return IPRange{}, fmt.Errorf("start IP %q and end IP %q must be of the same family", start, end)
+	}
+	if start.Compare(end) > 0 {
+		return IPRange{}, fmt.Errorf("start IP %q must not be after end IP %q", start, end)
+	}
+
+	return IPRange{Start: start, End: end}, nil
+}
+
+// ParseCIDRRange parses the range of all the IPs in the CIDR.
+func ParseCIDRRange(cidr string) (IPRange, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return IPRange{}, fmt.Errorf("invalid CIDR: %w", err)
+	}
+	prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()).Masked()
+
+	// Set all the host bits of the first IP to get the last IP.
+	end := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(end)*8; bit++ {
+		end[bit/8] |= 1 << (7 - bit%8)
+	}
+	endAddr, _ := netip.AddrFromSlice(end)
+
+	return IPRange{Start: prefix.Addr(), End: endAddr}, nil
+}
+
+// ParseAddressRange parses the range of the ServiceLoadBalancer address range, from its CIDR, or its start and end IPs.
+func ParseAddressRange(addressRange v1alpha1.AddressRange) (IPRange, error) {
+	if addressRange.CIDR != "" {
+		return ParseCIDRRange(addressRange.CIDR)
+	}
+	return ParseIPRange(addressRange.Start, addressRange.End)
+}
+
+// Contains checks if the IP falls within the range.
+func (r IPRange) Contains(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return r.Start.Compare(ip) <= 0 && r.End.Compare(ip) >= 0
+}
+
+// Overlaps checks if the ranges have at least one IP in common.
+func (r IPRange) Overlaps(other IPRange) bool {
+	return r.Start.Compare(other.End) <= 0 && other.Start.Compare(r.End) <= 0
+}
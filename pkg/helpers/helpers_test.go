@@ -5,9 +5,12 @@ package helpers
 
 import (
 	"fmt"
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 )
 
 func TestIsIPInRange(t *testing.T) {
@@ -114,3 +117,138 @@ func TestIsIPInRange(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAddressRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		addressRange  v1alpha1.AddressRange
+		expectedRange IPRange
+		expectedErr   string
+	}{
+		{
+			name:         "IPv4 start and end",
+			addressRange: v1alpha1.AddressRange{Start: "192.168.1.1", End: "192.168.1.10"},
+			expectedRange: IPRange{
+				Start: netip.MustParseAddr("192.168.1.1"),
+				End:   netip.MustParseAddr("192.168.1.10"),
+			},
+		},
+		{
+			name:         "IPv6 start and end",
+			addressRange: v1alpha1.AddressRange{Start: "2001:db8::1", End: "2001:db8::10"},
+			expectedRange: IPRange{
+				Start: netip.MustParseAddr("2001:db8::1"),
+				End:   netip.MustParseAddr("2001:db8::10"),
+			},
+		},
+		{
+			name:         "single IP",
+			addressRange: v1alpha1.AddressRange{Start: "192.168.1.1", End: "192.168.1.1"},
+			expectedRange: IPRange{
+				Start: netip.MustParseAddr("192.168.1.1"),
+				End:   netip.MustParseAddr("192.168.1.1"),
+			},
+		},
+		{
+			name:         "IPv4 CIDR",
+			addressRange: v1alpha1.AddressRange{CIDR: "192.168.1.0/28"},
+			expectedRange: IPRange{
+				Start: netip.MustParseAddr("192.168.1.0"),
+				End:   netip.MustParseAddr("192.168.1.15"),
+			},
+		},
+		{
+			name:         "IPv4 CIDR with host bits",
+			addressRange: v1alpha1.AddressRange{CIDR: "192.168.1.21/30"},
+			expectedRange: IPRange{
+				Start: netip.MustParseAddr("192.168.1.20"),
+				End:   netip.MustParseAddr("192.168.1.23"),
+			},
+		},
+		{
+			name:         "IPv6 CIDR",
+			addressRange: v1alpha1.AddressRange{CIDR: "2001:db8::/120"},
+			expectedRange: IPRange{
+				Start: netip.MustParseAddr("2001:db8::"),
+				End:   netip.MustParseAddr("2001:db8::ff"),
+			},
+		},
+		{
+			name:         "start after end",
+			addressRange: v1alpha1.AddressRange{Start: "192.168.1.10", End: "192.168.1.1"},
+			expectedErr:  `start IP "192.168.1.10" must not be after end IP "192.168.1.1"`,
+		},
+		{
+			name:         "start and end of different families",
+			addressRange: v1alpha1.AddressRange{Start: "192.168.1.1", End: "2001:db8::10"},
+			expectedErr:  `start IP "192.168.1.1" and end IP "2001:db8::10" must be of the same family`,
+		},
+		{
+			name:         "invalid start IP",
+			addressRange: v1alpha1.AddressRange{Start: "invalid-ip", End: "192.168.1.10"},
+			expectedErr:  `invalid start IP: ParseAddr("invalid-ip"): unable to parse IP`,
+		},
+		{
+			name:         "invalid CIDR",
+			addressRange: v1alpha1.AddressRange{CIDR: "192.168.1.0"},
+			expectedErr:  `invalid CIDR: netip.ParsePrefix("192.168.1.0"): no '/'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAddressRange(tt.addressRange)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRange, got)
+		})
+	}
+}
+
+func TestIPRangeOverlaps(t *testing.T) {
+	tests := []struct {
+		name             string
+		a                string
+		b                string
+		expectedOverlaps bool
+	}{
+		{
+			name:             "disjoint ranges",
+			a:                "192.168.1.0/28",
+			b:                "192.168.1.16/28",
+			expectedOverlaps: false,
+		},
+		{
+			name:             "nested ranges",
+			a:                "192.168.1.0/24",
+			b:                "192.168.1.16/28",
+			expectedOverlaps: true,
+		},
+		{
+			name:             "same range",
+			a:                "192.168.1.0/28",
+			b:                "192.168.1.0/28",
+			expectedOverlaps: true,
+		},
+		{
+			name:             "ranges of different families",
+			a:                "0.0.0.0/0",
+			b:                "::/0",
+			expectedOverlaps: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := ParseCIDRRange(tt.a)
+			assert.NoError(t, err)
+			b, err := ParseCIDRRange(tt.b)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOverlaps, a.Overlaps(b))
+			assert.Equal(t, tt.expectedOverlaps, b.Overlaps(a))
+		})
+	}
+}
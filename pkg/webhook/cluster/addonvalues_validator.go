@@ -0,0 +1,125 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	v1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+type addonValuesValidator struct {
+	client  ctrlclient.Client
+	decoder admission.Decoder
+}
+
+func NewAddonValuesValidator(
+	client ctrlclient.Client, decoder admission.Decoder,
+) *addonValuesValidator {
+	return &addonValuesValidator{
+		client:  client,
+		decoder: decoder,
+	}
+}
+
+func (a *addonValuesValidator) Validator() admission.HandlerFunc {
+	return a.validate
+}
+
+func (a *addonValuesValidator) validate(
+	ctx context.Context,
+	req admission.Request,
+) admission.Response {
+	if req.Operation == v1.Delete {
+		return admission.Allowed("")
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := a.decoder.Decode(req, cluster); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !cluster.Spec.Topology.IsDefined() {
+		return admission.Allowed("")
+	}
+
+	clusterConfig, err := variables.UnmarshalClusterConfigVariable(cluster.Spec.Topology.Variables)
+	if err != nil {
+		return admission.Denied(
+			fmt.Errorf("failed to unmarshal cluster topology variable %q: %w",
+				v1alpha1.ClusterConfigVariableName,
+				err).Error(),
+		)
+	}
+
+	if clusterConfig == nil || clusterConfig.Addons == nil {
+		return admission.Allowed("")
+	}
+
+	if errs := validateAddonValuesMode(
+		clusterConfig.Addons,
+		field.NewPath(v1alpha1.ClusterConfigVariableName, "addons"),
+	); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// validateAddonValuesMode checks that the values of the addons are only merged over the default values with the
+// HelmAddon strategy. The ClusterResourceSet strategy does not use the values.
+func validateAddonValuesMode(addons *variables.Addons, fldPath *field.Path) field.ErrorList {
+	fldErrs := field.ErrorList{}
+	validate := func(strategy v1alpha1.AddonStrategy, addonConfig *v1alpha1.AddonConfig, addonPath *field.Path) {
+		if strategy != v1alpha1.AddonStrategyClusterResourceSet ||
+			addonConfig.Values == nil ||
+			addonConfig.Values.Mode != v1alpha1.AddonValuesModeMerge {
+			return
+		}
+		fldErrs = append(fldErrs, field.Invalid(
+			addonPath.Child("values", "mode"),
+			addonConfig.Values.Mode,
+			fmt.Sprintf(
+				"values can only be merged with the %q strategy, not with the %q strategy",
+				v1alpha1.AddonStrategyHelmAddon,
+				strategy,
+			),
+		))
+	}
+
+	if addons.CNI != nil {
+		validate(addons.CNI.Strategy, &addons.CNI.AddonConfig, fldPath.Child("cni"))
+	}
+	if addons.NFD != nil {
+		validate(addons.NFD.Strategy, &addons.NFD.AddonConfig, fldPath.Child("nfd"))
+	}
+	if addons.ClusterAutoscaler != nil {
+		validate(
+			addons.ClusterAutoscaler.Strategy,
+			&addons.ClusterAutoscaler.AddonConfig,
+			fldPath.Child("clusterAutoscaler"),
+		)
+	}
+	if addons.CCM != nil {
+		validate(addons.CCM.Strategy, &addons.CCM.AddonConfig, fldPath.Child("ccm"))
+	}
+	if addons.CSI != nil {
+		for _, name := range slices.Sorted(maps.Keys(addons.CSI.Providers)) {
+			provider := addons.CSI.Providers[name]
+			validate(provider.Strategy, &provider.AddonConfig, fldPath.Child("csi", "providers").Key(name))
+		}
+	}
+	return fldErrs
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+func TestAddonValuesValidator(t *testing.T) {
+	mergedValues := v1alpha1.AddonConfig{
+		Values: &v1alpha1.AddonValues{
+			SourceRef: &v1alpha1.ValuesReference{Kind: "ConfigMap", Name: "values"},
+			Mode:      v1alpha1.AddonValuesModeMerge,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		addons        *variables.Addons
+		expectAllowed bool
+		expectMessage string
+	}{
+		{
+			name:          "allows clusters without addons",
+			expectAllowed: true,
+		},
+		{
+			name: "allows merging values with the HelmAddon strategy",
+			addons: &variables.Addons{
+				CNI: &v1alpha1.CNI{
+					Provider:    v1alpha1.CNIProviderCilium,
+					Strategy:    v1alpha1.AddonStrategyHelmAddon,
+					AddonConfig: mergedValues,
+				},
+			},
+			expectAllowed: true,
+		},
+		{
+			name: "rejects merging values with the ClusterResourceSet strategy",
+			addons: &variables.Addons{
+				GenericAddons: v1alpha1.GenericAddons{
+					CCM: &v1alpha1.CCM{
+						Strategy:    v1alpha1.AddonStrategyClusterResourceSet,
+						AddonConfig: mergedValues,
+					},
+				},
+				CSI: &variables.CSI{
+					Providers: map[string]v1alpha1.CSIProvider{
+						v1alpha1.CSIProviderNutanix: {
+							Strategy:    v1alpha1.AddonStrategyClusterResourceSet,
+							AddonConfig: mergedValues,
+						},
+					},
+				},
+			},
+			expectAllowed: false,
+			expectMessage: `[clusterConfig.addons.ccm.values.mode: Invalid value: "Merge": values can only be merged with the "HelmAddon" strategy, not with the "ClusterResourceSet" strategy, clusterConfig.addons.csi.providers[nutanix].values.mode: Invalid value: "Merge"`, //nolint:lll // Long error message.
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1beta2.AddToScheme(scheme)).To(Succeed())
+			validator := NewAddonValuesValidator(
+				fake.NewClientBuilder().WithScheme(scheme).Build(),
+				admission.NewDecoder(scheme),
+			)
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: createAddonValuesClusterRaw(g, tc.addons),
+					},
+				},
+			}
+
+			resp := validator.validate(context.Background(), req)
+			g.Expect(resp.Allowed).To(Equal(tc.expectAllowed), resp.Result.Message)
+			if tc.expectMessage != "" {
+				g.Expect(resp.Result.Message).To(ContainSubstring(tc.expectMessage))
+			}
+		})
+	}
+}
+
+func createAddonValuesClusterRaw(g Gomega, addons *variables.Addons) []byte {
+	clusterConfigRaw, err := json.Marshal(&variables.ClusterConfigSpec{Addons: addons})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cluster := &clusterv1beta2.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1beta2.GroupVersion.String(),
+			Kind:       "Cluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "test-namespace",
+		},
+		Spec: clusterv1beta2.ClusterSpec{
+			Topology: clusterv1beta2.Topology{
+				ClassRef: clusterv1beta2.ClusterClassRef{
+					Name: "test-class",
+				},
+				Version: "v1.30.0",
+				Variables: []clusterv1beta2.ClusterVariable{{
+					Name:  v1alpha1.ClusterConfigVariableName,
+					Value: apiextensionsv1.JSON{Raw: clusterConfigRaw},
+				}},
+			},
+		},
+	}
+	clusterRaw, err := json.Marshal(cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	return clusterRaw
+}
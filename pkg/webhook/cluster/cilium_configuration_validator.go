@@ -14,13 +14,16 @@ import (
 	v1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
 )
 
 type advancedCiliumConfigurationValidator struct {
@@ -59,11 +62,6 @@ func (a *advancedCiliumConfigurationValidator) validate(
 		return admission.Allowed("")
 	}
 
-	// Skip validation if the skip annotation is present
-	if hasSkipAnnotation(cluster) {
-		return admission.Allowed("")
-	}
-
 	clusterConfig, err := variables.UnmarshalClusterConfigVariable(cluster.Spec.Topology.Variables)
 	if err != nil {
 		return admission.Denied(
@@ -76,13 +74,33 @@ func (a *advancedCiliumConfigurationValidator) validate(
 	if clusterConfig == nil {
 		return admission.Allowed("")
 	}
+	// Skip validation if no CNI is specified.
+	if clusterConfig.Addons == nil || clusterConfig.Addons.CNI == nil {
+		return admission.Allowed("")
+	}
+
+	kubeProxyIsDisabled := clusterConfig.KubeProxy != nil &&
+		clusterConfig.KubeProxy.Mode == v1alpha1.KubeProxyModeDisabled
+
+	if errs := validateCiliumConfiguration(
+		clusterConfig.Addons.CNI,
+		utils.GetProvider(cluster),
+		kubeProxyIsDisabled,
+		field.NewPath(v1alpha1.ClusterConfigVariableName, "addons", "cni"),
+	); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	// Skip validation if the skip annotation is present
+	if hasSkipAnnotation(cluster) {
+		return admission.Allowed("")
+	}
 	// Skip validation if kube-proxy is not disabled.
-	if clusterConfig.KubeProxy == nil || clusterConfig.KubeProxy.Mode != v1alpha1.KubeProxyModeDisabled {
+	if !kubeProxyIsDisabled {
 		return admission.Allowed("")
 	}
 	// Skip validation if not using Cilium as CNI provider.
-	if clusterConfig.Addons == nil || clusterConfig.Addons.CNI == nil ||
-		clusterConfig.Addons.CNI.Provider != v1alpha1.CNIProviderCilium {
+	if clusterConfig.Addons.CNI.Provider != v1alpha1.CNIProviderCilium {
 		return admission.Allowed("")
 	}
 	// Skip validation if no custom values are specified.
@@ -103,6 +121,7 @@ func (a *advancedCiliumConfigurationValidator) validate(
 	// Validate that kubeProxyReplacement is enabled
 	if err := validateCiliumKubeProxyReplacement(
 		ciliumValues,
+		clusterConfig.Addons.CNI.Values.Mode,
 		cluster.Namespace,
 		clusterConfig.Addons.CNI.Values.SourceRef.Name,
 	); err != nil {
@@ -112,6 +131,136 @@ func (a *advancedCiliumConfigurationValidator) validate(
 	return admission.Allowed("")
 }
 
+// validateCiliumConfiguration validates the combinations of the Cilium configuration settings.
+func validateCiliumConfiguration(
+	cni *v1alpha1.CNI,
+	provider string,
+	kubeProxyIsDisabled bool,
+	fldPath *field.Path,
+) field.ErrorList {
+	cilium := cni.Cilium
+	if cilium == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	ciliumPath := fldPath.Child("cilium")
+
+	if cni.Provider != v1alpha1.CNIProviderCilium {
+		errs = append(errs, field.Forbidden(
+			ciliumPath,
+			fmt.Sprintf("is only supported with the %s provider", v1alpha1.CNIProviderCilium),
+		))
+		return errs
+	}
+	if cni.Strategy == v1alpha1.AddonStrategyClusterResourceSet {
+		errs = append(errs, field.Forbidden(
+			ciliumPath,
+			fmt.Sprintf("is not supported with the %s strategy", v1alpha1.AddonStrategyClusterResourceSet),
+		))
+	}
+
+	if hubble := cilium.Hubble; hubble != nil {
+		hubblePath := ciliumPath.Child("hubble")
+		hubbleDisabled := hubble.Enabled != nil && !*hubble.Enabled
+		if hubbleDisabled && ptr.Deref(hubble.Relay, false) {
+			errs = append(errs, field.Invalid(
+				hubblePath.Child("relay"), *hubble.Relay, "requires Hubble to be enabled",
+			))
+		}
+		if ptr.Deref(hubble.UI, false) && (hubbleDisabled || (hubble.Relay != nil && !*hubble.Relay)) {
+			errs = append(errs, field.Invalid(
+				hubblePath.Child("ui"), *hubble.UI, "requires Hubble and Hubble Relay to be enabled",
+			))
+		}
+	}
+
+	if encryption := cilium.Encryption; encryption != nil {
+		encryptionPath := ciliumPath.Child("encryption")
+		switch encryption.Type {
+		case v1alpha1.CiliumEncryptionTypeIPsec:
+			if encryption.IPsecKeySecretRef == nil {
+				errs = append(errs, field.Required(
+					encryptionPath.Child("ipsecKeySecretRef"), "is required with IPsec encryption",
+				))
+			}
+			if encryption.NodeEncryption {
+				errs = append(errs, field.Invalid(
+					encryptionPath.Child("nodeEncryption"),
+					encryption.NodeEncryption,
+					"is only supported with WireGuard encryption",
+				))
+			}
+		case v1alpha1.CiliumEncryptionTypeWireGuard:
+			if encryption.IPsecKeySecretRef != nil {
+				errs = append(errs, field.Forbidden(
+					encryptionPath.Child("ipsecKeySecretRef"), "is only supported with IPsec encryption",
+				))
+			}
+		}
+	}
+
+	if routing := cilium.Routing; routing != nil {
+		routingPath := ciliumPath.Child("routing")
+		switch routing.Mode {
+		case v1alpha1.CiliumRoutingModeNative:
+			// On EKS, the native routing CIDR is detected from the VPC by the ENI IPAM.
+			if routing.IPv4NativeRoutingCIDR == "" && provider != "eks" {
+				errs = append(errs, field.Required(
+					routingPath.Child("ipv4NativeRoutingCIDR"), "is required with the Native routing mode",
+				))
+			}
+			if routing.TunnelProtocol != "" {
+				errs = append(errs, field.Forbidden(
+					routingPath.Child("tunnelProtocol"), "is only supported with the Tunnel routing mode",
+				))
+			}
+		case v1alpha1.CiliumRoutingModeTunnel:
+			if provider == "eks" {
+				errs = append(errs, field.Invalid(
+					routingPath.Child("mode"), routing.Mode, "must be Native on EKS clusters",
+				))
+			}
+			if routing.IPv4NativeRoutingCIDR != "" {
+				errs = append(errs, field.Forbidden(
+					routingPath.Child("ipv4NativeRoutingCIDR"), "is only supported with the Native routing mode",
+				))
+			}
+			if routing.AutoDirectNodeRoutes {
+				errs = append(errs, field.Forbidden(
+					routingPath.Child("autoDirectNodeRoutes"), "is only supported with the Native routing mode",
+				))
+			}
+			// The load balancer uses Geneve to dispatch DSR traffic when kube-proxy is disabled.
+			if routing.TunnelProtocol == v1alpha1.CiliumTunnelProtocolVXLAN && kubeProxyIsDisabled {
+				errs = append(errs, field.Invalid(
+					routingPath.Child("tunnelProtocol"),
+					routing.TunnelProtocol,
+					"must be Geneve when kube-proxy is disabled",
+				))
+			}
+		}
+	}
+
+	if bandwidthManager := cilium.BandwidthManager; bandwidthManager != nil {
+		if bandwidthManager.BBR && !bandwidthManager.Enabled {
+			errs = append(errs, field.Invalid(
+				ciliumPath.Child("bandwidthManager", "bbr"),
+				bandwidthManager.BBR,
+				"requires the bandwidth manager to be enabled",
+			))
+		}
+	}
+
+	if ptr.Deref(cilium.BPFMasquerade, false) && !kubeProxyIsDisabled {
+		errs = append(errs, field.Invalid(
+			ciliumPath.Child("bpfMasquerade"), *cilium.BPFMasquerade, "requires kube-proxy to be disabled",
+		))
+	}
+
+	return errs
+}
+
 func hasSkipAnnotation(cluster *clusterv1.Cluster) bool {
 	if cluster.Annotations == nil {
 		return false
@@ -148,7 +297,7 @@ func templateValues(cluster *clusterv1.Cluster, text string) (string, error) {
 }
 
 type ciliumValues struct {
-	KubeProxyReplacement bool `json:"kubeProxyReplacement"`
+	KubeProxyReplacement *bool `json:"kubeProxyReplacement"`
 }
 
 // getCiliumValues retrieves and parses the Cilium values from a ConfigMap.
@@ -211,8 +360,16 @@ func getCiliumValues(
 	return values, nil
 }
 
-func validateCiliumKubeProxyReplacement(values *ciliumValues, namespace, configMapName string) error {
-	if !values.KubeProxyReplacement {
+func validateCiliumKubeProxyReplacement(
+	values *ciliumValues,
+	mode v1alpha1.AddonValuesMode,
+	namespace, configMapName string,
+) error {
+	// When merged, the values inherit kubeProxyReplacement from the default values unless they disable it.
+	if mode == v1alpha1.AddonValuesModeMerge && values.KubeProxyReplacement == nil {
+		return nil
+	}
+	if !ptr.Deref(values.KubeProxyReplacement, false) {
 		return fmt.Errorf(
 			"kube-proxy is disabled, but Cilium ConfigMap %s/%s does not have 'kubeProxyReplacement' enabled",
 			namespace,
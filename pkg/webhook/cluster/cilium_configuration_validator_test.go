@@ -15,6 +15,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -421,6 +422,81 @@ kubeProxyReplacement: true
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
+		It("should allow when merged values do not set kubeProxyReplacement", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				AddonConfig: v1alpha1.AddonConfig{
+					Values: &v1alpha1.AddonValues{
+						SourceRef: &v1alpha1.ValuesReference{
+							Kind: "ConfigMap",
+							Name: "cilium-values",
+						},
+						Mode: v1alpha1.AddonValuesModeMerge,
+					},
+				},
+			}
+			cluster := createTestCluster("test-cluster", "test-namespace", v1alpha1.KubeProxyModeDisabled, cni)
+			req := createAdmissionRequest(cluster)
+
+			// Create ConfigMap without kubeProxyReplacement, inherited from the default values
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cilium-values",
+					Namespace: "test-namespace",
+				},
+				Data: map[string]string{
+					"values.yaml": `
+hubble:
+  ui:
+    enabled: true
+`,
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap).Build()
+			validator = NewAdvancedCiliumConfigurationValidator(client, decoder)
+
+			resp := validator.validate(context.Background(), req)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should deny when merged values set kubeProxyReplacement to false", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				AddonConfig: v1alpha1.AddonConfig{
+					Values: &v1alpha1.AddonValues{
+						SourceRef: &v1alpha1.ValuesReference{
+							Kind: "ConfigMap",
+							Name: "cilium-values",
+						},
+						Mode: v1alpha1.AddonValuesModeMerge,
+					},
+				},
+			}
+			cluster := createTestCluster("test-cluster", "test-namespace", v1alpha1.KubeProxyModeDisabled, cni)
+			req := createAdmissionRequest(cluster)
+
+			// Create ConfigMap with kubeProxyReplacement set to false
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cilium-values",
+					Namespace: "test-namespace",
+				},
+				Data: map[string]string{
+					"values.yaml": `
+kubeProxyReplacement: false
+`,
+				},
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap).Build()
+			validator = NewAdvancedCiliumConfigurationValidator(client, decoder)
+
+			resp := validator.validate(context.Background(), req)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring("does not have 'kubeProxyReplacement' enabled"))
+		})
+
 		It("should allow when ConfigMap uses templated values with ControlPlaneEndpoint", func() {
 			cni := &v1alpha1.CNI{
 				Provider: v1alpha1.CNIProviderCilium,
@@ -565,6 +641,323 @@ k8sServicePort: "{{ .ControlPlaneEndpoint.Port }}"
 			Expect(resp.Result.Message).To(ContainSubstring("can't evaluate field UnknownField"))
 		})
 	})
+
+	Context("when the Cilium configuration is set", func() {
+		validate := func(
+			kubeProxyMode v1alpha1.KubeProxyMode,
+			cni *v1alpha1.CNI,
+			labels map[string]string,
+		) admission.Response {
+			cluster := createTestCluster("test-cluster", "test-namespace", kubeProxyMode, cni)
+			cluster.Labels = labels
+			req := createAdmissionRequest(cluster)
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			validator = NewAdvancedCiliumConfigurationValidator(client, decoder)
+
+			return validator.validate(context.Background(), req)
+		}
+
+		It("should allow a valid configuration", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Hubble: &v1alpha1.CiliumHubble{
+						Relay: ptr.To(true),
+						UI:    ptr.To(true),
+					},
+					Encryption: &v1alpha1.CiliumEncryption{
+						Type:           v1alpha1.CiliumEncryptionTypeWireGuard,
+						NodeEncryption: true,
+					},
+					Routing: &v1alpha1.CiliumRouting{
+						Mode:                  v1alpha1.CiliumRoutingModeNative,
+						IPv4NativeRoutingCIDR: "10.0.0.0/8",
+						AutoDirectNodeRoutes:  true,
+					},
+					BandwidthManager: &v1alpha1.CiliumBandwidthManager{
+						Enabled: true,
+						BBR:     true,
+					},
+					BPFMasquerade: ptr.To(true),
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeTrue(), resp.Result.Message)
+		})
+
+		It("should deny when CNI provider is not Cilium", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCalico,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					BPFMasquerade: ptr.To(true),
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium: Forbidden: is only supported with the Cilium provider",
+			))
+		})
+
+		It("should deny with the ClusterResourceSet strategy", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Strategy: v1alpha1.AddonStrategyClusterResourceSet,
+				Cilium:   &v1alpha1.CiliumConfiguration{},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium: Forbidden: is not supported with the ClusterResourceSet strategy",
+			))
+		})
+
+		It("should deny the Hubble UI when Hubble Relay is disabled", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Hubble: &v1alpha1.CiliumHubble{
+						Relay: ptr.To(false),
+						UI:    ptr.To(true),
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.hubble.ui: Invalid value: true: " +
+					"requires Hubble and Hubble Relay to be enabled",
+			))
+		})
+
+		It("should deny Hubble Relay when Hubble is disabled", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Hubble: &v1alpha1.CiliumHubble{
+						Enabled: ptr.To(false),
+						Relay:   ptr.To(true),
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.hubble.relay: Invalid value: true: requires Hubble to be enabled",
+			))
+		})
+
+		It("should deny IPsec encryption without the key Secret", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Encryption: &v1alpha1.CiliumEncryption{
+						Type:           v1alpha1.CiliumEncryptionTypeIPsec,
+						NodeEncryption: true,
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.encryption.ipsecKeySecretRef: Required value",
+			))
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.encryption.nodeEncryption: Invalid value: true: " +
+					"is only supported with WireGuard encryption",
+			))
+		})
+
+		It("should deny WireGuard encryption with the IPsec key Secret", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Encryption: &v1alpha1.CiliumEncryption{
+						Type:              v1alpha1.CiliumEncryptionTypeWireGuard,
+						IPsecKeySecretRef: &v1alpha1.LocalObjectReference{Name: "cilium-ipsec-keys"},
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.encryption.ipsecKeySecretRef: Forbidden",
+			))
+		})
+
+		It("should deny Native routing without the native routing CIDR", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Routing: &v1alpha1.CiliumRouting{
+						Mode:           v1alpha1.CiliumRoutingModeNative,
+						TunnelProtocol: v1alpha1.CiliumTunnelProtocolGeneve,
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.routing.ipv4NativeRoutingCIDR: Required value",
+			))
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.routing.tunnelProtocol: Forbidden",
+			))
+		})
+
+		It("should allow Native routing without the native routing CIDR on EKS", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Routing: &v1alpha1.CiliumRouting{
+						Mode: v1alpha1.CiliumRoutingModeNative,
+					},
+				},
+			}
+
+			resp := validate(
+				v1alpha1.KubeProxyModeDisabled, cni, map[string]string{clusterv1beta2.ProviderNameLabel: "eks"},
+			)
+			Expect(resp.Allowed).To(BeTrue(), resp.Result.Message)
+		})
+
+		It("should deny Tunnel routing on EKS", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Routing: &v1alpha1.CiliumRouting{
+						Mode: v1alpha1.CiliumRoutingModeTunnel,
+					},
+				},
+			}
+
+			resp := validate(
+				v1alpha1.KubeProxyModeDisabled, cni, map[string]string{clusterv1beta2.ProviderNameLabel: "eks"},
+			)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				`clusterConfig.addons.cni.cilium.routing.mode: Invalid value: "Tunnel": must be Native on EKS clusters`,
+			))
+		})
+
+		It("should deny Tunnel routing with native routing settings", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Routing: &v1alpha1.CiliumRouting{
+						Mode:                  v1alpha1.CiliumRoutingModeTunnel,
+						IPv4NativeRoutingCIDR: "10.0.0.0/8",
+						AutoDirectNodeRoutes:  true,
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.routing.ipv4NativeRoutingCIDR: Forbidden",
+			))
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.routing.autoDirectNodeRoutes: Forbidden",
+			))
+		})
+
+		It("should deny the VXLAN tunnel protocol when kube-proxy is disabled", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Routing: &v1alpha1.CiliumRouting{
+						Mode:           v1alpha1.CiliumRoutingModeTunnel,
+						TunnelProtocol: v1alpha1.CiliumTunnelProtocolVXLAN,
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				`clusterConfig.addons.cni.cilium.routing.tunnelProtocol: Invalid value: "VXLAN": ` +
+					"must be Geneve when kube-proxy is disabled",
+			))
+		})
+
+		It("should allow the VXLAN tunnel protocol when kube-proxy is enabled", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					Routing: &v1alpha1.CiliumRouting{
+						Mode:           v1alpha1.CiliumRoutingModeTunnel,
+						TunnelProtocol: v1alpha1.CiliumTunnelProtocolVXLAN,
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeIPTables, cni, nil)
+			Expect(resp.Allowed).To(BeTrue(), resp.Result.Message)
+		})
+
+		It("should deny BBR when the bandwidth manager is disabled", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					BandwidthManager: &v1alpha1.CiliumBandwidthManager{
+						BBR: true,
+					},
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeDisabled, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.bandwidthManager.bbr: Invalid value: true: " +
+					"requires the bandwidth manager to be enabled",
+			))
+		})
+
+		It("should deny BPF masquerade when kube-proxy is enabled", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					BPFMasquerade: ptr.To(true),
+				},
+			}
+
+			resp := validate(v1alpha1.KubeProxyModeIPTables, cni, nil)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring(
+				"clusterConfig.addons.cni.cilium.bpfMasquerade: Invalid value: true: requires kube-proxy to be disabled",
+			))
+		})
+
+		It("should validate the configuration when the skip annotation is present", func() {
+			cni := &v1alpha1.CNI{
+				Provider: v1alpha1.CNIProviderCilium,
+				Cilium: &v1alpha1.CiliumConfiguration{
+					BPFMasquerade: ptr.To(true),
+				},
+			}
+			cluster := createTestCluster("test-cluster", "test-namespace", v1alpha1.KubeProxyModeIPTables, cni)
+			cluster.Annotations = map[string]string{
+				v1alpha1.SkipCiliumKubeProxyReplacementValidation: "true",
+			}
+			req := createAdmissionRequest(cluster)
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			validator = NewAdvancedCiliumConfigurationValidator(client, decoder)
+
+			resp := validator.validate(context.Background(), req)
+			Expect(resp.Allowed).To(BeFalse())
+		})
+	})
 })
 
 func createTestCluster(
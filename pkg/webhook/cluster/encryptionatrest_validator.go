@@ -0,0 +1,103 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/generic/mutation/kubeadm/encryptionatrest"
+)
+
+type encryptionAtRestValidator struct {
+	client  ctrlclient.Client
+	decoder admission.Decoder
+}
+
+func NewEncryptionAtRestValidator(
+	client ctrlclient.Client, decoder admission.Decoder,
+) *encryptionAtRestValidator {
+	return &encryptionAtRestValidator{
+		client:  client,
+		decoder: decoder,
+	}
+}
+
+func (e *encryptionAtRestValidator) Validator() admission.HandlerFunc {
+	return e.validate
+}
+
+func (e *encryptionAtRestValidator) validate(
+	ctx context.Context,
+	req admission.Request,
+) admission.Response {
+	if req.Operation == v1.Delete {
+		return admission.Allowed("")
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := e.decoder.Decode(req, cluster); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !cluster.Spec.Topology.IsDefined() {
+		return admission.Allowed("")
+	}
+
+	encryption, err := encryptionAtRest(cluster)
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	// The resources encrypted with the old configuration must remain readable.
+	var oldEncryption *v1alpha1.EncryptionAtRest
+	if req.Operation == v1.Update {
+		oldCluster := &clusterv1.Cluster{}
+		if err := e.decoder.DecodeRaw(req.OldObject, oldCluster); err != nil {
+			return admission.Errored(
+				http.StatusBadRequest,
+				fmt.Errorf("failed to decode old cluster: %w", err),
+			)
+		}
+		if oldCluster.Spec.Topology.IsDefined() {
+			oldEncryption, err = encryptionAtRest(oldCluster)
+			if err != nil {
+				return admission.Denied(err.Error())
+			}
+		}
+	}
+
+	if errs := encryptionatrest.ValidateEncryptionAtRest(
+		encryption,
+		oldEncryption,
+		field.NewPath(v1alpha1.ClusterConfigVariableName, encryptionatrest.VariableName),
+	); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// encryptionAtRest returns the encryption at rest configuration of the cluster, or nil if it is not set.
+func encryptionAtRest(cluster *clusterv1.Cluster) (*v1alpha1.EncryptionAtRest, error) {
+	clusterConfig, err := variables.UnmarshalClusterConfigVariable(cluster.Spec.Topology.Variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cluster topology variable %q: %w",
+			v1alpha1.ClusterConfigVariableName,
+			err)
+	}
+	if clusterConfig == nil {
+		return nil, nil
+	}
+	return clusterConfig.EncryptionAtRest, nil
+}
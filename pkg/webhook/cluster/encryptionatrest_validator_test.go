@@ -0,0 +1,177 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+func TestEncryptionAtRestValidator(t *testing.T) {
+	defaultProviders := []v1alpha1.EncryptionProviders{{AESCBC: &v1alpha1.AESConfiguration{}}}
+
+	testCases := []struct {
+		name          string
+		operation     admissionv1.Operation
+		encryption    *v1alpha1.EncryptionAtRest
+		oldEncryption *v1alpha1.EncryptionAtRest
+		expectAllowed bool
+		expectMessage string
+	}{
+		{
+			name:          "allows clusters without encryption at rest",
+			operation:     admissionv1.Create,
+			expectAllowed: true,
+		},
+		{
+			name:      "allows custom resources and wildcards",
+			operation: admissionv1.Create,
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC:    &v1alpha1.AESConfiguration{},
+					Resources: []string{"secrets", "externalsecrets.external-secrets.io", "*.cert-manager.io"},
+				}},
+			},
+			expectAllowed: true,
+		},
+		{
+			name:      "rejects resources that the API server does not allow",
+			operation: admissionv1.Create,
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC:    &v1alpha1.AESConfiguration{},
+					Resources: []string{"*"},
+				}},
+			},
+			expectAllowed: false,
+			expectMessage: "clusterConfig.encryptionAtRest.providers[0].resources[0]",
+		},
+		{
+			name:      "allows adding resources to an existing cluster",
+			operation: admissionv1.Update,
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC:    &v1alpha1.AESConfiguration{},
+					Resources: []string{"secrets", "configmaps", "*.cert-manager.io"},
+				}},
+			},
+			oldEncryption: &v1alpha1.EncryptionAtRest{Providers: defaultProviders},
+			expectAllowed: true,
+		},
+		{
+			name:      "rejects removing encrypted resources from an existing cluster",
+			operation: admissionv1.Update,
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					AESCBC:    &v1alpha1.AESConfiguration{},
+					Resources: []string{"secrets"},
+				}},
+			},
+			oldEncryption: &v1alpha1.EncryptionAtRest{Providers: defaultProviders},
+			expectAllowed: false,
+			expectMessage: `resource "configmaps" is encrypted with the aescbc provider`,
+		},
+		{
+			name:      "rejects removing the provider that encrypted resources of an existing cluster",
+			operation: admissionv1.Update,
+			encryption: &v1alpha1.EncryptionAtRest{
+				Providers: []v1alpha1.EncryptionProviders{{
+					Secretbox: &v1alpha1.SecretboxConfiguration{},
+				}},
+			},
+			oldEncryption: &v1alpha1.EncryptionAtRest{Providers: defaultProviders},
+			expectAllowed: false,
+			expectMessage: "must include the aescbc provider",
+		},
+		{
+			name:          "rejects disabling encryption at rest of an existing cluster",
+			operation:     admissionv1.Update,
+			oldEncryption: &v1alpha1.EncryptionAtRest{Providers: defaultProviders},
+			expectAllowed: false,
+			expectMessage: "clusterConfig.encryptionAtRest: Forbidden: cannot be removed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1beta2.AddToScheme(scheme)).To(Succeed())
+			validator := NewEncryptionAtRestValidator(
+				fake.NewClientBuilder().WithScheme(scheme).Build(),
+				admission.NewDecoder(scheme),
+			)
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					Object: runtime.RawExtension{
+						Raw: createEncryptionAtRestClusterRaw(g, tc.encryption),
+					},
+				},
+			}
+			if tc.operation == admissionv1.Update {
+				req.OldObject = runtime.RawExtension{
+					Raw: createEncryptionAtRestClusterRaw(g, tc.oldEncryption),
+				}
+			}
+
+			resp := validator.validate(context.Background(), req)
+			g.Expect(resp.Allowed).To(Equal(tc.expectAllowed), resp.Result.Message)
+			if tc.expectMessage != "" {
+				g.Expect(resp.Result.Message).To(ContainSubstring(tc.expectMessage))
+			}
+		})
+	}
+}
+
+func createEncryptionAtRestClusterRaw(g Gomega, encryption *v1alpha1.EncryptionAtRest) []byte {
+	clusterConfig := &variables.ClusterConfigSpec{
+		KubeadmClusterConfigSpec: v1alpha1.KubeadmClusterConfigSpec{
+			EncryptionAtRest: encryption,
+		},
+	}
+	clusterConfigRaw, err := json.Marshal(clusterConfig)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cluster := &clusterv1beta2.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1beta2.GroupVersion.String(),
+			Kind:       "Cluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "test-namespace",
+		},
+		Spec: clusterv1beta2.ClusterSpec{
+			Topology: clusterv1beta2.Topology{
+				ClassRef: clusterv1beta2.ClusterClassRef{
+					Name: "test-class",
+				},
+				Version: "v1.30.0",
+				Variables: []clusterv1beta2.ClusterVariable{{
+					Name:  v1alpha1.ClusterConfigVariableName,
+					Value: apiextensionsv1.JSON{Raw: clusterConfigRaw},
+				}},
+			},
+		},
+	}
+	clusterRaw, err := json.Marshal(cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	return clusterRaw
+}
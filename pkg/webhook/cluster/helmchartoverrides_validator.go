@@ -0,0 +1,102 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/lifecycle/config"
+)
+
+type helmChartOverridesValidator struct {
+	client  ctrlclient.Client
+	decoder admission.Decoder
+}
+
+func NewHelmChartOverridesValidator(
+	client ctrlclient.Client, decoder admission.Decoder,
+) *helmChartOverridesValidator {
+	return &helmChartOverridesValidator{
+		client:  client,
+		decoder: decoder,
+	}
+}
+
+func (h *helmChartOverridesValidator) Validator() admission.HandlerFunc {
+	return h.validate
+}
+
+func (h *helmChartOverridesValidator) validate(
+	ctx context.Context,
+	req admission.Request,
+) admission.Response {
+	if req.Operation == v1.Delete {
+		return admission.Allowed("")
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := h.decoder.Decode(req, cluster); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !cluster.Spec.Topology.IsDefined() {
+		return admission.Allowed("")
+	}
+
+	clusterConfig, err := variables.UnmarshalClusterConfigVariable(cluster.Spec.Topology.Variables)
+	if err != nil {
+		return admission.Denied(
+			fmt.Errorf("failed to unmarshal cluster topology variable %q: %w",
+				v1alpha1.ClusterConfigVariableName,
+				err).Error(),
+		)
+	}
+
+	if clusterConfig == nil || clusterConfig.Addons == nil {
+		return admission.Allowed("")
+	}
+
+	if errs := validateHelmChartOverrides(
+		clusterConfig.Addons.HelmChartOverrides,
+		field.NewPath(v1alpha1.ClusterConfigVariableName, "addons", "helmChartOverrides"),
+	); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// validateHelmChartOverrides checks that the Helm chart overrides only override the Helm charts of known components.
+func validateHelmChartOverrides(
+	overrides []v1alpha1.HelmChartOverride,
+	fldPath *field.Path,
+) field.ErrorList {
+	components := config.Components()
+	supported := make([]string, 0, len(components))
+	for _, component := range components {
+		supported = append(supported, string(component))
+	}
+
+	fldErrs := field.ErrorList{}
+	for i, override := range overrides {
+		if !config.IsComponent(override.Component) {
+			fldErrs = append(fldErrs, field.NotSupported(
+				fldPath.Index(i).Child("component"),
+				override.Component,
+				supported,
+			))
+		}
+	}
+	return fldErrs
+}
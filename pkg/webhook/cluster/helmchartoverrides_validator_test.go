@@ -0,0 +1,119 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+func TestHelmChartOverridesValidator(t *testing.T) {
+	testCases := []struct {
+		name          string
+		overrides     []v1alpha1.HelmChartOverride
+		expectAllowed bool
+		expectMessage string
+	}{
+		{
+			name:          "allows clusters without Helm chart overrides",
+			expectAllowed: true,
+		},
+		{
+			name: "allows overriding the Helm charts of known components",
+			overrides: []v1alpha1.HelmChartOverride{
+				{Component: "cilium", Version: "1.17.1"},
+				{Component: "aws-ebs-csi", Version: "2.40.0", RepositoryURL: "https://example.com/charts"},
+			},
+			expectAllowed: true,
+		},
+		{
+			name: "rejects overriding the Helm chart of an unknown component",
+			overrides: []v1alpha1.HelmChartOverride{
+				{Component: "cilium", Version: "1.17.1"},
+				{Component: "calico", Version: "3.29.0"},
+			},
+			expectAllowed: false,
+			expectMessage: `clusterConfig.addons.helmChartOverrides[1].component: Unsupported value: "calico"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1beta2.AddToScheme(scheme)).To(Succeed())
+			validator := NewHelmChartOverridesValidator(
+				fake.NewClientBuilder().WithScheme(scheme).Build(),
+				admission.NewDecoder(scheme),
+			)
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: createHelmChartOverridesClusterRaw(g, tc.overrides),
+					},
+				},
+			}
+
+			resp := validator.validate(context.Background(), req)
+			g.Expect(resp.Allowed).To(Equal(tc.expectAllowed), resp.Result.Message)
+			if tc.expectMessage != "" {
+				g.Expect(resp.Result.Message).To(ContainSubstring(tc.expectMessage))
+			}
+		})
+	}
+}
+
+func createHelmChartOverridesClusterRaw(g Gomega, overrides []v1alpha1.HelmChartOverride) []byte {
+	clusterConfig := &variables.ClusterConfigSpec{
+		Addons: &variables.Addons{
+			GenericAddons: v1alpha1.GenericAddons{
+				HelmChartOverrides: overrides,
+			},
+		},
+	}
+	clusterConfigRaw, err := json.Marshal(clusterConfig)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cluster := &clusterv1beta2.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1beta2.GroupVersion.String(),
+			Kind:       "Cluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "test-namespace",
+		},
+		Spec: clusterv1beta2.ClusterSpec{
+			Topology: clusterv1beta2.Topology{
+				ClassRef: clusterv1beta2.ClusterClassRef{
+					Name: "test-class",
+				},
+				Version: "v1.30.0",
+				Variables: []clusterv1beta2.ClusterVariable{{
+					Name:  v1alpha1.ClusterConfigVariableName,
+					Value: apiextensionsv1.JSON{Raw: clusterConfigRaw},
+				}},
+			},
+		},
+	}
+	clusterRaw, err := json.Marshal(cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	return clusterRaw
+}
@@ -9,9 +9,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/netip"
+	"slices"
 
 	v1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -19,6 +22,7 @@ import (
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/handlers/generic/mutation/kubeadm/controlplanevirtualip/providers"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/helpers"
 )
 
@@ -79,6 +83,17 @@ func (a *nutanixValidator) validate(
 			return admission.Denied(err.Error())
 		}
 
+		kcpTemplate, err := a.kubeadmControlPlaneTemplate(ctx, cluster, clusterConfig.Nutanix.ControlPlaneEndpoint)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if err := validateControlPlaneVirtualIPProvider(
+			clusterConfig.Nutanix.ControlPlaneEndpoint,
+			kcpTemplate,
+		); err != nil {
+			return admission.Denied(err.Error())
+		}
+
 		if clusterConfig.Addons != nil {
 			// Check if Prism Central IP is in MetalLB Load Balancer IP range.
 			if err := validatePrismCentralIPNotInLoadBalancerIPRange(
@@ -293,23 +308,26 @@ func validatePrismCentralIPNotInLoadBalancerIPRange(
 		return nil
 	}
 
-	for _, pool := range serviceLoadBalancerConfiguration.Configuration.AddressRanges {
-		isIPInRange, err := helpers.IsIPInRange(pool.Start, pool.End, pcIP.String())
+	addressRanges := slices.Clone(serviceLoadBalancerConfiguration.Configuration.AddressRanges)
+	for _, addressPool := range serviceLoadBalancerConfiguration.Configuration.AddressPools {
+		addressRanges = append(addressRanges, addressPool.AddressRanges...)
+	}
+
+	for _, addressRange := range addressRanges {
+		ipRange, err := helpers.ParseAddressRange(addressRange)
 		if err != nil {
 			return fmt.Errorf(
-				"failed to check if Prism Central IP %q is part of MetalLB address range %q-%q: %w",
+				"failed to check if Prism Central IP %q is part of MetalLB address range %q: %w",
 				pcIP,
-				pool.Start,
-				pool.End,
+				addressRange.String(),
 				err,
 			)
 		}
-		if isIPInRange {
+		if ipRange.Contains(pcIP) {
 			errMsg := fmt.Sprintf(
-				"Prism Central IP %q must not be part of MetalLB address range %q-%q",
+				"Prism Central IP %q must not be part of MetalLB address range %q",
 				pcIP,
-				pool.Start,
-				pool.End,
+				addressRange.String(),
 			)
 			return errors.New(errMsg)
 		}
@@ -349,3 +367,109 @@ func validatePrismCentralIPDoesNotEqualControlPlaneIP(
 
 	return nil
 }
+
+// kubeadmControlPlaneTemplate returns the KubeadmControlPlaneTemplate of the ClusterClass, from which the keepalived
+// and HAProxy virtual IP provider reads the API server bind port. It is only read when that provider is selected. If
+// the ClusterClass or its KubeadmControlPlaneTemplate does not exist yet, nil is returned.
+func (a *nutanixValidator) kubeadmControlPlaneTemplate(
+	ctx context.Context,
+	cluster *clusterv1.Cluster,
+	controlPlaneEndpointSpec v1alpha1.ControlPlaneEndpointSpec,
+) (*controlplanev1.KubeadmControlPlaneTemplate, error) {
+	virtualIPSpec := controlPlaneEndpointSpec.VirtualIPSpec
+	if virtualIPSpec == nil || virtualIPSpec.Provider != v1alpha1.VirtualIPProviderKeepalivedHAProxy {
+		return nil, nil
+	}
+
+	clusterClass := &clusterv1.ClusterClass{}
+	if err := a.client.Get(ctx, cluster.GetClassKey(), clusterClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ClusterClass %s: %w", cluster.GetClassKey(), err)
+	}
+
+	templateRef := clusterClass.Spec.ControlPlane.TemplateRef
+	if templateRef.Kind != "KubeadmControlPlaneTemplate" ||
+		templateRef.GroupVersionKind().Group != controlplanev1.GroupVersion.Group {
+		return nil, nil
+	}
+	kcpTemplate := &controlplanev1.KubeadmControlPlaneTemplate{}
+	templateKey := ctrlclient.ObjectKey{Namespace: clusterClass.Namespace, Name: templateRef.Name}
+	if err := a.client.Get(ctx, templateKey, kcpTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get KubeadmControlPlaneTemplate %s: %w", templateKey, err)
+	}
+	return kcpTemplate, nil
+}
+
+// validateControlPlaneVirtualIPProvider checks that the control plane endpoint can be served by the
+// selected virtual IP provider, and that the provider specific configuration matches the selected provider. The API
+// server bind port is read from the KubeadmControlPlaneTemplate, if it exists.
+func validateControlPlaneVirtualIPProvider(
+	controlPlaneEndpointSpec v1alpha1.ControlPlaneEndpointSpec,
+	kcpTemplate *controlplanev1.KubeadmControlPlaneTemplate,
+) error {
+	virtualIPSpec := controlPlaneEndpointSpec.VirtualIPSpec
+	if virtualIPSpec == nil {
+		return nil
+	}
+
+	if virtualIPSpec.Provider != v1alpha1.VirtualIPProviderKeepalivedHAProxy {
+		if virtualIPSpec.KeepalivedHAProxy != nil {
+			return fmt.Errorf(
+				"control plane virtual IP keepalivedHAProxy configuration must not be set with the %q provider",
+				virtualIPSpec.Provider,
+			)
+		}
+		return nil
+	}
+
+	if virtualIPSpec.KeepalivedHAProxy != nil {
+		// If the virtual router ID is not set, it is 0, and an ID is derived from the cluster.
+		virtualRouterID := virtualIPSpec.KeepalivedHAProxy.VirtualRouterID
+		if virtualRouterID < 0 || virtualRouterID > 255 {
+			return fmt.Errorf(
+				"control plane virtual IP virtualRouterID %d must be between 1 and 255 with the %q provider",
+				virtualRouterID,
+				v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+			)
+		}
+	}
+
+	// keepalived assigns the virtual IP to the network interface, so it must be an IP address.
+	if _, err := netip.ParseAddr(controlPlaneEndpointSpec.VirtualIPAddress()); err != nil {
+		return fmt.Errorf(
+			"control plane virtual IP %q must be an IP address with the %q provider",
+			controlPlaneEndpointSpec.VirtualIPAddress(),
+			v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+		)
+	}
+
+	// HAProxy listens on the virtual IP port on every control plane machine,
+	// so it cannot be the port the API server binds to.
+	apiServerBindPort := int32(providers.DefaultAPIServerBindPort)
+	if kcpTemplate != nil {
+		var err error
+		apiServerBindPort, err = providers.APIServerBindPort(kcpTemplate)
+		if err != nil {
+			return err
+		}
+	}
+	port := controlPlaneEndpointSpec.Port
+	if virtualIPSpec.Configuration != nil && virtualIPSpec.Configuration.Port != 0 {
+		port = virtualIPSpec.Configuration.Port
+	}
+	if port == apiServerBindPort {
+		return fmt.Errorf(
+			"control plane virtual IP port %d must be different from the API server bind port %d with the %q provider",
+			port,
+			apiServerBindPort,
+			v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+		)
+	}
+
+	return nil
+}
@@ -4,13 +4,20 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	capxv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/github.com/nutanix-cloud-native/cluster-api-provider-nutanix/api/v1beta1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
@@ -53,10 +60,59 @@ func TestValidatePrismCentralIPNotInLoadBalancerIPRange(t *testing.T) {
 				},
 			},
 			expectedErr: fmt.Errorf(
-				"Prism Central IP %q must not be part of MetalLB address range %q-%q",
+				"Prism Central IP %q must not be part of MetalLB address range %q",
 				"192.168.1.15",
-				"192.168.1.10",
-				"192.168.1.20",
+				"192.168.1.10-192.168.1.20",
+			),
+		},
+		{
+			name: "PC IP in address pool range",
+			pcEndpoint: v1alpha1.NutanixPrismCentralEndpointSpec{
+				URL: "https://192.168.1.35:9440",
+			},
+			serviceLoadBalancerConfiguration: &v1alpha1.ServiceLoadBalancer{
+				Provider: v1alpha1.ServiceLoadBalancerProviderMetalLB,
+				Configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+					AddressPools: []v1alpha1.ServiceLoadBalancerAddressPool{
+						{
+							Name: "l2",
+							AddressRanges: []v1alpha1.AddressRange{
+								{Start: "192.168.1.10", End: "192.168.1.20"},
+							},
+						},
+						{
+							Name:              "bgp",
+							AdvertisementMode: v1alpha1.ServiceLoadBalancerAdvertisementModeBGP,
+							AddressRanges: []v1alpha1.AddressRange{
+								{Start: "192.168.1.30", End: "192.168.1.40"},
+							},
+						},
+					},
+				},
+			},
+			expectedErr: fmt.Errorf(
+				"Prism Central IP %q must not be part of MetalLB address range %q",
+				"192.168.1.35",
+				"192.168.1.30-192.168.1.40",
+			),
+		},
+		{
+			name: "PC IP in CIDR range",
+			pcEndpoint: v1alpha1.NutanixPrismCentralEndpointSpec{
+				URL: "https://192.168.1.15:9440",
+			},
+			serviceLoadBalancerConfiguration: &v1alpha1.ServiceLoadBalancer{
+				Provider: v1alpha1.ServiceLoadBalancerProviderMetalLB,
+				Configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+					AddressRanges: []v1alpha1.AddressRange{
+						{CIDR: "192.168.1.0/28"},
+					},
+				},
+			},
+			expectedErr: fmt.Errorf(
+				"Prism Central IP %q must not be part of MetalLB address range %q",
+				"192.168.1.15",
+				"192.168.1.0/28",
 			),
 		},
 		{
@@ -227,6 +283,285 @@ func TestValidatePrismCentralIPDoesNotEqualControlPlaneIP(t *testing.T) {
 	}
 }
 
+func TestValidateControlPlaneVirtualIPProvider(t *testing.T) {
+	tests := []struct {
+		name                     string
+		controlPlaneEndpointSpec v1alpha1.ControlPlaneEndpointSpec
+		kcpTemplate              *controlplanev1.KubeadmControlPlaneTemplate
+		expectedErr              error
+	}{
+		{
+			name: "Virtual IP not set",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 6443,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "KubeVIP with the API server port",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 6443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKubeVIP,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "KubeVIP with keepalivedHAProxy configuration",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 6443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider:          v1alpha1.VirtualIPProviderKubeVIP,
+					KeepalivedHAProxy: &v1alpha1.KeepalivedHAProxyConfiguration{VirtualRouterID: 10},
+				},
+			},
+			expectedErr: fmt.Errorf(
+				"control plane virtual IP keepalivedHAProxy configuration must not be set with the %q provider",
+				"KubeVIP",
+			),
+		},
+		{
+			name: "KeepalivedHAProxy with a different port",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 8443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider:          v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+					KeepalivedHAProxy: &v1alpha1.KeepalivedHAProxyConfiguration{VirtualRouterID: 10},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "KeepalivedHAProxy with a different port override",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "cluster.example.com",
+				Port: 6443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+					Configuration: &v1alpha1.ControlPlaneVirtualIPConfiguration{
+						Address: "192.168.1.2",
+						Port:    8443,
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "KeepalivedHAProxy with the API server port",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 6443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+				},
+			},
+			expectedErr: fmt.Errorf(
+				"control plane virtual IP port %d must be different from the API server bind port %d with the %q provider",
+				6443,
+				6443,
+				"KeepalivedHAProxy",
+			),
+		},
+		{
+			name: "KeepalivedHAProxy with the default API server port and a custom API server bind port",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 6443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+				},
+			},
+			kcpTemplate: kubeadmControlPlaneTemplateWithBindPorts(7443, 7443),
+			expectedErr: nil,
+		},
+		{
+			name: "KeepalivedHAProxy with a custom API server bind port",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 7443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+				},
+			},
+			kcpTemplate: kubeadmControlPlaneTemplateWithBindPorts(7443, 7443),
+			expectedErr: fmt.Errorf(
+				"control plane virtual IP port %d must be different from the API server bind port %d with the %q provider",
+				7443,
+				7443,
+				"KeepalivedHAProxy",
+			),
+		},
+		{
+			name: "KeepalivedHAProxy with different API server bind ports for joining machines",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 8443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+				},
+			},
+			kcpTemplate: kubeadmControlPlaneTemplateWithBindPorts(7443, 0),
+			expectedErr: fmt.Errorf(
+				"API server bind port %d of the InitConfiguration must be the same as the bind port %d of the "+
+					"JoinConfiguration",
+				7443,
+				6443,
+			),
+		},
+		{
+			name: "KeepalivedHAProxy with an invalid virtual router ID",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "192.168.1.2",
+				Port: 8443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider:          v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+					KeepalivedHAProxy: &v1alpha1.KeepalivedHAProxyConfiguration{VirtualRouterID: 256},
+				},
+			},
+			expectedErr: fmt.Errorf(
+				"control plane virtual IP virtualRouterID %d must be between 1 and 255 with the %q provider",
+				256,
+				"KeepalivedHAProxy",
+			),
+		},
+		{
+			name: "KeepalivedHAProxy with a hostname",
+			controlPlaneEndpointSpec: v1alpha1.ControlPlaneEndpointSpec{
+				Host: "cluster.example.com",
+				Port: 8443,
+				VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+					Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+				},
+			},
+			expectedErr: fmt.Errorf(
+				"control plane virtual IP %q must be an IP address with the %q provider",
+				"cluster.example.com",
+				"KeepalivedHAProxy",
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateControlPlaneVirtualIPProvider(tt.controlPlaneEndpointSpec, tt.kcpTemplate)
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKubeadmControlPlaneTemplate(t *testing.T) {
+	keepalivedHAProxy := v1alpha1.ControlPlaneEndpointSpec{
+		Host: "192.168.1.2",
+		Port: 6443,
+		VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+			Provider: v1alpha1.VirtualIPProviderKeepalivedHAProxy,
+		},
+	}
+	kubeVIP := v1alpha1.ControlPlaneEndpointSpec{
+		Host: "192.168.1.2",
+		Port: 6443,
+		VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+			Provider: v1alpha1.VirtualIPProviderKubeVIP,
+		},
+	}
+
+	tests := []struct {
+		name                     string
+		controlPlaneEndpointSpec v1alpha1.ControlPlaneEndpointSpec
+		withoutClusterClass      bool
+		expectTemplate           bool
+	}{
+		{
+			name:                     "KubeadmControlPlaneTemplate of the ClusterClass",
+			controlPlaneEndpointSpec: keepalivedHAProxy,
+			expectTemplate:           true,
+		},
+		{
+			name:                     "ClusterClass does not exist",
+			controlPlaneEndpointSpec: keepalivedHAProxy,
+			withoutClusterClass:      true,
+		},
+		{
+			name:                     "KubeadmControlPlaneTemplate not read for other providers",
+			controlPlaneEndpointSpec: kubeVIP,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			assert.NoError(t, clusterv1beta2.AddToScheme(scheme))
+			assert.NoError(t, controlplanev1.AddToScheme(scheme))
+
+			kcpTemplate := kubeadmControlPlaneTemplateWithBindPorts(7443, 7443)
+			clusterClass := &clusterv1beta2.ClusterClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-class", Namespace: kcpTemplate.Namespace},
+				Spec: clusterv1beta2.ClusterClassSpec{
+					ControlPlane: clusterv1beta2.ControlPlaneClass{
+						TemplateRef: clusterv1beta2.ClusterClassTemplateReference{
+							APIVersion: controlplanev1.GroupVersion.String(),
+							Kind:       "KubeadmControlPlaneTemplate",
+							Name:       kcpTemplate.Name,
+						},
+					},
+				},
+			}
+			clientBuilder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kcpTemplate)
+			if !tt.withoutClusterClass {
+				clientBuilder = clientBuilder.WithObjects(clusterClass)
+			}
+
+			cluster := &clusterv1beta2.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: kcpTemplate.Namespace},
+				Spec: clusterv1beta2.ClusterSpec{
+					Topology: clusterv1beta2.Topology{
+						ClassRef: clusterv1beta2.ClusterClassRef{Name: clusterClass.Name},
+						Version:  "v1.33.0",
+					},
+				},
+			}
+
+			validator := NewNutanixValidator(clientBuilder.Build(), admission.NewDecoder(scheme))
+			got, err := validator.kubeadmControlPlaneTemplate(
+				context.Background(),
+				cluster,
+				tt.controlPlaneEndpointSpec,
+			)
+			assert.NoError(t, err)
+			if !tt.expectTemplate {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				assert.Equal(t, kcpTemplate.Spec, got.Spec)
+			}
+		})
+	}
+}
+
+func kubeadmControlPlaneTemplateWithBindPorts(
+	initBindPort, joinBindPort int32,
+) *controlplanev1.KubeadmControlPlaneTemplate {
+	kcpTemplate := &controlplanev1.KubeadmControlPlaneTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-kcp-template", Namespace: "test-namespace"},
+	}
+	kubeadmConfigSpec := &kcpTemplate.Spec.Template.Spec.KubeadmConfigSpec
+	kubeadmConfigSpec.InitConfiguration.LocalAPIEndpoint.BindPort = initBindPort
+	kubeadmConfigSpec.JoinConfiguration.ControlPlane = &bootstrapv1.JoinControlPlane{
+		LocalAPIEndpoint: bootstrapv1.APIEndpoint{BindPort: joinBindPort},
+	}
+	return kcpTemplate
+}
+
 func TestValidateTopologyFailureDomainConfig(t *testing.T) {
 	testcases := []struct {
 		name                string
@@ -0,0 +1,266 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+
+	v1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/helpers"
+)
+
+type serviceLoadBalancerValidator struct {
+	client  ctrlclient.Client
+	decoder admission.Decoder
+}
+
+func NewServiceLoadBalancerValidator(
+	client ctrlclient.Client, decoder admission.Decoder,
+) *serviceLoadBalancerValidator {
+	return &serviceLoadBalancerValidator{
+		client:  client,
+		decoder: decoder,
+	}
+}
+
+func (s *serviceLoadBalancerValidator) Validator() admission.HandlerFunc {
+	return s.validate
+}
+
+func (s *serviceLoadBalancerValidator) validate(
+	ctx context.Context,
+	req admission.Request,
+) admission.Response {
+	if req.Operation == v1.Delete {
+		return admission.Allowed("")
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := s.decoder.Decode(req, cluster); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !cluster.Spec.Topology.IsDefined() {
+		return admission.Allowed("")
+	}
+
+	clusterConfig, err := variables.UnmarshalClusterConfigVariable(cluster.Spec.Topology.Variables)
+	if err != nil {
+		return admission.Denied(
+			fmt.Errorf("failed to unmarshal cluster topology variable %q: %w",
+				v1alpha1.ClusterConfigVariableName,
+				err).Error(),
+		)
+	}
+
+	if clusterConfig == nil ||
+		clusterConfig.Addons == nil ||
+		clusterConfig.Addons.ServiceLoadBalancer == nil ||
+		clusterConfig.Addons.ServiceLoadBalancer.Configuration == nil {
+		return admission.Allowed("")
+	}
+
+	// On update, only the address ranges that changed are validated, so that a change to the cluster network, or a
+	// range that was allowed before, does not block unrelated updates.
+	var unchanged sets.Set[v1alpha1.AddressRange]
+	if req.Operation == v1.Update {
+		oldCluster := &clusterv1.Cluster{}
+		if err := s.decoder.DecodeRaw(req.OldObject, oldCluster); err != nil {
+			return admission.Errored(
+				http.StatusBadRequest,
+				fmt.Errorf("failed to decode old cluster: %w", err),
+			)
+		}
+		unchanged = serviceLoadBalancerAddressRanges(oldCluster)
+	}
+
+	if errs := validateServiceLoadBalancerAddressRanges(
+		clusterConfig.Addons.ServiceLoadBalancer.Configuration,
+		reservedIPRanges(cluster, clusterConfig),
+		unchanged,
+		field.NewPath(
+			v1alpha1.ClusterConfigVariableName,
+			"addons",
+			"serviceLoadBalancer",
+			"configuration",
+		),
+	); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("")
+}
+
+// serviceLoadBalancerAddressRanges returns the address ranges of the ServiceLoadBalancer configuration of the
+// cluster. A configuration that cannot be read has no address ranges.
+func serviceLoadBalancerAddressRanges(cluster *clusterv1.Cluster) sets.Set[v1alpha1.AddressRange] {
+	addressRanges := sets.New[v1alpha1.AddressRange]()
+	if !cluster.Spec.Topology.IsDefined() {
+		return addressRanges
+	}
+	clusterConfig, err := variables.UnmarshalClusterConfigVariable(cluster.Spec.Topology.Variables)
+	if err != nil ||
+		clusterConfig == nil ||
+		clusterConfig.Addons == nil ||
+		clusterConfig.Addons.ServiceLoadBalancer == nil ||
+		clusterConfig.Addons.ServiceLoadBalancer.Configuration == nil {
+		return addressRanges
+	}
+
+	configuration := clusterConfig.Addons.ServiceLoadBalancer.Configuration
+	addressRanges.Insert(configuration.AddressRanges...)
+	for _, pool := range configuration.AddressPools {
+		addressRanges.Insert(pool.AddressRanges...)
+	}
+	return addressRanges
+}
+
+// reservedIPRange is a range of IPs used by the cluster, that the ServiceLoadBalancer must not use.
+type reservedIPRange struct {
+	description string
+	ipRange     helpers.IPRange
+}
+
+// reservedIPRanges returns the pod and service CIDRs, and the control plane endpoint IPs, of the cluster.
+// Hostnames and invalid CIDRs are ignored.
+func reservedIPRanges(
+	cluster *clusterv1.Cluster,
+	clusterConfig *variables.ClusterConfigSpec,
+) []reservedIPRange {
+	var reserved []reservedIPRange
+
+	for _, cidr := range cluster.Spec.ClusterNetwork.Pods.CIDRBlocks {
+		if ipRange, err := helpers.ParseCIDRRange(cidr); err == nil {
+			reserved = append(reserved, reservedIPRange{
+				description: fmt.Sprintf("pod CIDR %q", cidr),
+				ipRange:     ipRange,
+			})
+		}
+	}
+	for _, cidr := range cluster.Spec.ClusterNetwork.Services.CIDRBlocks {
+		if ipRange, err := helpers.ParseCIDRRange(cidr); err == nil {
+			reserved = append(reserved, reservedIPRange{
+				description: fmt.Sprintf("service CIDR %q", cidr),
+				ipRange:     ipRange,
+			})
+		}
+	}
+
+	controlPlaneEndpointHosts := []string{cluster.Spec.ControlPlaneEndpoint.Host}
+	if clusterConfig.Nutanix != nil {
+		controlPlaneEndpointHosts = append(
+			controlPlaneEndpointHosts,
+			clusterConfig.Nutanix.ControlPlaneEndpoint.Host,
+			clusterConfig.Nutanix.ControlPlaneEndpoint.VirtualIPAddress(),
+		)
+	}
+	seen := map[netip.Addr]struct{}{}
+	for _, host := range controlPlaneEndpointHosts {
+		ip, err := netip.ParseAddr(host)
+		if err != nil {
+			continue
+		}
+		ip = ip.Unmap()
+		if _, ok := seen[ip]; ok {
+			continue
+		}
+		seen[ip] = struct{}{}
+		reserved = append(reserved, reservedIPRange{
+			description: fmt.Sprintf("control plane endpoint IP %q", ip),
+			ipRange:     helpers.IPRange{Start: ip, End: ip},
+		})
+	}
+
+	return reserved
+}
+
+// validateServiceLoadBalancerAddressRanges checks that every address range of the ServiceLoadBalancer is valid,
+// does not overlap another address range, and does not overlap a range reserved by the cluster. The unchanged address
+// ranges are not checked, but the changed address ranges must not overlap them.
+func validateServiceLoadBalancerAddressRanges(
+	configuration *v1alpha1.ServiceLoadBalancerConfiguration,
+	reserved []reservedIPRange,
+	unchanged sets.Set[v1alpha1.AddressRange],
+	fldPath *field.Path,
+) field.ErrorList {
+	type addressRangeEntry struct {
+		path         *field.Path
+		addressRange v1alpha1.AddressRange
+	}
+
+	var entries []addressRangeEntry
+	for i, addressRange := range configuration.AddressRanges {
+		entries = append(entries, addressRangeEntry{
+			path:         fldPath.Child("addressRanges").Index(i),
+			addressRange: addressRange,
+		})
+	}
+	for _, pool := range configuration.AddressPools {
+		for i, addressRange := range pool.AddressRanges {
+			entries = append(entries, addressRangeEntry{
+				path:         fldPath.Child("addressPools").Key(pool.Name).Child("addressRanges").Index(i),
+				addressRange: addressRange,
+			})
+		}
+	}
+
+	fldErrs := field.ErrorList{}
+	ipRanges := make([]helpers.IPRange, len(entries))
+	valid := make([]bool, len(entries))
+	changed := make([]bool, len(entries))
+	for i, entry := range entries {
+		changed[i] = !unchanged.Has(entry.addressRange)
+		ipRange, err := helpers.ParseAddressRange(entry.addressRange)
+		if err != nil {
+			if changed[i] {
+				fldErrs = append(fldErrs, field.Invalid(entry.path, entry.addressRange.String(), err.Error()))
+			}
+			continue
+		}
+		ipRanges[i], valid[i] = ipRange, true
+
+		for j := range i {
+			if !changed[i] && !changed[j] {
+				continue
+			}
+			if valid[j] && ipRanges[j].Overlaps(ipRange) {
+				fldErrs = append(fldErrs, field.Invalid(
+					entry.path,
+					entry.addressRange.String(),
+					fmt.Sprintf(
+						"must not overlap address range %q at %s",
+						entries[j].addressRange.String(),
+						entries[j].path,
+					),
+				))
+			}
+		}
+
+		if !changed[i] {
+			continue
+		}
+		for _, r := range reserved {
+			if r.ipRange.Overlaps(ipRange) {
+				fldErrs = append(fldErrs, field.Invalid(
+					entry.path,
+					entry.addressRange.String(),
+					fmt.Sprintf("must not overlap the %s", r.description),
+				))
+			}
+		}
+	}
+
+	return fldErrs
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+func TestServiceLoadBalancerValidator(t *testing.T) {
+	testCases := []struct {
+		name          string
+		configuration *v1alpha1.ServiceLoadBalancerConfiguration
+		// oldConfiguration is the configuration of the old cluster of an update, if set.
+		oldConfiguration *v1alpha1.ServiceLoadBalancerConfiguration
+		nutanix          *v1alpha1.NutanixSpec
+		expectAllowed    bool
+		expectMessage    string
+	}{
+		{
+			name:          "allows clusters without a service load balancer configuration",
+			expectAllowed: true,
+		},
+		{
+			name: "allows IPv4 and IPv6 address ranges and CIDRs",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{
+					{Start: "10.100.1.1", End: "10.100.1.20"},
+					{CIDR: "10.100.2.0/24"},
+					{Start: "fd00:100::1", End: "fd00:100::20"},
+					{CIDR: "fd00:200::/120"},
+				},
+			},
+			expectAllowed: true,
+		},
+		{
+			name: "rejects a start IP after the end IP",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{
+					{Start: "10.100.1.20", End: "10.100.1.1"},
+				},
+			},
+			expectAllowed: false,
+			expectMessage: `clusterConfig.addons.serviceLoadBalancer.configuration.addressRanges[0]: ` +
+				`Invalid value: "10.100.1.20-10.100.1.1": ` +
+				`start IP "10.100.1.20" must not be after end IP "10.100.1.1"`,
+		},
+		{
+			name: "rejects start and end IPs of different families",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{
+					{Start: "10.100.1.1", End: "fd00:100::20"},
+				},
+			},
+			expectAllowed: false,
+			expectMessage: "must be of the same family",
+		},
+		{
+			name: "rejects overlapping address ranges in different address pools",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressPools: []v1alpha1.ServiceLoadBalancerAddressPool{
+					{
+						Name:          "l2",
+						AddressRanges: []v1alpha1.AddressRange{{CIDR: "10.100.1.0/24"}},
+					},
+					{
+						Name:          "other",
+						AddressRanges: []v1alpha1.AddressRange{{Start: "10.100.1.200", End: "10.100.2.10"}},
+					},
+				},
+			},
+			expectAllowed: false,
+			expectMessage: `clusterConfig.addons.serviceLoadBalancer.configuration.addressPools[other].addressRanges[0]: ` +
+				`Invalid value: "10.100.1.200-10.100.2.10": must not overlap address range "10.100.1.0/24" at ` +
+				`clusterConfig.addons.serviceLoadBalancer.configuration.addressPools[l2].addressRanges[0]`,
+		},
+		{
+			name: "rejects an address range overlapping the pod CIDR",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{CIDR: "192.168.0.0/24"}},
+			},
+			expectAllowed: false,
+			expectMessage: `must not overlap the pod CIDR "192.168.0.0/16"`,
+		},
+		{
+			name: "rejects an address range overlapping the service CIDR",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{Start: "fd00:96::1", End: "fd00:96::10"}},
+			},
+			expectAllowed: false,
+			expectMessage: `must not overlap the service CIDR "fd00:96::/108"`,
+		},
+		{
+			name: "rejects an address range including the control plane endpoint IP",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{Start: "10.100.1.1", End: "10.100.1.20"}},
+			},
+			nutanix: &v1alpha1.NutanixSpec{
+				ControlPlaneEndpoint: v1alpha1.ControlPlaneEndpointSpec{
+					Host: "10.100.1.10",
+					Port: 6443,
+				},
+			},
+			expectAllowed: false,
+			expectMessage: `must not overlap the control plane endpoint IP "10.100.1.10"`,
+		},
+		{
+			name: "rejects an address range including the control plane virtual IP",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{CIDR: "10.100.1.0/28"}},
+			},
+			nutanix: &v1alpha1.NutanixSpec{
+				ControlPlaneEndpoint: v1alpha1.ControlPlaneEndpointSpec{
+					Host: "10.200.1.10",
+					Port: 6443,
+					VirtualIPSpec: &v1alpha1.ControlPlaneVirtualIPSpec{
+						Configuration: &v1alpha1.ControlPlaneVirtualIPConfiguration{
+							Address: "10.100.1.10",
+						},
+					},
+				},
+			},
+			expectAllowed: false,
+			expectMessage: `must not overlap the control plane endpoint IP "10.100.1.10"`,
+		},
+		{
+			name: "allows an unchanged address range overlapping the pod CIDR on update",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{CIDR: "192.168.0.0/24"}, {CIDR: "10.100.1.0/24"}},
+			},
+			oldConfiguration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{CIDR: "192.168.0.0/24"}},
+			},
+			expectAllowed: true,
+		},
+		{
+			name: "rejects a changed address range overlapping the pod CIDR on update",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{CIDR: "192.168.0.0/24"}},
+			},
+			oldConfiguration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{CIDR: "10.100.1.0/24"}},
+			},
+			expectAllowed: false,
+			expectMessage: `must not overlap the pod CIDR "192.168.0.0/16"`,
+		},
+		{
+			name: "rejects a changed address range overlapping an unchanged address range on update",
+			configuration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{
+					{CIDR: "10.100.1.0/24"},
+					{Start: "10.100.1.200", End: "10.100.2.10"},
+				},
+			},
+			oldConfiguration: &v1alpha1.ServiceLoadBalancerConfiguration{
+				AddressRanges: []v1alpha1.AddressRange{{CIDR: "10.100.1.0/24"}},
+			},
+			expectAllowed: false,
+			expectMessage: `must not overlap address range "10.100.1.0/24"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1beta2.AddToScheme(scheme)).To(Succeed())
+			validator := NewServiceLoadBalancerValidator(
+				fake.NewClientBuilder().WithScheme(scheme).Build(),
+				admission.NewDecoder(scheme),
+			)
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: createServiceLoadBalancerClusterRaw(g, tc.configuration, tc.nutanix),
+					},
+				},
+			}
+			if tc.oldConfiguration != nil {
+				req.Operation = admissionv1.Update
+				req.OldObject = runtime.RawExtension{
+					Raw: createServiceLoadBalancerClusterRaw(g, tc.oldConfiguration, tc.nutanix),
+				}
+			}
+
+			resp := validator.validate(context.Background(), req)
+			g.Expect(resp.Allowed).To(Equal(tc.expectAllowed), resp.Result.Message)
+			if tc.expectMessage != "" {
+				g.Expect(resp.Result.Message).To(ContainSubstring(tc.expectMessage))
+			}
+		})
+	}
+}
+
+func createServiceLoadBalancerClusterRaw(
+	g Gomega,
+	configuration *v1alpha1.ServiceLoadBalancerConfiguration,
+	nutanix *v1alpha1.NutanixSpec,
+) []byte {
+	clusterConfig := &variables.ClusterConfigSpec{
+		Nutanix: nutanix,
+		Addons: &variables.Addons{
+			GenericAddons: v1alpha1.GenericAddons{
+				ServiceLoadBalancer: &v1alpha1.ServiceLoadBalancer{
+					Provider:      v1alpha1.ServiceLoadBalancerProviderMetalLB,
+					Configuration: configuration,
+				},
+			},
+		},
+	}
+	clusterConfigRaw, err := json.Marshal(clusterConfig)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cluster := &clusterv1beta2.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1beta2.GroupVersion.String(),
+			Kind:       "Cluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "test-namespace",
+		},
+		Spec: clusterv1beta2.ClusterSpec{
+			ClusterNetwork: clusterv1beta2.ClusterNetwork{
+				Pods: clusterv1beta2.NetworkRanges{
+					CIDRBlocks: []string{"192.168.0.0/16", "fd00:192::/56"},
+				},
+				Services: clusterv1beta2.NetworkRanges{
+					CIDRBlocks: []string{"172.30.0.0/16", "fd00:96::/108"},
+				},
+			},
+			Topology: clusterv1beta2.Topology{
+				ClassRef: clusterv1beta2.ClusterClassRef{
+					Name: "test-class",
+				},
+				Version: "v1.30.0",
+				Variables: []clusterv1beta2.ClusterVariable{{
+					Name:  v1alpha1.ClusterConfigVariableName,
+					Value: apiextensionsv1.JSON{Raw: clusterConfigRaw},
+				}},
+			},
+		},
+	}
+	clusterRaw, err := json.Marshal(cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	return clusterRaw
+}
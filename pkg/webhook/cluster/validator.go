@@ -14,5 +14,9 @@ func NewValidator(client ctrlclient.Client, decoder admission.Decoder) admission
 		NewNutanixValidator(client, decoder).Validator(),
 		NewAdvancedCiliumConfigurationValidator(client, decoder).Validator(),
 		NewKubeletConfigurationValidator(client, decoder).Validator(),
+		NewEncryptionAtRestValidator(client, decoder).Validator(),
+		NewServiceLoadBalancerValidator(client, decoder).Validator(),
+		NewHelmChartOverridesValidator(client, decoder).Validator(),
+		NewAddonValuesValidator(client, decoder).Validator(),
 	)
 }
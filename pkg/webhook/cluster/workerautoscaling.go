@@ -0,0 +1,131 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	v1 "k8s.io/api/admission/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+// autoscalingAnnotationsSetAnnotationKey marks the MachineDeployment topologies whose cluster-autoscaler min and max
+// size annotations were set from the autoscaling limits of their workerConfig, so that the annotations are removed
+// when the limits are removed, and annotations set by the user are kept.
+const autoscalingAnnotationsSetAnnotationKey = v1alpha1.APIGroup + "/worker-autoscaling-annotations"
+
+// workerAutoscaling sets the cluster-autoscaler min and max size annotations of the MachineDeployment topologies from
+// the autoscaling limits of their workerConfig. MachineDeployment annotations cannot be set by topology patches, so
+// they are set on the Cluster instead.
+type workerAutoscaling struct {
+	client  ctrlclient.Client
+	decoder admission.Decoder
+}
+
+func NewWorkerAutoscaling(
+	client ctrlclient.Client, decoder admission.Decoder,
+) *workerAutoscaling {
+	return &workerAutoscaling{
+		client:  client,
+		decoder: decoder,
+	}
+}
+
+func (w *workerAutoscaling) Defaulter() admission.HandlerFunc {
+	return w.defaulter
+}
+
+func (w *workerAutoscaling) defaulter(
+	ctx context.Context,
+	req admission.Request,
+) admission.Response {
+	if req.Operation == v1.Delete {
+		return admission.Allowed("")
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := w.decoder.Decode(req, cluster); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !cluster.Spec.Topology.IsDefined() {
+		return admission.Allowed("")
+	}
+
+	if err := setAutoscalingAnnotations(cluster); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	marshaledCluster, err := json.Marshal(cluster)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledCluster)
+}
+
+// setAutoscalingAnnotations sets the cluster-autoscaler min and max size annotations of each MachineDeployment
+// topology from the autoscaling limits of its workerConfig override, or of the cluster workerConfig if it has no
+// override. The annotations are removed if they were set from limits that are no longer set.
+func setAutoscalingAnnotations(cluster *clusterv1.Cluster) error {
+	defaultWorkerConfig, err := variables.UnmarshalWorkerConfigVariable(
+		cluster.Spec.Topology.Variables,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to unmarshal cluster topology variable %q: %w",
+			v1alpha1.WorkerConfigVariableName,
+			err,
+		)
+	}
+
+	for i := range cluster.Spec.Topology.Workers.MachineDeployments {
+		md := &cluster.Spec.Topology.Workers.MachineDeployments[i]
+
+		workerConfig, err := variables.UnmarshalWorkerConfigVariable(md.Variables.Overrides)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to unmarshal worker overrides variable %q for machineDeployment %q: %w",
+				v1alpha1.WorkerConfigVariableName,
+				md.Name,
+				err,
+			)
+		}
+		if workerConfig == nil {
+			workerConfig = defaultWorkerConfig
+		}
+
+		// If no limits are set, only remove the annotations set from previous limits. Otherwise, leave them
+		// unchanged, because they can still be set on the MachineDeployment topology directly.
+		if workerConfig == nil || workerConfig.Autoscaling == nil {
+			if _, ok := md.Metadata.Annotations[autoscalingAnnotationsSetAnnotationKey]; ok {
+				delete(md.Metadata.Annotations, clusterv1.AutoscalerMinSizeAnnotation)
+				delete(md.Metadata.Annotations, clusterv1.AutoscalerMaxSizeAnnotation)
+				delete(md.Metadata.Annotations, autoscalingAnnotationsSetAnnotationKey)
+			}
+			continue
+		}
+
+		if md.Metadata.Annotations == nil {
+			md.Metadata.Annotations = make(map[string]string, 3)
+		}
+		md.Metadata.Annotations[autoscalingAnnotationsSetAnnotationKey] = ""
+		md.Metadata.Annotations[clusterv1.AutoscalerMinSizeAnnotation] = strconv.Itoa(
+			int(workerConfig.Autoscaling.MinSize),
+		)
+		md.Metadata.Annotations[clusterv1.AutoscalerMaxSizeAnnotation] = strconv.Itoa(
+			int(workerConfig.Autoscaling.MaxSize),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+)
+
+func TestSetAutoscalingAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1beta2.Cluster{
+		Spec: clusterv1beta2.ClusterSpec{
+			Topology: clusterv1beta2.Topology{
+				ClassRef: clusterv1beta2.ClusterClassRef{Name: "test-class"},
+				Version:  "v1.33.0",
+				Variables: []clusterv1beta2.ClusterVariable{
+					workerConfigVariable(g, &v1alpha1.WorkerAutoscaling{MinSize: 1, MaxSize: 3}),
+				},
+				Workers: clusterv1beta2.WorkersTopology{
+					MachineDeployments: []clusterv1beta2.MachineDeploymentTopology{
+						{
+							Name: "md-default",
+							Metadata: clusterv1beta2.ObjectMeta{
+								Annotations: map[string]string{"other": ""},
+							},
+						},
+						{
+							Name: "md-override",
+							Variables: clusterv1beta2.MachineDeploymentVariables{
+								Overrides: []clusterv1beta2.ClusterVariable{
+									workerConfigVariable(g, &v1alpha1.WorkerAutoscaling{MinSize: 0, MaxSize: 10}),
+								},
+							},
+						},
+						{
+							Name: "md-override-without-autoscaling",
+							Variables: clusterv1beta2.MachineDeploymentVariables{
+								Overrides: []clusterv1beta2.ClusterVariable{
+									workerConfigVariable(g, nil),
+								},
+							},
+							Metadata: clusterv1beta2.ObjectMeta{
+								Annotations: map[string]string{
+									clusterv1beta2.AutoscalerMinSizeAnnotation: "2",
+									clusterv1beta2.AutoscalerMaxSizeAnnotation: "4",
+								},
+							},
+						},
+						{
+							Name: "md-autoscaling-removed",
+							Variables: clusterv1beta2.MachineDeploymentVariables{
+								Overrides: []clusterv1beta2.ClusterVariable{
+									workerConfigVariable(g, nil),
+								},
+							},
+							Metadata: clusterv1beta2.ObjectMeta{
+								Annotations: map[string]string{
+									"other": "",
+									clusterv1beta2.AutoscalerMinSizeAnnotation: "2",
+									clusterv1beta2.AutoscalerMaxSizeAnnotation: "4",
+									autoscalingAnnotationsSetAnnotationKey:     "",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g.Expect(setAutoscalingAnnotations(cluster)).To(Succeed())
+
+	mds := cluster.Spec.Topology.Workers.MachineDeployments
+	g.Expect(mds[0].Metadata.Annotations).To(Equal(map[string]string{
+		"other": "",
+		clusterv1beta2.AutoscalerMinSizeAnnotation: "1",
+		clusterv1beta2.AutoscalerMaxSizeAnnotation: "3",
+		autoscalingAnnotationsSetAnnotationKey:     "",
+	}))
+	g.Expect(mds[1].Metadata.Annotations).To(Equal(map[string]string{
+		clusterv1beta2.AutoscalerMinSizeAnnotation: "0",
+		clusterv1beta2.AutoscalerMaxSizeAnnotation: "10",
+		autoscalingAnnotationsSetAnnotationKey:     "",
+	}))
+	g.Expect(mds[2].Metadata.Annotations).To(Equal(map[string]string{
+		clusterv1beta2.AutoscalerMinSizeAnnotation: "2",
+		clusterv1beta2.AutoscalerMaxSizeAnnotation: "4",
+	}))
+	g.Expect(mds[3].Metadata.Annotations).To(Equal(map[string]string{
+		"other": "",
+	}))
+}
+
+func TestWorkerAutoscalingDefaulter(t *testing.T) {
+	testCases := []struct {
+		name          string
+		autoscaling   *v1alpha1.WorkerAutoscaling
+		expectPatched bool
+	}{
+		{
+			name: "does not patch clusters without autoscaling limits",
+		},
+		{
+			name:          "patches clusters with autoscaling limits",
+			autoscaling:   &v1alpha1.WorkerAutoscaling{MinSize: 1, MaxSize: 3},
+			expectPatched: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1beta2.AddToScheme(scheme)).To(Succeed())
+			defaulter := NewWorkerAutoscaling(
+				fake.NewClientBuilder().WithScheme(scheme).Build(),
+				admission.NewDecoder(scheme),
+			)
+
+			cluster := &clusterv1beta2.Cluster{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: clusterv1beta2.GroupVersion.String(),
+					Kind:       "Cluster",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "test-namespace",
+				},
+				Spec: clusterv1beta2.ClusterSpec{
+					Topology: clusterv1beta2.Topology{
+						ClassRef: clusterv1beta2.ClusterClassRef{Name: "test-class"},
+						Version:  "v1.33.0",
+						Variables: []clusterv1beta2.ClusterVariable{
+							workerConfigVariable(g, tc.autoscaling),
+						},
+						Workers: clusterv1beta2.WorkersTopology{
+							MachineDeployments: []clusterv1beta2.MachineDeploymentTopology{{
+								Name: "md-0",
+							}},
+						},
+					},
+				},
+			}
+			clusterRaw, err := json.Marshal(cluster)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			resp := defaulter.defaulter(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object: runtime.RawExtension{
+						Raw: clusterRaw,
+					},
+				},
+			})
+			g.Expect(resp.Allowed).To(BeTrue())
+			if tc.expectPatched {
+				g.Expect(resp.Patches).To(HaveLen(1))
+				g.Expect(resp.Patches[0].Path).To(Equal("/spec/topology/workers/machineDeployments/0/metadata"))
+			} else {
+				g.Expect(resp.Patches).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func workerConfigVariable(g Gomega, autoscaling *v1alpha1.WorkerAutoscaling) clusterv1beta2.ClusterVariable {
+	workerConfig := &variables.WorkerNodeConfigSpec{
+		GenericWorkerNodeSpec: v1alpha1.GenericWorkerNodeSpec{
+			Autoscaling: autoscaling,
+		},
+	}
+	workerConfigRaw, err := json.Marshal(workerConfig)
+	g.Expect(err).NotTo(HaveOccurred())
+	return clusterv1beta2.ClusterVariable{
+		Name:  v1alpha1.WorkerConfigVariableName,
+		Value: apiextensionsv1.JSON{Raw: workerConfigRaw},
+	}
+}
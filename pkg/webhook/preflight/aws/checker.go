@@ -0,0 +1,149 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/go-logr/logr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+var Checker = &awsChecker{
+	configurationCheckFactory:    newConfigurationCheck,
+	credentialsCheckFactory:      newCredentialsCheck,
+	regionCheckFactory:           newRegionCheck,
+	networkChecksFactory:         newNetworkChecks,
+	machineImageChecksFactory:    newMachineImageChecks,
+	instanceTypeChecksFactory:    newInstanceTypeChecks,
+	instanceProfileChecksFactory: newInstanceProfileChecks,
+	placementGroupChecksFactory:  newPlacementGroupChecks,
+}
+
+type awsChecker struct {
+	configurationCheckFactory func(
+		cd *checkDependencies,
+	) preflight.Check
+
+	credentialsCheckFactory func(
+		ctx context.Context,
+		awsClientFactory func(ctx context.Context, region string) (client, error),
+		cd *checkDependencies,
+	) preflight.Check
+
+	regionCheckFactory func(
+		cd *checkDependencies,
+	) preflight.Check
+
+	networkChecksFactory func(
+		cd *checkDependencies,
+	) []preflight.Check
+
+	machineImageChecksFactory func(
+		cd *checkDependencies,
+	) []preflight.Check
+
+	instanceTypeChecksFactory func(
+		cd *checkDependencies,
+	) []preflight.Check
+
+	instanceProfileChecksFactory func(
+		cd *checkDependencies,
+	) []preflight.Check
+
+	placementGroupChecksFactory func(
+		cd *checkDependencies,
+	) []preflight.Check
+}
+
+// machineSpec is the AWS configuration of the control plane, or of one MachineDeployment.
+type machineSpec struct {
+	iamInstanceProfile string
+	instanceType       string
+	generic            *carenv1.AWSGenericNodeSpec
+
+	// field is the path to the AWS configuration of the machines.
+	field string
+}
+
+type checkDependencies struct {
+	kclient    ctrlclient.Client
+	cluster    *clusterv1.Cluster
+	oldCluster *clusterv1.Cluster
+
+	// region, network and identityRef are shared by the AWS and EKS cluster configurations.
+	// infrastructureField is the path to the one used by the cluster.
+	region              *carenv1.Region
+	network             *carenv1.AWSNetwork
+	identityRef         bool
+	infrastructureField string
+
+	controlPlaneMachineSpec                  *machineSpec
+	workerMachineSpecByMachineDeploymentName map[string]*machineSpec
+
+	awsClient client
+	// resultCacheKey identifies the AWS client, and is set with it.
+	resultCacheKey string
+	log            logr.Logger
+}
+
+// hasAWSConfiguration returns true if the cluster has any AWS or EKS configuration.
+func (cd *checkDependencies) hasAWSConfiguration() bool {
+	return cd.infrastructureField != "" ||
+		cd.controlPlaneMachineSpec != nil ||
+		len(cd.workerMachineSpecByMachineDeploymentName) > 0
+}
+
+// machineSpecs returns the control plane and worker machine specs.
+func (cd *checkDependencies) machineSpecs() []*machineSpec {
+	specs := []*machineSpec{}
+	if cd.controlPlaneMachineSpec != nil {
+		specs = append(specs, cd.controlPlaneMachineSpec)
+	}
+	for _, mdName := range slices.Sorted(maps.Keys(cd.workerMachineSpecByMachineDeploymentName)) {
+		specs = append(specs, cd.workerMachineSpecByMachineDeploymentName[mdName])
+	}
+	return specs
+}
+
+func (a *awsChecker) Init(
+	ctx context.Context,
+	kclient ctrlclient.Client,
+	cluster *clusterv1.Cluster,
+	oldCluster *clusterv1.Cluster,
+) []preflight.Check {
+	cd := &checkDependencies{
+		kclient:    kclient,
+		cluster:    cluster,
+		oldCluster: oldCluster,
+		log:        ctrl.LoggerFrom(ctx).WithName("preflight/aws"),
+	}
+
+	checks := []preflight.Check{
+		// The configuration check must run first, because it initializes data used by all other checks,
+		// and the credentials check second, because it initializes the AWS client used by other checks.
+		// All other checks call the API of the region, so they depend on the region check.
+		a.configurationCheckFactory(cd),
+		a.credentialsCheckFactory(ctx, newClient, cd),
+		a.regionCheckFactory(cd),
+	}
+
+	checks = slices.Concat(
+		checks,
+		a.networkChecksFactory(cd),
+		a.machineImageChecksFactory(cd),
+		a.instanceTypeChecksFactory(cd),
+		a.instanceProfileChecksFactory(cd),
+		a.placementGroupChecksFactory(cd),
+	)
+
+	return checks
+}
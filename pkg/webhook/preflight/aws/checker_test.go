@@ -0,0 +1,161 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+type mockCheck struct {
+	name   string
+	result preflight.CheckResult
+}
+
+func (m *mockCheck) Name() string {
+	return m.name
+}
+
+func (m *mockCheck) Run(_ context.Context) preflight.CheckResult {
+	return m.result
+}
+
+// newTestCluster returns a topology Cluster with the given clusterConfig variable value, and a
+// MachineDeployment for each of the given workerConfig variable overrides.
+func newTestCluster(t *testing.T, clusterConfig any, workerConfigs map[string]any) *clusterv1.Cluster {
+	t.Helper()
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: clusterv1.ClusterSpec{
+			Topology: clusterv1.Topology{
+				Version: "v1.33.1",
+			},
+		},
+	}
+
+	if clusterConfig != nil {
+		cluster.Spec.Topology.Variables = append(cluster.Spec.Topology.Variables,
+			clusterv1.ClusterVariable{
+				Name:  carenv1.ClusterConfigVariableName,
+				Value: mustJSON(t, clusterConfig),
+			},
+		)
+	}
+
+	for name, workerConfig := range workerConfigs {
+		cluster.Spec.Topology.Workers.MachineDeployments = append(
+			cluster.Spec.Topology.Workers.MachineDeployments,
+			clusterv1.MachineDeploymentTopology{
+				Name: name,
+				Variables: clusterv1.MachineDeploymentVariables{
+					Overrides: []clusterv1.ClusterVariable{
+						{
+							Name:  carenv1.WorkerConfigVariableName,
+							Value: mustJSON(t, workerConfig),
+						},
+					},
+				},
+			},
+		)
+	}
+
+	return cluster
+}
+
+func mustJSON(t *testing.T, v any) apiextensionsv1.JSON {
+	t.Helper()
+
+	raw, err := json.Marshal(v)
+	require.NoError(t, err)
+	return apiextensionsv1.JSON{Raw: raw}
+}
+
+func TestAWSChecker_Init(t *testing.T) {
+	called := []string{}
+	newMockChecksFactory := func(name string) func(cd *checkDependencies) []preflight.Check {
+		return func(cd *checkDependencies) []preflight.Check {
+			called = append(called, name)
+			return []preflight.Check{&mockCheck{name: name}}
+		}
+	}
+
+	checker := &awsChecker{
+		configurationCheckFactory: func(cd *checkDependencies) preflight.Check {
+			called = append(called, "AWSConfiguration")
+			return &mockCheck{name: "AWSConfiguration"}
+		},
+		credentialsCheckFactory: func(
+			_ context.Context,
+			_ func(ctx context.Context, region string) (client, error),
+			cd *checkDependencies,
+		) preflight.Check {
+			called = append(called, "AWSCredentials")
+			return &mockCheck{name: "AWSCredentials"}
+		},
+		regionCheckFactory: func(cd *checkDependencies) preflight.Check {
+			called = append(called, "AWSRegion")
+			return &mockCheck{name: "AWSRegion"}
+		},
+		networkChecksFactory:         newMockChecksFactory("AWSNetwork"),
+		machineImageChecksFactory:    newMockChecksFactory("AWSMachineImage"),
+		instanceTypeChecksFactory:    newMockChecksFactory("AWSInstanceType"),
+		instanceProfileChecksFactory: newMockChecksFactory("AWSIAMInstanceProfile"),
+		placementGroupChecksFactory:  newMockChecksFactory("AWSPlacementGroup"),
+	}
+
+	checks := checker.Init(context.Background(), nil, newTestCluster(t, nil, nil), nil)
+
+	names := make([]string, 0, len(checks))
+	for _, check := range checks {
+		names = append(names, check.Name())
+	}
+
+	expected := []string{
+		"AWSConfiguration",
+		"AWSCredentials",
+		"AWSRegion",
+		"AWSNetwork",
+		"AWSMachineImage",
+		"AWSInstanceType",
+		"AWSIAMInstanceProfile",
+		"AWSPlacementGroup",
+	}
+	// The factories must be called in order, because they depend on data initialized by earlier factories.
+	assert.Equal(t, expected, called)
+	assert.Equal(t, expected, names)
+}
+
+func TestCheckDependencies_MachineSpecs(t *testing.T) {
+	cd := &checkDependencies{
+		controlPlaneMachineSpec: &machineSpec{field: "control-plane"},
+		workerMachineSpecByMachineDeploymentName: map[string]*machineSpec{
+			"md-b": {field: "md-b"},
+			"md-a": {field: "md-a"},
+		},
+		log: logr.Discard(),
+	}
+
+	fields := []string{}
+	for _, spec := range cd.machineSpecs() {
+		fields = append(fields, spec.field)
+	}
+
+	// The control plane comes first, then the MachineDeployments, ordered by name.
+	assert.Equal(t, []string{"control-plane", "md-a", "md-b"}, fields)
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/smithy-go"
+)
+
+// client contains the EC2 and IAM methods used by the checks. The method signatures match the
+// AWS SDK clients, so that the SDK clients can be used directly, and tests can use a fake.
+type client interface {
+	DescribeRegions(
+		ctx context.Context,
+		params *ec2.DescribeRegionsInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeRegionsOutput, error)
+
+	DescribeVpcs(
+		ctx context.Context,
+		params *ec2.DescribeVpcsInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeVpcsOutput, error)
+
+	DescribeSubnets(
+		ctx context.Context,
+		params *ec2.DescribeSubnetsInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeSubnetsOutput, error)
+
+	DescribeImages(
+		ctx context.Context,
+		params *ec2.DescribeImagesInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeImagesOutput, error)
+
+	DescribeInstanceTypeOfferings(
+		ctx context.Context,
+		params *ec2.DescribeInstanceTypeOfferingsInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribeInstanceTypeOfferingsOutput, error)
+
+	DescribePlacementGroups(
+		ctx context.Context,
+		params *ec2.DescribePlacementGroupsInput,
+		optFns ...func(*ec2.Options),
+	) (*ec2.DescribePlacementGroupsOutput, error)
+
+	GetInstanceProfile(
+		ctx context.Context,
+		params *iam.GetInstanceProfileInput,
+		optFns ...func(*iam.Options),
+	) (*iam.GetInstanceProfileOutput, error)
+}
+
+// clientWrapper implements the client interface using the AWS SDK clients.
+type clientWrapper struct {
+	ec2 *ec2.Client
+	iam *iam.Client
+}
+
+var _ client = &clientWrapper{}
+
+// newClient returns a client for the given region, using the default AWS credentials chain of the
+// extension, e.g. environment variables, or a web identity token. It returns an error if no
+// credentials can be found.
+func newClient(ctx context.Context, region string) (client, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+
+	if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+
+	return &clientWrapper{
+		ec2: ec2.NewFromConfig(cfg),
+		iam: iam.NewFromConfig(cfg),
+	}, nil
+}
+
+func (c *clientWrapper) DescribeRegions(
+	ctx context.Context,
+	params *ec2.DescribeRegionsInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DescribeRegionsOutput, error) {
+	return c.ec2.DescribeRegions(ctx, params, optFns...)
+}
+
+func (c *clientWrapper) DescribeVpcs(
+	ctx context.Context,
+	params *ec2.DescribeVpcsInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DescribeVpcsOutput, error) {
+	return c.ec2.DescribeVpcs(ctx, params, optFns...)
+}
+
+func (c *clientWrapper) DescribeSubnets(
+	ctx context.Context,
+	params *ec2.DescribeSubnetsInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DescribeSubnetsOutput, error) {
+	return c.ec2.DescribeSubnets(ctx, params, optFns...)
+}
+
+func (c *clientWrapper) DescribeImages(
+	ctx context.Context,
+	params *ec2.DescribeImagesInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DescribeImagesOutput, error) {
+	return c.ec2.DescribeImages(ctx, params, optFns...)
+}
+
+func (c *clientWrapper) DescribeInstanceTypeOfferings(
+	ctx context.Context,
+	params *ec2.DescribeInstanceTypeOfferingsInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+	return c.ec2.DescribeInstanceTypeOfferings(ctx, params, optFns...)
+}
+
+func (c *clientWrapper) DescribePlacementGroups(
+	ctx context.Context,
+	params *ec2.DescribePlacementGroupsInput,
+	optFns ...func(*ec2.Options),
+) (*ec2.DescribePlacementGroupsOutput, error) {
+	return c.ec2.DescribePlacementGroups(ctx, params, optFns...)
+}
+
+func (c *clientWrapper) GetInstanceProfile(
+	ctx context.Context,
+	params *iam.GetInstanceProfileInput,
+	optFns ...func(*iam.Options),
+) (*iam.GetInstanceProfileOutput, error) {
+	return c.iam.GetInstanceProfile(ctx, params, optFns...)
+}
+
+// errorCode returns the code of the AWS API error wrapped by err, e.g. "InvalidVpcID.NotFound", or
+// an empty string if err does not wrap an AWS API error.
+func errorCode(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return ""
+}
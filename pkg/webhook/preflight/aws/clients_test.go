@@ -0,0 +1,149 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClient struct {
+	describeRegionsFunc func(
+		ctx context.Context,
+		params *ec2.DescribeRegionsInput,
+	) (*ec2.DescribeRegionsOutput, error)
+
+	describeVpcsFunc func(
+		ctx context.Context,
+		params *ec2.DescribeVpcsInput,
+	) (*ec2.DescribeVpcsOutput, error)
+
+	describeSubnetsFunc func(
+		ctx context.Context,
+		params *ec2.DescribeSubnetsInput,
+	) (*ec2.DescribeSubnetsOutput, error)
+
+	describeImagesFunc func(
+		ctx context.Context,
+		params *ec2.DescribeImagesInput,
+	) (*ec2.DescribeImagesOutput, error)
+
+	describeInstanceTypeOfferingsFunc func(
+		ctx context.Context,
+		params *ec2.DescribeInstanceTypeOfferingsInput,
+	) (*ec2.DescribeInstanceTypeOfferingsOutput, error)
+
+	describePlacementGroupsFunc func(
+		ctx context.Context,
+		params *ec2.DescribePlacementGroupsInput,
+	) (*ec2.DescribePlacementGroupsOutput, error)
+
+	getInstanceProfileFunc func(
+		ctx context.Context,
+		params *iam.GetInstanceProfileInput,
+	) (*iam.GetInstanceProfileOutput, error)
+}
+
+var _ client = &mockClient{}
+
+func (m *mockClient) DescribeRegions(
+	ctx context.Context,
+	params *ec2.DescribeRegionsInput,
+	_ ...func(*ec2.Options),
+) (*ec2.DescribeRegionsOutput, error) {
+	return m.describeRegionsFunc(ctx, params)
+}
+
+func (m *mockClient) DescribeVpcs(
+	ctx context.Context,
+	params *ec2.DescribeVpcsInput,
+	_ ...func(*ec2.Options),
+) (*ec2.DescribeVpcsOutput, error) {
+	return m.describeVpcsFunc(ctx, params)
+}
+
+func (m *mockClient) DescribeSubnets(
+	ctx context.Context,
+	params *ec2.DescribeSubnetsInput,
+	_ ...func(*ec2.Options),
+) (*ec2.DescribeSubnetsOutput, error) {
+	return m.describeSubnetsFunc(ctx, params)
+}
+
+func (m *mockClient) DescribeImages(
+	ctx context.Context,
+	params *ec2.DescribeImagesInput,
+	_ ...func(*ec2.Options),
+) (*ec2.DescribeImagesOutput, error) {
+	return m.describeImagesFunc(ctx, params)
+}
+
+func (m *mockClient) DescribeInstanceTypeOfferings(
+	ctx context.Context,
+	params *ec2.DescribeInstanceTypeOfferingsInput,
+	_ ...func(*ec2.Options),
+) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+	return m.describeInstanceTypeOfferingsFunc(ctx, params)
+}
+
+func (m *mockClient) DescribePlacementGroups(
+	ctx context.Context,
+	params *ec2.DescribePlacementGroupsInput,
+	_ ...func(*ec2.Options),
+) (*ec2.DescribePlacementGroupsOutput, error) {
+	return m.describePlacementGroupsFunc(ctx, params)
+}
+
+func (m *mockClient) GetInstanceProfile(
+	ctx context.Context,
+	params *iam.GetInstanceProfileInput,
+	_ ...func(*iam.Options),
+) (*iam.GetInstanceProfileOutput, error) {
+	return m.getInstanceProfileFunc(ctx, params)
+}
+
+// apiError returns an error like the errors returned by the AWS API.
+func apiError(code string) error {
+	return fmt.Errorf(
+		"operation error: %w",
+		&smithy.GenericAPIError{Code: code, Message: "test API error"},
+	)
+}
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "nil error",
+			err:  nil,
+			want: "",
+		},
+		{
+			name: "non-API error",
+			err:  errors.New("connection refused"),
+			want: "",
+		},
+		{
+			name: "wrapped API error",
+			err:  apiError("InvalidVpcID.NotFound"),
+			want: "InvalidVpcID.NotFound",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorCode(tt.err))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+type credentialsCheck struct {
+	result preflight.CheckResult
+}
+
+func (c *credentialsCheck) Name() string {
+	return "AWSCredentials"
+}
+
+func (c *credentialsCheck) Run(_ context.Context) preflight.CheckResult {
+	return c.result
+}
+
+func newCredentialsCheck(
+	ctx context.Context,
+	awsClientFactory func(ctx context.Context, region string) (client, error),
+	cd *checkDependencies,
+) preflight.Check {
+	cd.log.V(5).Info("Initializing AWS credentials check")
+
+	credentialsCheck := &credentialsCheck{
+		result: preflight.CheckResult{
+			Allowed: true,
+		},
+	}
+
+	if !cd.hasAWSConfiguration() {
+		// If there is no AWS configuration at all, the credentials check is not needed.
+		return credentialsCheck
+	}
+
+	// The region may be set by the ClusterClass templates instead, in which case the checks
+	// cannot know which region the cluster uses.
+	if cd.region == nil || *cd.region == "" {
+		credentialsCheck.result.Warnings = append(credentialsCheck.result.Warnings,
+			fmt.Sprintf(
+				"Field %s.region is not set, so the AWS infrastructure is not checked.",
+				cd.infrastructureFieldOrDefault(),
+			),
+		)
+		return credentialsCheck
+	}
+
+	// The checks use the credentials of this extension, because it does not have access to the
+	// credentials of the identity. The account of the identity may differ, so the checks of the
+	// account resources would not be valid.
+	if cd.identityRef {
+		credentialsCheck.result.Warnings = append(credentialsCheck.result.Warnings,
+			fmt.Sprintf(
+				"Field %s.identityRef is not yet supported by checks, so the AWS infrastructure is not checked.",
+				cd.infrastructureField,
+			),
+		)
+		return credentialsCheck
+	}
+
+	awsClient, err := awsClientFactory(ctx, string(*cd.region))
+	if err != nil {
+		// The extension is not required to have AWS credentials, so this does not block the cluster.
+		credentialsCheck.result.Warnings = append(credentialsCheck.result.Warnings,
+			fmt.Sprintf(
+				"Failed to initialize the AWS API client, so the AWS infrastructure is not checked: %s. Configure AWS credentials for the runtime extension to enable the checks.", ///nolint:lll // Message is long.
+				err,
+			),
+		)
+		return credentialsCheck
+	}
+
+	cd.awsClient = awsClient
+	cd.resultCacheKey = preflight.CacheKey(string(*cd.region))
+	return credentialsCheck
+}
+
+// infrastructureFieldOrDefault returns the path to the AWS or EKS cluster configuration, or the
+// path to the AWS cluster configuration, if the cluster has neither.
+func (cd *checkDependencies) infrastructureFieldOrDefault() string {
+	if cd.infrastructureField != "" {
+		return cd.infrastructureField
+	}
+	return awsFieldPath
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func TestNewCredentialsCheck(t *testing.T) {
+	tests := []struct {
+		name             string
+		cd               *checkDependencies
+		factoryErr       error
+		expectedWarnings int
+		expectClient     bool
+		expectedRegion   string
+	}{
+		{
+			name:             "no AWS configuration",
+			cd:               &checkDependencies{},
+			expectedWarnings: 0,
+			expectClient:     false,
+		},
+		{
+			name: "region not set",
+			cd: &checkDependencies{
+				infrastructureField: awsFieldPath,
+			},
+			expectedWarnings: 1,
+			expectClient:     false,
+		},
+		{
+			name: "client initialized",
+			cd: &checkDependencies{
+				region:              ptr.To(carenv1.Region("us-west-2")),
+				infrastructureField: awsFieldPath,
+			},
+			expectedWarnings: 0,
+			expectClient:     true,
+			expectedRegion:   "us-west-2",
+		},
+		{
+			name: "identity reference not supported",
+			cd: &checkDependencies{
+				region:              ptr.To(carenv1.Region("us-west-2")),
+				identityRef:         true,
+				infrastructureField: eksFieldPath,
+			},
+			expectedWarnings: 1,
+			expectClient:     false,
+		},
+		{
+			name: "no credentials available",
+			cd: &checkDependencies{
+				region:              ptr.To(carenv1.Region("us-west-2")),
+				infrastructureField: awsFieldPath,
+			},
+			factoryErr:       errors.New("no credentials"),
+			expectedWarnings: 1,
+			expectClient:     false,
+			expectedRegion:   "us-west-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cd.log = logr.Discard()
+
+			factoryRegion := ""
+			factory := func(_ context.Context, region string) (client, error) {
+				factoryRegion = region
+				if tt.factoryErr != nil {
+					return nil, tt.factoryErr
+				}
+				return &mockClient{}, nil
+			}
+
+			result := newCredentialsCheck(t.Context(), factory, tt.cd).Run(t.Context())
+
+			// Missing credentials must never block the cluster.
+			assert.True(t, result.Allowed)
+			assert.False(t, result.InternalError)
+			assert.Empty(t, result.Causes)
+			assert.Len(t, result.Warnings, tt.expectedWarnings)
+			assert.Equal(t, tt.expectClient, tt.cd.awsClient != nil)
+			assert.Equal(t, tt.expectClient, tt.cd.resultCacheKey != "")
+			assert.Equal(t, tt.expectedRegion, factoryRegion)
+		})
+	}
+}
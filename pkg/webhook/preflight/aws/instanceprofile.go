@@ -0,0 +1,96 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+type instanceProfileCheck struct {
+	dependsOnRegion
+
+	instanceProfile string
+	field           string
+	client          client
+	// resultCacheKey identifies the AWS client.
+	resultCacheKey string
+}
+
+func (c *instanceProfileCheck) Name() string {
+	return "AWSIAMInstanceProfile"
+}
+
+func (c *instanceProfileCheck) CacheKey() string {
+	if c.resultCacheKey == "" {
+		return ""
+	}
+	return preflight.CacheKey(c.resultCacheKey, c.field, c.instanceProfile)
+}
+
+func (c *instanceProfileCheck) Run(ctx context.Context) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
+	_, err := c.client.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{
+		InstanceProfileName: ptr.To(c.instanceProfile),
+	})
+	var noSuchEntityErr *iamtypes.NoSuchEntityException
+	switch {
+	case errors.As(err, &noSuchEntityErr):
+		result.Allowed = false
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"IAM instance profile %q was not found. Create the instance profile, for example with clusterawsadm, or use an existing one, then retry.", ///nolint:lll // Message is long.
+				c.instanceProfile,
+			),
+			Field: c.field,
+		})
+	case err != nil:
+		result.Allowed = false
+		result.InternalError = true
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Failed to get IAM instance profile %q: %s. This is usually a temporary error. Please retry.",
+				c.instanceProfile,
+				err,
+			),
+			Field: c.field,
+		})
+	}
+
+	return result
+}
+
+func newInstanceProfileChecks(
+	cd *checkDependencies,
+) []preflight.Check {
+	checks := []preflight.Check{}
+
+	if cd == nil || cd.awsClient == nil {
+		return checks
+	}
+
+	for _, spec := range cd.machineSpecs() {
+		if spec.iamInstanceProfile == "" {
+			continue
+		}
+		checks = append(checks, &instanceProfileCheck{
+			instanceProfile: spec.iamInstanceProfile,
+			field:           spec.field + ".iamInstanceProfile",
+			client:          cd.awsClient,
+			resultCacheKey:  cd.resultCacheKey,
+		})
+	}
+
+	return checks
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func TestInstanceProfileCheck(t *testing.T) {
+	tests := []struct {
+		name                  string
+		getErr                error
+		expectedAllowed       bool
+		expectedInternalError bool
+	}{
+		{
+			name:            "instance profile exists",
+			expectedAllowed: true,
+		},
+		{
+			name: "instance profile not found",
+			getErr: fmt.Errorf(
+				"operation error: %w",
+				&iamtypes.NoSuchEntityException{Message: ptr.To("not found")},
+			),
+			expectedAllowed: false,
+		},
+		{
+			name:                  "API error",
+			getErr:                errors.New("connection refused"),
+			expectedAllowed:       false,
+			expectedInternalError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := &instanceProfileCheck{
+				instanceProfile: "nodes.cluster-api-provider-aws.sigs.k8s.io",
+				field:           controlPlaneFieldPath + ".iamInstanceProfile",
+				client: &mockClient{
+					getInstanceProfileFunc: func(
+						_ context.Context,
+						params *iam.GetInstanceProfileInput,
+					) (*iam.GetInstanceProfileOutput, error) {
+						assert.Equal(t, "nodes.cluster-api-provider-aws.sigs.k8s.io", *params.InstanceProfileName)
+						if tt.getErr != nil {
+							return nil, tt.getErr
+						}
+						return &iam.GetInstanceProfileOutput{}, nil
+					},
+				},
+			}
+
+			result := check.Run(t.Context())
+
+			assert.Equal(t, tt.expectedAllowed, result.Allowed)
+			assert.Equal(t, tt.expectedInternalError, result.InternalError)
+			if !tt.expectedAllowed {
+				require.Len(t, result.Causes, 1)
+				assert.Equal(t, controlPlaneFieldPath+".iamInstanceProfile", result.Causes[0].Field)
+			}
+		})
+	}
+}
+
+func TestNewInstanceProfileChecks(t *testing.T) {
+	cd := &checkDependencies{
+		awsClient: &mockClient{},
+		controlPlaneMachineSpec: &machineSpec{
+			iamInstanceProfile: "control-plane.cluster-api-provider-aws.sigs.k8s.io",
+			generic:            &carenv1.AWSGenericNodeSpec{},
+			field:              controlPlaneFieldPath,
+		},
+		workerMachineSpecByMachineDeploymentName: map[string]*machineSpec{
+			"md-0": {generic: &carenv1.AWSGenericNodeSpec{}},
+			"md-1": {
+				iamInstanceProfile: "nodes.cluster-api-provider-aws.sigs.k8s.io",
+				generic:            &carenv1.AWSGenericNodeSpec{},
+				field:              "md-1",
+			},
+		},
+	}
+
+	checks := newInstanceProfileChecks(cd)
+
+	require.Len(t, checks, 2)
+	assert.Equal(t, controlPlaneFieldPath+".iamInstanceProfile", checks[0].(*instanceProfileCheck).field)
+	assert.Equal(t, "md-1.iamInstanceProfile", checks[1].(*instanceProfileCheck).field)
+}
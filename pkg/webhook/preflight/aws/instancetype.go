@@ -0,0 +1,99 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+type instanceTypeCheck struct {
+	dependsOnRegion
+
+	instanceType string
+	field        string
+	client       client
+	// resultCacheKey identifies the AWS client.
+	resultCacheKey string
+}
+
+func (c *instanceTypeCheck) Name() string {
+	return "AWSInstanceType"
+}
+
+func (c *instanceTypeCheck) CacheKey() string {
+	if c.resultCacheKey == "" {
+		return ""
+	}
+	return preflight.CacheKey(c.resultCacheKey, c.field, c.instanceType)
+}
+
+func (c *instanceTypeCheck) Run(ctx context.Context) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
+	resp, err := c.client.DescribeInstanceTypeOfferings(ctx, &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: ec2types.LocationTypeRegion,
+		Filters: []ec2types.Filter{
+			{Name: ptr.To("instance-type"), Values: []string{c.instanceType}},
+		},
+	})
+	if err != nil {
+		result.Allowed = false
+		result.InternalError = true
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Failed to get offerings of instance type %q: %s. This is usually a temporary error. Please retry.",
+				c.instanceType,
+				err,
+			),
+			Field: c.field,
+		})
+		return result
+	}
+
+	if len(resp.InstanceTypeOfferings) == 0 {
+		result.Allowed = false
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Instance type %q is not offered in the AWS region. Check the instance type name, or use an instance type offered in the region of the cluster, then retry.", ///nolint:lll // Message is long.
+				c.instanceType,
+			),
+			Field: c.field,
+		})
+	}
+
+	return result
+}
+
+func newInstanceTypeChecks(
+	cd *checkDependencies,
+) []preflight.Check {
+	checks := []preflight.Check{}
+
+	if cd == nil || cd.awsClient == nil {
+		return checks
+	}
+
+	for _, spec := range cd.machineSpecs() {
+		if spec.instanceType == "" {
+			continue
+		}
+		checks = append(checks, &instanceTypeCheck{
+			instanceType:   spec.instanceType,
+			field:          spec.field + ".instanceType",
+			client:         cd.awsClient,
+			resultCacheKey: cd.resultCacheKey,
+		})
+	}
+
+	return checks
+}
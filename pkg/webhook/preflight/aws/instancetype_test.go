@@ -0,0 +1,92 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+func TestInstanceTypeCheck(t *testing.T) {
+	tests := []struct {
+		name                  string
+		offerings             []ec2types.InstanceTypeOffering
+		describeErr           error
+		expectedAllowed       bool
+		expectedInternalError bool
+	}{
+		{
+			name: "instance type offered",
+			offerings: []ec2types.InstanceTypeOffering{
+				{InstanceType: ec2types.InstanceTypeM5Xlarge},
+			},
+			expectedAllowed: true,
+		},
+		{
+			name:            "instance type not offered",
+			expectedAllowed: false,
+		},
+		{
+			name:                  "API error",
+			describeErr:           errors.New("connection refused"),
+			expectedAllowed:       false,
+			expectedInternalError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := &instanceTypeCheck{
+				instanceType: "m5.xlarge",
+				field:        controlPlaneFieldPath + ".instanceType",
+				client: &mockClient{
+					describeInstanceTypeOfferingsFunc: func(
+						_ context.Context,
+						params *ec2.DescribeInstanceTypeOfferingsInput,
+					) (*ec2.DescribeInstanceTypeOfferingsOutput, error) {
+						assert.Equal(t, ec2types.LocationTypeRegion, params.LocationType)
+						if tt.describeErr != nil {
+							return nil, tt.describeErr
+						}
+						return &ec2.DescribeInstanceTypeOfferingsOutput{InstanceTypeOfferings: tt.offerings}, nil
+					},
+				},
+			}
+
+			result := check.Run(t.Context())
+
+			assert.Equal(t, tt.expectedAllowed, result.Allowed)
+			assert.Equal(t, tt.expectedInternalError, result.InternalError)
+			if !tt.expectedAllowed {
+				require.Len(t, result.Causes, 1)
+				assert.Equal(t, controlPlaneFieldPath+".instanceType", result.Causes[0].Field)
+			}
+		})
+	}
+}
+
+func TestInstanceTypeCheck_CacheKey(t *testing.T) {
+	newCheck := func(resultCacheKey, instanceType string) *instanceTypeCheck {
+		return &instanceTypeCheck{
+			instanceType:   instanceType,
+			field:          "test-field",
+			resultCacheKey: resultCacheKey,
+		}
+	}
+
+	var _ preflight.CacheableCheck = newCheck("us-west-2", "m5.large")
+
+	assert.Equal(t, newCheck("us-west-2", "m5.large").CacheKey(), newCheck("us-west-2", "m5.large").CacheKey())
+	assert.NotEqual(t, newCheck("us-west-2", "m5.large").CacheKey(), newCheck("us-west-2", "m5.xlarge").CacheKey())
+	assert.NotEqual(t, newCheck("us-west-2", "m5.large").CacheKey(), newCheck("us-east-1", "m5.large").CacheKey())
+	assert.Empty(t, newCheck("", "m5.large").CacheKey(), "expected no caching without an AWS client key")
+}
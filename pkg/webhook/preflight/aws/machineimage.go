@@ -0,0 +1,218 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/utils/ptr"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+const (
+	errorCodeAMINotFound  = "InvalidAMIID.NotFound"
+	errorCodeAMIMalformed = "InvalidAMIID.Malformed"
+
+	// The defaults used by the AWS provider to look up an AMI.
+	defaultAMILookupFormat  = "capa-ami-{{.BaseOS}}-?{{.K8sVersion}}-*"
+	defaultAMILookupOwnerID = "258751437250"
+)
+
+type machineImageCheck struct {
+	dependsOnRegion
+
+	amiSpec           *carenv1.AMISpec
+	kubernetesVersion string
+	field             string
+	client            client
+	// resultCacheKey identifies the AWS client.
+	resultCacheKey string
+}
+
+func (c *machineImageCheck) Name() string {
+	return "AWSMachineImage"
+}
+
+func (c *machineImageCheck) CacheKey() string {
+	if c.resultCacheKey == "" {
+		return ""
+	}
+	return preflight.CacheKey(c.resultCacheKey, c.field, c.amiSpec, c.kubernetesVersion)
+}
+
+func (c *machineImageCheck) Run(ctx context.Context) preflight.CheckResult {
+	// The AMI ID takes precedence over the lookup.
+	if c.amiSpec.ID != "" {
+		return c.checkID(ctx)
+	}
+	return c.checkLookup(ctx)
+}
+
+func (c *machineImageCheck) checkID(ctx context.Context) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
+	resp, err := c.client.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		ImageIds: []string{c.amiSpec.ID},
+	})
+	switch {
+	case errorCode(err) == errorCodeAMINotFound ||
+		errorCode(err) == errorCodeAMIMalformed ||
+		(err == nil && len(resp.Images) == 0):
+		result.Allowed = false
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"AMI %q was not found in the AWS region. Check that the AMI exists in the region of the cluster, and is shared with the account, then retry.", ///nolint:lll // Message is long.
+				c.amiSpec.ID,
+			),
+			Field: c.field + ".ami.id",
+		})
+	case err != nil:
+		result.Allowed = false
+		result.InternalError = true
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Failed to get AMI %q: %s. This is usually a temporary error. Please retry.",
+				c.amiSpec.ID,
+				err,
+			),
+			Field: c.field + ".ami.id",
+		})
+	}
+
+	return result
+}
+
+func (c *machineImageCheck) checkLookup(ctx context.Context) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
+	lookup := c.amiSpec.Lookup
+	if lookup == nil {
+		lookup = &carenv1.AMILookup{}
+	}
+
+	name, err := amiLookupName(lookup, c.kubernetesVersion)
+	if err != nil {
+		result.Allowed = false
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Failed to generate the AMI name from the lookup format %q: %s. Review the format, then retry.",
+				lookup.Format,
+				err,
+			),
+			Field: c.field + ".ami.lookup.format",
+		})
+		return result
+	}
+
+	owner := defaultAMILookupOwnerID
+	if lookup.Org != "" {
+		owner = lookup.Org
+	}
+
+	resp, err := c.client.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		Owners: []string{owner},
+		Filters: []ec2types.Filter{
+			{Name: ptr.To("name"), Values: []string{name}},
+			{Name: ptr.To("state"), Values: []string{string(ec2types.ImageStateAvailable)}},
+		},
+	})
+	if err != nil {
+		result.Allowed = false
+		result.InternalError = true
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Failed to look up AMIs with name %q and owner %q: %s. This is usually a temporary error. Please retry.",
+				name,
+				owner,
+				err,
+			),
+			Field: c.field + ".ami.lookup",
+		})
+		return result
+	}
+
+	if len(resp.Images) == 0 {
+		result.Allowed = false
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"No AMIs found in the AWS region with name %q and owner %q. Build an AMI for Kubernetes version %s, or change the lookup arguments, then retry.", ///nolint:lll // Message is long.
+				name,
+				owner,
+				c.kubernetesVersion,
+			),
+			Field: c.field + ".ami.lookup",
+		})
+	}
+
+	return result
+}
+
+// amiLookupName returns the AMI name pattern that the AWS provider uses to look up the AMI.
+// Unlike the AWS provider, an empty base OS matches any base OS, because the default base OS
+// depends on the version of the AWS provider.
+func amiLookupName(lookup *carenv1.AMILookup, kubernetesVersion string) (string, error) {
+	format := lookup.Format
+	if format == "" {
+		format = defaultAMILookupFormat
+	}
+	baseOS := lookup.BaseOS
+	if baseOS == "" {
+		baseOS = "*"
+	}
+
+	tmpl, err := template.New("amiName").Parse(format)
+	if err != nil {
+		return "", err
+	}
+
+	var name strings.Builder
+	err = tmpl.Execute(&name, struct {
+		BaseOS     string
+		K8sVersion string
+	}{
+		BaseOS:     baseOS,
+		K8sVersion: strings.TrimPrefix(kubernetesVersion, "v"),
+	})
+	if err != nil {
+		return "", err
+	}
+	return name.String(), nil
+}
+
+func newMachineImageChecks(
+	cd *checkDependencies,
+) []preflight.Check {
+	checks := []preflight.Check{}
+
+	if cd == nil || cd.awsClient == nil {
+		return checks
+	}
+
+	for _, spec := range cd.machineSpecs() {
+		// Without an AMI configuration, the AWS provider uses its defaults, which are not checked.
+		if spec.generic.AMISpec == nil {
+			continue
+		}
+		checks = append(checks, &machineImageCheck{
+			amiSpec:           spec.generic.AMISpec,
+			kubernetesVersion: cd.cluster.Spec.Topology.Version,
+			field:             spec.field,
+			client:            cd.awsClient,
+			resultCacheKey:    cd.resultCacheKey,
+		})
+	}
+
+	return checks
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+func TestAMILookupName(t *testing.T) {
+	tests := []struct {
+		name    string
+		lookup  *carenv1.AMILookup
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "defaults",
+			lookup: &carenv1.AMILookup{},
+			want:   "capa-ami-*-?1.33.1-*",
+		},
+		{
+			name:   "base OS",
+			lookup: &carenv1.AMILookup{BaseOS: "ubuntu-22.04"},
+			want:   "capa-ami-ubuntu-22.04-?1.33.1-*",
+		},
+		{
+			name:   "custom format",
+			lookup: &carenv1.AMILookup{Format: "my-ami-{{.K8sVersion}}-{{.BaseOS}}", BaseOS: "rhel-9"},
+			want:   "my-ami-1.33.1-rhel-9",
+		},
+		{
+			name:    "invalid format",
+			lookup:  &carenv1.AMILookup{Format: "my-ami-{{.K8sVersion"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := amiLookupName(tt.lookup, "v1.33.1")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMachineImageCheck(t *testing.T) {
+	tests := []struct {
+		name                  string
+		amiSpec               *carenv1.AMISpec
+		images                []ec2types.Image
+		describeErr           error
+		expectedAllowed       bool
+		expectedInternalError bool
+		expectedField         string
+		expectedOwner         string
+		expectedName          string
+	}{
+		{
+			name:            "AMI ID exists",
+			amiSpec:         &carenv1.AMISpec{ID: "ami-11111111"},
+			images:          []ec2types.Image{{ImageId: ptr.To("ami-11111111")}},
+			expectedAllowed: true,
+		},
+		{
+			name:            "AMI ID not found",
+			amiSpec:         &carenv1.AMISpec{ID: "ami-11111111"},
+			describeErr:     apiError(errorCodeAMINotFound),
+			expectedAllowed: false,
+			expectedField:   controlPlaneFieldPath + ".ami.id",
+		},
+		{
+			name:            "AMI ID not returned",
+			amiSpec:         &carenv1.AMISpec{ID: "ami-11111111"},
+			expectedAllowed: false,
+			expectedField:   controlPlaneFieldPath + ".ami.id",
+		},
+		{
+			name:                  "AMI ID API error",
+			amiSpec:               &carenv1.AMISpec{ID: "ami-11111111"},
+			describeErr:           errors.New("connection refused"),
+			expectedAllowed:       false,
+			expectedInternalError: true,
+			expectedField:         controlPlaneFieldPath + ".ami.id",
+		},
+		{
+			name:            "lookup with defaults finds an AMI",
+			amiSpec:         &carenv1.AMISpec{},
+			images:          []ec2types.Image{{ImageId: ptr.To("ami-11111111")}},
+			expectedAllowed: true,
+			expectedOwner:   defaultAMILookupOwnerID,
+			expectedName:    "capa-ami-*-?1.33.1-*",
+		},
+		{
+			name: "lookup finds no AMI",
+			amiSpec: &carenv1.AMISpec{
+				Lookup: &carenv1.AMILookup{Org: "123456789012", BaseOS: "ubuntu-22.04"},
+			},
+			expectedAllowed: false,
+			expectedField:   controlPlaneFieldPath + ".ami.lookup",
+			expectedOwner:   "123456789012",
+			expectedName:    "capa-ami-ubuntu-22.04-?1.33.1-*",
+		},
+		{
+			name:                  "lookup API error",
+			amiSpec:               &carenv1.AMISpec{},
+			describeErr:           errors.New("connection refused"),
+			expectedAllowed:       false,
+			expectedInternalError: true,
+			expectedField:         controlPlaneFieldPath + ".ami.lookup",
+		},
+		{
+			name: "lookup with invalid format",
+			amiSpec: &carenv1.AMISpec{
+				Lookup: &carenv1.AMILookup{Format: "{{.K8sVersion"},
+			},
+			expectedAllowed: false,
+			expectedField:   controlPlaneFieldPath + ".ami.lookup.format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var input *ec2.DescribeImagesInput
+			check := &machineImageCheck{
+				amiSpec:           tt.amiSpec,
+				kubernetesVersion: "v1.33.1",
+				field:             controlPlaneFieldPath,
+				client: &mockClient{
+					describeImagesFunc: func(
+						_ context.Context,
+						params *ec2.DescribeImagesInput,
+					) (*ec2.DescribeImagesOutput, error) {
+						input = params
+						if tt.describeErr != nil {
+							return nil, tt.describeErr
+						}
+						return &ec2.DescribeImagesOutput{Images: tt.images}, nil
+					},
+				},
+			}
+
+			result := check.Run(t.Context())
+
+			assert.Equal(t, tt.expectedAllowed, result.Allowed)
+			assert.Equal(t, tt.expectedInternalError, result.InternalError)
+			if tt.expectedAllowed {
+				assert.Empty(t, result.Causes)
+			} else {
+				require.Len(t, result.Causes, 1)
+				assert.Equal(t, tt.expectedField, result.Causes[0].Field)
+			}
+			if tt.expectedOwner != "" {
+				require.NotNil(t, input)
+				assert.Equal(t, []string{tt.expectedOwner}, input.Owners)
+				assert.Equal(t, []string{tt.expectedName}, input.Filters[0].Values)
+			}
+		})
+	}
+}
+
+func TestNewMachineImageChecks(t *testing.T) {
+	cd := &checkDependencies{
+		cluster:   newTestCluster(t, nil, nil),
+		awsClient: &mockClient{},
+		controlPlaneMachineSpec: &machineSpec{
+			generic: &carenv1.AWSGenericNodeSpec{AMISpec: &carenv1.AMISpec{ID: "ami-11111111"}},
+			field:   controlPlaneFieldPath,
+		},
+		workerMachineSpecByMachineDeploymentName: map[string]*machineSpec{
+			// Without an AMI configuration, there is nothing to check.
+			"md-0": {generic: &carenv1.AWSGenericNodeSpec{}},
+		},
+	}
+
+	checks := newMachineImageChecks(cd)
+
+	require.Len(t, checks, 1)
+	check, ok := checks[0].(*machineImageCheck)
+	require.True(t, ok)
+	assert.Equal(t, "v1.33.1", check.kubernetesVersion)
+	assert.Equal(t, controlPlaneFieldPath, check.field)
+}
+
+func TestMachineImageCheck_CacheKey(t *testing.T) {
+	newCheck := func(amiID, kubernetesVersion string) *machineImageCheck {
+		return &machineImageCheck{
+			amiSpec:           &carenv1.AMISpec{ID: amiID},
+			kubernetesVersion: kubernetesVersion,
+			field:             "test-field",
+			resultCacheKey:    "us-west-2",
+		}
+	}
+
+	var _ preflight.CacheableCheck = newCheck("ami-1", "v1.33.0")
+
+	assert.Equal(t, newCheck("ami-1", "v1.33.0").CacheKey(), newCheck("ami-1", "v1.33.0").CacheKey())
+	assert.NotEqual(t, newCheck("ami-1", "v1.33.0").CacheKey(), newCheck("ami-2", "v1.33.0").CacheKey())
+	assert.NotEqual(t, newCheck("ami-1", "v1.33.0").CacheKey(), newCheck("ami-1", "v1.34.0").CacheKey())
+}
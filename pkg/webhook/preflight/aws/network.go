@@ -0,0 +1,147 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"k8s.io/utils/ptr"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+const (
+	errorCodeVPCNotFound    = "InvalidVpcID.NotFound"
+	errorCodeSubnetNotFound = "InvalidSubnetID.NotFound"
+)
+
+type networkCheck struct {
+	dependsOnRegion
+
+	network *carenv1.AWSNetwork
+	field   string
+	client  client
+	// resultCacheKey identifies the AWS client.
+	resultCacheKey string
+}
+
+func (c *networkCheck) Name() string {
+	return "AWSNetwork"
+}
+
+func (c *networkCheck) CacheKey() string {
+	if c.resultCacheKey == "" {
+		return ""
+	}
+	return preflight.CacheKey(c.resultCacheKey, c.field, c.network)
+}
+
+func (c *networkCheck) Run(ctx context.Context) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
+	vpcID := ""
+	if c.network.VPC != nil {
+		vpcID = c.network.VPC.ID
+		_, err := c.client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+			VpcIds: []string{vpcID},
+		})
+		switch {
+		case errorCode(err) == errorCodeVPCNotFound:
+			result.Allowed = false
+			result.Causes = append(result.Causes, preflight.Cause{
+				Message: fmt.Sprintf(
+					"VPC %q was not found in the AWS region. Check that the VPC exists in the region of the cluster, then retry.", ///nolint:lll // Message is long.
+					vpcID,
+				),
+				Field: c.field + ".vpc.id",
+			})
+			// The subnets cannot belong to a VPC that does not exist.
+			return result
+		case err != nil:
+			result.Allowed = false
+			result.InternalError = true
+			result.Causes = append(result.Causes, preflight.Cause{
+				Message: fmt.Sprintf(
+					"Failed to get VPC %q: %s. This is usually a temporary error. Please retry.",
+					vpcID,
+					err,
+				),
+				Field: c.field + ".vpc.id",
+			})
+			return result
+		}
+	}
+
+	// Describe the subnets one at a time, because the API fails the whole request if any of the
+	// subnets does not exist, and we want to report every subnet that does not exist.
+	for i, subnet := range c.network.Subnets {
+		field := fmt.Sprintf("%s.subnets[%d].id", c.field, i)
+		resp, err := c.client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+			SubnetIds: []string{subnet.ID},
+		})
+		switch {
+		case errorCode(err) == errorCodeSubnetNotFound || (err == nil && len(resp.Subnets) == 0):
+			result.Allowed = false
+			result.Causes = append(result.Causes, preflight.Cause{
+				Message: fmt.Sprintf(
+					"Subnet %q was not found in the AWS region. Check that the subnet exists in the region of the cluster, then retry.", ///nolint:lll // Message is long.
+					subnet.ID,
+				),
+				Field: field,
+			})
+		case err != nil:
+			result.Allowed = false
+			result.InternalError = true
+			result.Causes = append(result.Causes, preflight.Cause{
+				Message: fmt.Sprintf(
+					"Failed to get subnet %q: %s. This is usually a temporary error. Please retry.",
+					subnet.ID,
+					err,
+				),
+				Field: field,
+			})
+		case vpcID != "" && ptr.Deref(resp.Subnets[0].VpcId, "") != vpcID:
+			result.Allowed = false
+			result.Causes = append(result.Causes, preflight.Cause{
+				Message: fmt.Sprintf(
+					"Subnet %q belongs to VPC %q, not to the VPC %q of the cluster. Use subnets of the VPC of the cluster, then retry.", ///nolint:lll // Message is long.
+					subnet.ID,
+					ptr.Deref(resp.Subnets[0].VpcId, ""),
+					vpcID,
+				),
+				Field: field,
+			})
+		}
+	}
+
+	return result
+}
+
+func newNetworkChecks(
+	cd *checkDependencies,
+) []preflight.Check {
+	checks := []preflight.Check{}
+
+	if cd == nil || cd.awsClient == nil {
+		return checks
+	}
+
+	if cd.network == nil || (cd.network.VPC == nil && len(cd.network.Subnets) == 0) {
+		return checks
+	}
+
+	checks = append(checks, &networkCheck{
+		network:        cd.network,
+		field:          cd.infrastructureField + ".network",
+		client:         cd.awsClient,
+		resultCacheKey: cd.resultCacheKey,
+	})
+
+	return checks
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func TestNetworkCheck(t *testing.T) {
+	const field = awsFieldPath + ".network"
+
+	subnets := map[string]string{
+		"subnet-11111111": "vpc-11111111",
+		"subnet-22222222": "vpc-22222222",
+	}
+	describeSubnets := func(
+		_ context.Context,
+		params *ec2.DescribeSubnetsInput,
+	) (*ec2.DescribeSubnetsOutput, error) {
+		vpcID, ok := subnets[params.SubnetIds[0]]
+		if !ok {
+			return nil, apiError(errorCodeSubnetNotFound)
+		}
+		return &ec2.DescribeSubnetsOutput{
+			Subnets: []ec2types.Subnet{{SubnetId: ptr.To(params.SubnetIds[0]), VpcId: ptr.To(vpcID)}},
+		}, nil
+	}
+
+	tests := []struct {
+		name                  string
+		network               *carenv1.AWSNetwork
+		describeVpcsErr       error
+		expectedAllowed       bool
+		expectedInternalError bool
+		expectedFields        []string
+	}{
+		{
+			name: "VPC and subnets exist",
+			network: &carenv1.AWSNetwork{
+				VPC:     &carenv1.VPC{ID: "vpc-11111111"},
+				Subnets: carenv1.Subnets{{ID: "subnet-11111111"}},
+			},
+			expectedAllowed: true,
+		},
+		{
+			name: "subnets exist without VPC",
+			network: &carenv1.AWSNetwork{
+				Subnets: carenv1.Subnets{{ID: "subnet-11111111"}, {ID: "subnet-22222222"}},
+			},
+			expectedAllowed: true,
+		},
+		{
+			name: "VPC not found",
+			network: &carenv1.AWSNetwork{
+				VPC:     &carenv1.VPC{ID: "vpc-33333333"},
+				Subnets: carenv1.Subnets{{ID: "subnet-11111111"}},
+			},
+			describeVpcsErr: apiError(errorCodeVPCNotFound),
+			expectedAllowed: false,
+			expectedFields:  []string{field + ".vpc.id"},
+		},
+		{
+			name: "VPC API error",
+			network: &carenv1.AWSNetwork{
+				VPC: &carenv1.VPC{ID: "vpc-11111111"},
+			},
+			describeVpcsErr:       errors.New("connection refused"),
+			expectedAllowed:       false,
+			expectedInternalError: true,
+			expectedFields:        []string{field + ".vpc.id"},
+		},
+		{
+			name: "every subnet not found is reported",
+			network: &carenv1.AWSNetwork{
+				Subnets: carenv1.Subnets{
+					{ID: "subnet-33333333"},
+					{ID: "subnet-11111111"},
+					{ID: "subnet-44444444"},
+				},
+			},
+			expectedAllowed: false,
+			expectedFields:  []string{field + ".subnets[0].id", field + ".subnets[2].id"},
+		},
+		{
+			name: "subnet in another VPC",
+			network: &carenv1.AWSNetwork{
+				VPC:     &carenv1.VPC{ID: "vpc-11111111"},
+				Subnets: carenv1.Subnets{{ID: "subnet-11111111"}, {ID: "subnet-22222222"}},
+			},
+			expectedAllowed: false,
+			expectedFields:  []string{field + ".subnets[1].id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := &networkCheck{
+				network: tt.network,
+				field:   field,
+				client: &mockClient{
+					describeVpcsFunc: func(
+						_ context.Context,
+						_ *ec2.DescribeVpcsInput,
+					) (*ec2.DescribeVpcsOutput, error) {
+						if tt.describeVpcsErr != nil {
+							return nil, tt.describeVpcsErr
+						}
+						return &ec2.DescribeVpcsOutput{}, nil
+					},
+					describeSubnetsFunc: describeSubnets,
+				},
+			}
+
+			result := check.Run(t.Context())
+
+			assert.Equal(t, tt.expectedAllowed, result.Allowed)
+			assert.Equal(t, tt.expectedInternalError, result.InternalError)
+			fields := []string{}
+			for _, cause := range result.Causes {
+				fields = append(fields, cause.Field)
+			}
+			if tt.expectedFields == nil {
+				tt.expectedFields = []string{}
+			}
+			assert.Equal(t, tt.expectedFields, fields)
+		})
+	}
+}
+
+func TestNewNetworkChecks(t *testing.T) {
+	network := &carenv1.AWSNetwork{VPC: &carenv1.VPC{ID: "vpc-11111111"}}
+
+	tests := []struct {
+		name          string
+		cd            *checkDependencies
+		expectedCount int
+	}{
+		{
+			name:          "nil dependencies",
+			cd:            nil,
+			expectedCount: 0,
+		},
+		{
+			name: "no client",
+			cd: &checkDependencies{
+				network: network,
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "no network",
+			cd: &checkDependencies{
+				awsClient: &mockClient{},
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "network configured",
+			cd: &checkDependencies{
+				network:   network,
+				awsClient: &mockClient{},
+			},
+			expectedCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, newNetworkChecks(tt.cd), tt.expectedCount)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+const errorCodePlacementGroupNotFound = "InvalidPlacementGroup.Unknown"
+
+type placementGroupCheck struct {
+	dependsOnRegion
+
+	placementGroup string
+	field          string
+	client         client
+	// resultCacheKey identifies the AWS client.
+	resultCacheKey string
+}
+
+func (c *placementGroupCheck) Name() string {
+	return "AWSPlacementGroup"
+}
+
+func (c *placementGroupCheck) CacheKey() string {
+	if c.resultCacheKey == "" {
+		return ""
+	}
+	return preflight.CacheKey(c.resultCacheKey, c.field, c.placementGroup)
+}
+
+func (c *placementGroupCheck) Run(ctx context.Context) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
+	resp, err := c.client.DescribePlacementGroups(ctx, &ec2.DescribePlacementGroupsInput{
+		GroupNames: []string{c.placementGroup},
+	})
+	switch {
+	case errorCode(err) == errorCodePlacementGroupNotFound || (err == nil && len(resp.PlacementGroups) == 0):
+		result.Allowed = false
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Placement group %q was not found in the AWS region. Create the placement group in the region of the cluster, then retry.", ///nolint:lll // Message is long.
+				c.placementGroup,
+			),
+			Field: c.field,
+		})
+	case err != nil:
+		result.Allowed = false
+		result.InternalError = true
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Failed to get placement group %q: %s. This is usually a temporary error. Please retry.",
+				c.placementGroup,
+				err,
+			),
+			Field: c.field,
+		})
+	}
+
+	return result
+}
+
+func newPlacementGroupChecks(
+	cd *checkDependencies,
+) []preflight.Check {
+	checks := []preflight.Check{}
+
+	if cd == nil || cd.awsClient == nil {
+		return checks
+	}
+
+	for _, spec := range cd.machineSpecs() {
+		if spec.generic.PlacementGroup == nil || spec.generic.PlacementGroup.Name == "" {
+			continue
+		}
+		checks = append(checks, &placementGroupCheck{
+			placementGroup: spec.generic.PlacementGroup.Name,
+			field:          spec.field + ".placementGroup.name",
+			client:         cd.awsClient,
+			resultCacheKey: cd.resultCacheKey,
+		})
+	}
+
+	return checks
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestPlacementGroupCheck(t *testing.T) {
+	tests := []struct {
+		name                  string
+		placementGroups       []ec2types.PlacementGroup
+		describeErr           error
+		expectedAllowed       bool
+		expectedInternalError bool
+	}{
+		{
+			name:            "placement group exists",
+			placementGroups: []ec2types.PlacementGroup{{GroupName: ptr.To("pg")}},
+			expectedAllowed: true,
+		},
+		{
+			name:            "placement group not found",
+			describeErr:     apiError(errorCodePlacementGroupNotFound),
+			expectedAllowed: false,
+		},
+		{
+			name:            "placement group not returned",
+			expectedAllowed: false,
+		},
+		{
+			name:                  "API error",
+			describeErr:           errors.New("connection refused"),
+			expectedAllowed:       false,
+			expectedInternalError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := &placementGroupCheck{
+				placementGroup: "pg",
+				field:          controlPlaneFieldPath + ".placementGroup.name",
+				client: &mockClient{
+					describePlacementGroupsFunc: func(
+						_ context.Context,
+						_ *ec2.DescribePlacementGroupsInput,
+					) (*ec2.DescribePlacementGroupsOutput, error) {
+						if tt.describeErr != nil {
+							return nil, tt.describeErr
+						}
+						return &ec2.DescribePlacementGroupsOutput{PlacementGroups: tt.placementGroups}, nil
+					},
+				},
+			}
+
+			result := check.Run(t.Context())
+
+			assert.Equal(t, tt.expectedAllowed, result.Allowed)
+			assert.Equal(t, tt.expectedInternalError, result.InternalError)
+			if !tt.expectedAllowed {
+				require.Len(t, result.Causes, 1)
+				assert.Equal(t, controlPlaneFieldPath+".placementGroup.name", result.Causes[0].Field)
+			}
+		})
+	}
+}
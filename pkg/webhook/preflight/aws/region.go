@@ -0,0 +1,123 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"k8s.io/utils/ptr"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+// regionOptInStatusNotOptedIn is the opt-in status of a region that must be enabled for the
+// account before it can be used.
+const regionOptInStatusNotOptedIn = "not-opted-in"
+
+// regionCheckName is the name of the region check, which all checks that call the API of the region
+// depend on.
+const regionCheckName = "AWSRegion"
+
+type regionCheck struct {
+	region string
+	field  string
+	client client
+	// resultCacheKey identifies the AWS client.
+	resultCacheKey string
+}
+
+func (c *regionCheck) Name() string {
+	return regionCheckName
+}
+
+func (c *regionCheck) CacheKey() string {
+	if c.resultCacheKey == "" {
+		return ""
+	}
+	return preflight.CacheKey(c.resultCacheKey, c.field, c.region)
+}
+
+func (c *regionCheck) Run(ctx context.Context) preflight.CheckResult {
+	if c.client == nil {
+		return preflight.CheckResult{
+			Allowed: true,
+		}
+	}
+	return checkRegion(ctx, c.client, c.region, c.field)
+}
+
+func newRegionCheck(cd *checkDependencies) preflight.Check {
+	cd.log.V(5).Info("Initializing AWS region check")
+
+	check := &regionCheck{
+		field:          cd.infrastructureField + ".region",
+		client:         cd.awsClient,
+		resultCacheKey: cd.resultCacheKey,
+	}
+	if cd.region != nil {
+		check.region = string(*cd.region)
+	}
+	return check
+}
+
+// dependsOnRegion is embedded in the checks that call the API of the region, so that they run only if
+// the region is available.
+type dependsOnRegion struct{}
+
+func (dependsOnRegion) DependsOn() []string {
+	return []string{regionCheckName}
+}
+
+// checkRegion checks that the region exists, and is enabled for the account.
+func checkRegion(ctx context.Context, awsClient client, region, field string) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
+	resp, err := awsClient.DescribeRegions(ctx, &ec2.DescribeRegionsInput{
+		AllRegions: ptr.To(true),
+	})
+	if err != nil {
+		result.Allowed = false
+		result.InternalError = true
+		result.Causes = append(result.Causes, preflight.Cause{
+			Message: fmt.Sprintf(
+				"Failed to list AWS regions: %s. This is usually a temporary error. Please retry.",
+				err,
+			),
+			Field: field,
+		})
+		return result
+	}
+
+	for i := range resp.Regions {
+		if ptr.Deref(resp.Regions[i].RegionName, "") != region {
+			continue
+		}
+		if ptr.Deref(resp.Regions[i].OptInStatus, "") == regionOptInStatusNotOptedIn {
+			result.Allowed = false
+			result.Causes = append(result.Causes, preflight.Cause{
+				Message: fmt.Sprintf(
+					"AWS region %q is not enabled for the account. Enable the region, or use a different region, then retry.", ///nolint:lll // Message is long.
+					region,
+				),
+				Field: field,
+			})
+			return result
+		}
+		return result
+	}
+
+	result.Allowed = false
+	result.Causes = append(result.Causes, preflight.Cause{
+		Message: fmt.Sprintf(
+			"AWS region %q does not exist. Check the region name, then retry.",
+			region,
+		),
+		Field: field,
+	})
+	return result
+}
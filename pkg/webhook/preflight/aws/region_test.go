@@ -0,0 +1,124 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+func TestNewRegionCheck(t *testing.T) {
+	regions := &ec2.DescribeRegionsOutput{
+		Regions: []ec2types.Region{
+			{RegionName: ptr.To("us-west-2"), OptInStatus: ptr.To("opt-in-not-required")},
+			{RegionName: ptr.To("af-south-1"), OptInStatus: ptr.To(regionOptInStatusNotOptedIn)},
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		region                string
+		describeErr           error
+		expectedAllowed       bool
+		expectedInternalError bool
+	}{
+		{
+			name:            "region exists",
+			region:          "us-west-2",
+			expectedAllowed: true,
+		},
+		{
+			name:            "region does not exist",
+			region:          "us-nowhere-1",
+			expectedAllowed: false,
+		},
+		{
+			name:            "region not enabled",
+			region:          "af-south-1",
+			expectedAllowed: false,
+		},
+		{
+			name:                  "API error",
+			region:                "us-west-2",
+			describeErr:           errors.New("connection refused"),
+			expectedAllowed:       false,
+			expectedInternalError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			describeCalled := false
+			cd := &checkDependencies{
+				cluster:             newTestCluster(t, nil, nil),
+				region:              ptr.To(carenv1.Region(tt.region)),
+				infrastructureField: awsFieldPath,
+				awsClient: &mockClient{
+					describeRegionsFunc: func(
+						_ context.Context,
+						_ *ec2.DescribeRegionsInput,
+					) (*ec2.DescribeRegionsOutput, error) {
+						describeCalled = true
+						if tt.describeErr != nil {
+							return nil, tt.describeErr
+						}
+						return regions, nil
+					},
+				},
+				log: logr.Discard(),
+			}
+
+			check := newRegionCheck(cd)
+			// The region is checked when the check runs, not when it is initialized.
+			assert.False(t, describeCalled)
+
+			result := check.Run(t.Context())
+
+			assert.True(t, describeCalled)
+			assert.Equal(t, tt.expectedAllowed, result.Allowed)
+			assert.Equal(t, tt.expectedInternalError, result.InternalError)
+			if !tt.expectedAllowed {
+				assert.Len(t, result.Causes, 1)
+				assert.Equal(t, awsFieldPath+".region", result.Causes[0].Field)
+			}
+		})
+	}
+}
+
+func TestNewRegionCheck_NoClient(t *testing.T) {
+	cd := &checkDependencies{
+		cluster: newTestCluster(t, nil, nil),
+		log:     logr.Discard(),
+	}
+
+	result := newRegionCheck(cd).Run(t.Context())
+
+	assert.True(t, result.Allowed)
+}
+
+func TestChecksDependOnRegion(t *testing.T) {
+	checks := []preflight.Check{
+		&networkCheck{},
+		&machineImageCheck{},
+		&instanceTypeCheck{},
+		&instanceProfileCheck{},
+		&placementGroupCheck{},
+	}
+	for _, check := range checks {
+		dependentCheck, ok := check.(preflight.DependentCheck)
+		if assert.True(t, ok, "check %T must depend on the region check", check) {
+			assert.Equal(t, []string{regionCheckName}, dependentCheck.DependsOn())
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/variables"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+const (
+	clusterConfigFieldPath = "$.spec.topology.variables[?@.name==\"clusterConfig\"]"
+	awsFieldPath           = clusterConfigFieldPath + ".value.aws"
+	eksFieldPath           = clusterConfigFieldPath + ".value.eks"
+	controlPlaneFieldPath  = clusterConfigFieldPath + ".value.controlPlane.aws"
+)
+
+type configurationCheck struct {
+	result preflight.CheckResult
+}
+
+func (c *configurationCheck) Name() string {
+	return "AWSConfiguration"
+}
+
+func (c *configurationCheck) Run(_ context.Context) preflight.CheckResult {
+	return c.result
+}
+
+func newConfigurationCheck(
+	cd *checkDependencies,
+) preflight.Check {
+	cd.log.V(5).Info("Initializing AWS configuration check")
+
+	configurationCheck := &configurationCheck{
+		result: preflight.CheckResult{
+			Allowed: true,
+		},
+	}
+
+	clusterConfigVar := variables.GetClusterVariableByName(
+		carenv1.ClusterConfigVariableName,
+		cd.cluster.Spec.Topology.Variables,
+	)
+	if clusterConfigVar != nil {
+		// The AWS and EKS cluster configurations use different keys, so that only one of them is set.
+		awsClusterConfigSpec := &carenv1.AWSClusterConfigSpec{}
+		err := variables.UnmarshalClusterVariable(clusterConfigVar, awsClusterConfigSpec)
+		if err == nil {
+			eksClusterConfigSpec := &carenv1.EKSClusterConfigSpec{}
+			err = variables.UnmarshalClusterVariable(clusterConfigVar, eksClusterConfigSpec)
+			if err == nil {
+				setClusterConfigDependencies(cd, awsClusterConfigSpec, eksClusterConfigSpec)
+			}
+		}
+		if err != nil {
+			// Should not happen if the cluster passed CEL validation rules.
+			configurationCheck.result.Allowed = false
+			configurationCheck.result.InternalError = true
+			configurationCheck.result.Causes = append(configurationCheck.result.Causes,
+				preflight.Cause{
+					Message: fmt.Sprintf(
+						"Failed to unmarshal cluster variable %q: %s. Review the Cluster.", ///nolint:lll // Message is long.
+						carenv1.ClusterConfigVariableName,
+						err,
+					),
+					Field: clusterConfigFieldPath,
+				},
+			)
+		}
+	}
+
+	workerMachineSpecByMachineDeploymentName := make(map[string]*machineSpec)
+
+	for i := range cd.cluster.Spec.Topology.Workers.MachineDeployments {
+		md := &cd.cluster.Spec.Topology.Workers.MachineDeployments[i]
+
+		var workerConfigVar *clusterv1.ClusterVariable
+		var workerConfigFieldPath string
+		if len(md.Variables.Overrides) > 0 {
+			workerConfigVar = variables.GetClusterVariableByName(
+				carenv1.WorkerConfigVariableName,
+				md.Variables.Overrides,
+			)
+			if workerConfigVar != nil {
+				workerConfigFieldPath = fmt.Sprintf(
+					"$.spec.topology.workers.machineDeployments[?@.name==%q].variables[?@.name=='%s']",
+					md.Name,
+					carenv1.WorkerConfigVariableName,
+				)
+			}
+		}
+		if workerConfigVar == nil {
+			workerConfigVar = variables.GetClusterVariableByName(
+				carenv1.WorkerConfigVariableName,
+				cd.cluster.Spec.Topology.Variables,
+			)
+			if workerConfigVar != nil {
+				workerConfigFieldPath = fmt.Sprintf(
+					"$.spec.topology.variables[?@.name=='%s']",
+					carenv1.WorkerConfigVariableName,
+				)
+			}
+		}
+
+		if workerConfigVar == nil {
+			continue
+		}
+
+		awsWorkerNodeConfigSpec := &carenv1.AWSWorkerNodeConfigSpec{}
+		err := variables.UnmarshalClusterVariable(workerConfigVar, awsWorkerNodeConfigSpec)
+		eksWorkerNodeConfigSpec := &carenv1.EKSWorkerNodeConfigSpec{}
+		if err == nil {
+			err = variables.UnmarshalClusterVariable(workerConfigVar, eksWorkerNodeConfigSpec)
+		}
+		if err != nil {
+			// Should not happen if the cluster passed CEL validation rules.
+			configurationCheck.result.Allowed = false
+			configurationCheck.result.InternalError = true
+			configurationCheck.result.Causes = append(configurationCheck.result.Causes,
+				preflight.Cause{
+					Message: fmt.Sprintf(
+						"Failed to unmarshal variable %q: %s. Review the Cluster.", ///nolint:lll // Message is long.
+						carenv1.WorkerConfigVariableName,
+						err,
+					),
+					Field: workerConfigFieldPath,
+				},
+			)
+			continue
+		}
+
+		// Save the worker machine spec only if it contains AWS or EKS configuration.
+		switch {
+		case awsWorkerNodeConfigSpec.AWS != nil:
+			workerMachineSpecByMachineDeploymentName[md.Name] = workerMachineSpec(
+				awsWorkerNodeConfigSpec.AWS,
+				workerConfigFieldPath+".value.aws",
+			)
+		case eksWorkerNodeConfigSpec.EKS != nil:
+			workerMachineSpecByMachineDeploymentName[md.Name] = workerMachineSpec(
+				eksWorkerNodeConfigSpec.EKS,
+				workerConfigFieldPath+".value.eks",
+			)
+		}
+	}
+
+	// Save the worker machine specs only if at least one contains AWS or EKS configuration.
+	if len(workerMachineSpecByMachineDeploymentName) > 0 {
+		cd.workerMachineSpecByMachineDeploymentName = workerMachineSpecByMachineDeploymentName
+	}
+
+	return configurationCheck
+}
+
+func setClusterConfigDependencies(
+	cd *checkDependencies,
+	awsClusterConfigSpec *carenv1.AWSClusterConfigSpec,
+	eksClusterConfigSpec *carenv1.EKSClusterConfigSpec,
+) {
+	switch {
+	case awsClusterConfigSpec.AWS != nil:
+		cd.region = awsClusterConfigSpec.AWS.Region
+		cd.network = awsClusterConfigSpec.AWS.Network
+		cd.identityRef = awsClusterConfigSpec.AWS.IdentityRef != nil
+		cd.infrastructureField = awsFieldPath
+	case eksClusterConfigSpec.EKS != nil:
+		cd.region = eksClusterConfigSpec.EKS.Region
+		cd.network = eksClusterConfigSpec.EKS.Network
+		cd.identityRef = eksClusterConfigSpec.EKS.IdentityRef != nil
+		cd.infrastructureField = eksFieldPath
+	}
+
+	if awsClusterConfigSpec.ControlPlane != nil && awsClusterConfigSpec.ControlPlane.AWS != nil {
+		controlPlane := awsClusterConfigSpec.ControlPlane.AWS
+		cd.controlPlaneMachineSpec = &machineSpec{
+			iamInstanceProfile: controlPlane.IAMInstanceProfile,
+			instanceType:       controlPlane.InstanceType,
+			generic:            &controlPlane.AWSGenericNodeSpec,
+			field:              controlPlaneFieldPath,
+		}
+	}
+}
+
+func workerMachineSpec(spec *carenv1.AWSWorkerNodeSpec, field string) *machineSpec {
+	return &machineSpec{
+		iamInstanceProfile: spec.IAMInstanceProfile,
+		instanceType:       spec.InstanceType,
+		generic:            &spec.AWSGenericNodeSpec,
+		field:              field,
+	}
+}
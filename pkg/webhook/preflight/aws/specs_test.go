@@ -0,0 +1,152 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	capav1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+func TestNewConfigurationCheck(t *testing.T) {
+	tests := []struct {
+		name                    string
+		clusterConfig           any
+		workerConfigs           map[string]any
+		expectedAllowed         bool
+		expectedInternalError   bool
+		expectedRegion          *carenv1.Region
+		expectedIdentityRef     bool
+		expectedInfraField      string
+		expectedControlPlane    *machineSpec
+		expectedWorkersByMDName map[string]*machineSpec
+	}{
+		{
+			name:            "no variables",
+			expectedAllowed: true,
+		},
+		{
+			name: "AWS cluster with control plane and workers",
+			clusterConfig: carenv1.AWSClusterConfigSpec{
+				AWS: &carenv1.AWSSpec{
+					Region: ptr.To(carenv1.Region("us-west-2")),
+				},
+				ControlPlane: &carenv1.AWSControlPlaneSpec{
+					AWS: &carenv1.AWSControlPlaneNodeSpec{
+						IAMInstanceProfile: "control-plane-profile",
+						InstanceType:       "m5.xlarge",
+					},
+				},
+			},
+			workerConfigs: map[string]any{
+				"md-0": carenv1.AWSWorkerNodeConfigSpec{
+					AWS: &carenv1.AWSWorkerNodeSpec{
+						IAMInstanceProfile: "nodes-profile",
+						InstanceType:       "m5.2xlarge",
+					},
+				},
+			},
+			expectedAllowed:    true,
+			expectedRegion:     ptr.To(carenv1.Region("us-west-2")),
+			expectedInfraField: awsFieldPath,
+			expectedControlPlane: &machineSpec{
+				iamInstanceProfile: "control-plane-profile",
+				instanceType:       "m5.xlarge",
+				generic:            &carenv1.AWSGenericNodeSpec{},
+				field:              controlPlaneFieldPath,
+			},
+			expectedWorkersByMDName: map[string]*machineSpec{
+				"md-0": {
+					iamInstanceProfile: "nodes-profile",
+					instanceType:       "m5.2xlarge",
+					generic:            &carenv1.AWSGenericNodeSpec{},
+					field:              "$.spec.topology.workers.machineDeployments[?@.name==\"md-0\"].variables[?@.name=='workerConfig'].value.aws", ///nolint:lll // Field is long.
+				},
+			},
+		},
+		{
+			name: "EKS cluster with identity reference and workers",
+			clusterConfig: carenv1.EKSClusterConfigSpec{
+				EKS: &carenv1.EKSSpec{
+					Region:      ptr.To(carenv1.Region("eu-west-1")),
+					IdentityRef: &capav1.AWSIdentityReference{Name: "identity", Kind: capav1.ClusterRoleIdentityKind},
+				},
+			},
+			workerConfigs: map[string]any{
+				"md-0": carenv1.EKSWorkerNodeConfigSpec{
+					EKS: &carenv1.AWSWorkerNodeSpec{
+						InstanceType: "m5.large",
+					},
+				},
+			},
+			expectedAllowed:     true,
+			expectedRegion:      ptr.To(carenv1.Region("eu-west-1")),
+			expectedIdentityRef: true,
+			expectedInfraField:  eksFieldPath,
+			expectedWorkersByMDName: map[string]*machineSpec{
+				"md-0": {
+					instanceType: "m5.large",
+					generic:      &carenv1.AWSGenericNodeSpec{},
+					field:        "$.spec.topology.workers.machineDeployments[?@.name==\"md-0\"].variables[?@.name=='workerConfig'].value.eks", ///nolint:lll // Field is long.
+				},
+			},
+		},
+		{
+			name: "non-AWS cluster",
+			clusterConfig: carenv1.NutanixClusterConfigSpec{
+				ControlPlane: &carenv1.NutanixControlPlaneSpec{
+					Nutanix: &carenv1.NutanixControlPlaneNodeSpec{},
+				},
+			},
+			workerConfigs: map[string]any{
+				"md-0": carenv1.NutanixWorkerNodeConfigSpec{
+					Nutanix: &carenv1.NutanixWorkerNodeSpec{},
+				},
+			},
+			expectedAllowed: true,
+		},
+		{
+			name:                  "malformed cluster variable",
+			clusterConfig:         map[string]any{"aws": "not-an-object"},
+			expectedAllowed:       false,
+			expectedInternalError: true,
+		},
+		{
+			name: "malformed worker variable",
+			workerConfigs: map[string]any{
+				"md-0": map[string]any{"aws": "not-an-object"},
+			},
+			expectedAllowed:       false,
+			expectedInternalError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cd := &checkDependencies{
+				cluster: newTestCluster(t, tt.clusterConfig, tt.workerConfigs),
+				log:     logr.Discard(),
+			}
+
+			result := newConfigurationCheck(cd).Run(t.Context())
+
+			assert.Equal(t, tt.expectedAllowed, result.Allowed)
+			assert.Equal(t, tt.expectedInternalError, result.InternalError)
+			if !tt.expectedAllowed {
+				require.Len(t, result.Causes, 1)
+			}
+			assert.Equal(t, tt.expectedRegion, cd.region)
+			assert.Equal(t, tt.expectedIdentityRef, cd.identityRef)
+			assert.Equal(t, tt.expectedInfraField, cd.infrastructureField)
+			assert.Equal(t, tt.expectedControlPlane, cd.controlPlaneMachineSpec)
+			assert.Equal(t, tt.expectedWorkersByMDName, cd.workerMachineSpecByMachineDeploymentName)
+		})
+	}
+}
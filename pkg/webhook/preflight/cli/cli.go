@@ -0,0 +1,177 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cli runs the preflight checks for a Cluster read from manifest files, outside of the
+// preflight webhook. It is used to check cluster manifests, for example, in CI pipelines, before they
+// are applied.
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/skip"
+)
+
+const (
+	// ExitPassed is the exit code when all checks pass.
+	ExitPassed = 0
+
+	// ExitFailed is the exit code when any check fails.
+	ExitFailed = 1
+
+	// ExitError is the exit code when the checks cannot run, or any check fails to run.
+	ExitError = 2
+)
+
+// Options are the options of the preflight command.
+type Options struct {
+	// Filenames are the manifest files to read. "-" reads from standard input.
+	Filenames []string
+
+	// Namespace is the namespace of objects in the manifests that do not have one.
+	Namespace string
+
+	// Output is the output format, either OutputTable or OutputJSON.
+	Output string
+
+	// ReadFromCluster enables reading objects that are not in the manifests, for example, Secrets,
+	// from the cluster of the current kubeconfig.
+	ReadFromCluster bool
+
+	// Timeout is the duration that the checks have to run.
+	Timeout time.Duration
+}
+
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.StringSliceVarP(
+		&o.Filenames,
+		"filename",
+		"f",
+		nil,
+		"Manifest files that contain the Cluster, and the objects it references, such as Secrets. "+
+			"Use \"-\" to read from standard input.",
+	)
+	flags.StringVarP(
+		&o.Namespace,
+		"namespace",
+		"n",
+		"default",
+		"Namespace of objects in the manifests that do not have one",
+	)
+	flags.StringVarP(
+		&o.Output,
+		"output",
+		"o",
+		OutputTable,
+		fmt.Sprintf("Output format, one of %q or %q", OutputTable, OutputJSON),
+	)
+	flags.BoolVar(
+		&o.ReadFromCluster,
+		"read-from-cluster",
+		false,
+		"Read objects that are not in the manifests from the cluster of the current kubeconfig",
+	)
+	flags.DurationVar(
+		&o.Timeout,
+		"timeout",
+		preflight.Timeout,
+		"Duration that the preflight checks have to run",
+	)
+}
+
+// Validate returns an error if the options are invalid.
+func (o *Options) Validate() error {
+	if len(o.Filenames) == 0 {
+		return errors.New("at least one manifest file is required")
+	}
+	if o.Output != OutputTable && o.Output != OutputJSON {
+		return fmt.Errorf("unsupported output format %q", o.Output)
+	}
+	if o.Timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	return nil
+}
+
+// Run reads the manifests, runs the checks of the checkers for the Cluster, and writes the report to out.
+// If live is not nil, objects that are not in the manifests are read from live. It returns the exit code
+// of the report.
+func Run(
+	ctx context.Context,
+	opts *Options,
+	stdin io.Reader,
+	out io.Writer,
+	live ctrlclient.Reader,
+	checkers ...preflight.Checker,
+) (int, error) {
+	if err := opts.Validate(); err != nil {
+		return ExitError, err
+	}
+
+	readers := make([]io.Reader, 0, len(opts.Filenames))
+	for _, filename := range opts.Filenames {
+		if filename == "-" {
+			readers = append(readers, stdin)
+			continue
+		}
+		f, err := os.Open(filename)
+		if err != nil {
+			return ExitError, fmt.Errorf("failed to open manifest file: %w", err)
+		}
+		defer f.Close()
+		readers = append(readers, f)
+	}
+
+	scheme := NewScheme()
+	manifests, err := ReadManifests(scheme, opts.Namespace, readers...)
+	if err != nil {
+		return ExitError, err
+	}
+
+	report, err := Check(ctx, NewClient(scheme, manifests, live), manifests.Cluster, opts.Timeout, checkers...)
+	if err != nil {
+		return ExitError, err
+	}
+	if err := report.Write(out, opts.Output); err != nil {
+		return ExitError, fmt.Errorf("failed to write report: %w", err)
+	}
+	return report.ExitCode(), nil
+}
+
+// Check runs the checks of the checkers for the Cluster, as the preflight webhook does when the Cluster is
+// created, and returns the report.
+func Check(
+	ctx context.Context,
+	client ctrlclient.Client,
+	cluster *clusterv1.Cluster,
+	timeout time.Duration,
+	checkers ...preflight.Checker,
+) (*Report, error) {
+	// Checks run only for ClusterClass-based clusters.
+	if !cluster.Spec.Topology.IsDefined() {
+		return nil, fmt.Errorf(
+			"cluster %s does not have a topology, and preflight checks run only for clusters with a topology",
+			ctrlclient.ObjectKeyFromObject(cluster),
+		)
+	}
+
+	if skip.New(cluster).ForAll() {
+		report := newReport(nil)
+		report.Warnings = []string{"Cluster has skipped all preflight checks"}
+		return report, nil
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return newReport(preflight.RunChecks(checkCtx, client, cluster, nil, checkers...)), nil
+}
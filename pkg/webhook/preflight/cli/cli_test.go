@@ -0,0 +1,253 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+// secretChecker returns a check that passes if the Secret exists.
+type secretChecker struct {
+	key ctrlclient.ObjectKey
+}
+
+func (c *secretChecker) Init(
+	_ context.Context,
+	client ctrlclient.Client,
+	_, _ *clusterv1.Cluster,
+) []preflight.Check {
+	return []preflight.Check{&secretCheck{client: client, key: c.key}}
+}
+
+type secretCheck struct {
+	client ctrlclient.Client
+	key    ctrlclient.ObjectKey
+}
+
+func (c *secretCheck) Name() string {
+	return "Secret"
+}
+
+func (c *secretCheck) Run(ctx context.Context) preflight.CheckResult {
+	err := c.client.Get(ctx, c.key, &corev1.Secret{})
+	if err != nil {
+		return preflight.CheckResult{
+			Causes: []preflight.Cause{{
+				Message: err.Error(),
+				Field:   "$.spec.topology.variables[?@.name=='clusterConfig'].value.credentials",
+			}},
+		}
+	}
+	return preflight.CheckResult{Allowed: true, Warnings: []string{"found secret"}}
+}
+
+func writeManifest(t *testing.T, content string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(filename, []byte(content), 0o600))
+	return filename
+}
+
+func TestRun(t *testing.T) {
+	secretKey := ctrlclient.ObjectKey{Namespace: "other", Name: "test-secret"}
+	liveSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "test-secret"},
+	}
+
+	tests := []struct {
+		name             string
+		manifest         string
+		stdin            string
+		filenames        func(t *testing.T, manifest string) []string
+		live             ctrlclient.Reader
+		expectedExitCode int
+		expectedError    string
+		expectedOutput   []string
+	}{
+		{
+			name:             "secret in manifests",
+			manifest:         testClusterManifest + "---\n" + testSecretManifest,
+			expectedExitCode: ExitPassed,
+			expectedOutput:   []string{"Secret", "Passed", "found secret"},
+		},
+		{
+			name:             "secret not found",
+			manifest:         testClusterManifest,
+			expectedExitCode: ExitFailed,
+			expectedOutput: []string{
+				"Secret",
+				"Failed",
+				"$.spec.topology.variables[?@.name=='clusterConfig'].value.credentials",
+				`secrets "test-secret" not found`,
+			},
+		},
+		{
+			name:             "secret read from cluster",
+			manifest:         testClusterManifest,
+			live:             fake.NewClientBuilder().WithObjects(liveSecret).Build(),
+			expectedExitCode: ExitPassed,
+			expectedOutput:   []string{"Secret", "Passed"},
+		},
+		{
+			name:  "manifest read from standard input",
+			stdin: testClusterManifest + "---\n" + testSecretManifest,
+			filenames: func(_ *testing.T, _ string) []string {
+				return []string{"-"}
+			},
+			expectedExitCode: ExitPassed,
+			expectedOutput:   []string{"Secret", "Passed"},
+		},
+		{
+			name: "all checks skipped",
+			manifest: strings.Replace(
+				testClusterManifest,
+				"  name: test-cluster\n",
+				"  name: test-cluster\n  annotations:\n    preflight.cluster.caren.nutanix.com/skip: all\n",
+				1,
+			),
+			expectedExitCode: ExitPassed,
+			expectedOutput:   []string{"Warning", "Cluster has skipped all preflight checks"},
+		},
+		{
+			name: "cluster without topology",
+			manifest: "apiVersion: cluster.x-k8s.io/v1beta2\nkind: Cluster\n" +
+				"metadata:\n  name: test-cluster\n",
+			expectedExitCode: ExitError,
+			expectedError:    "does not have a topology",
+		},
+		{
+			name:     "missing file",
+			manifest: testClusterManifest,
+			filenames: func(t *testing.T, _ string) []string {
+				return []string{filepath.Join(t.TempDir(), "missing.yaml")}
+			},
+			expectedExitCode: ExitError,
+			expectedError:    "failed to open manifest file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filenames := []string{writeManifest(t, tt.manifest)}
+			if tt.filenames != nil {
+				filenames = tt.filenames(t, tt.manifest)
+			}
+			opts := &Options{
+				Filenames: filenames,
+				Namespace: "default",
+				Output:    OutputTable,
+				Timeout:   preflight.Timeout,
+			}
+			out := &bytes.Buffer{}
+
+			exitCode, err := Run(
+				t.Context(),
+				opts,
+				strings.NewReader(tt.stdin),
+				out,
+				tt.live,
+				&secretChecker{key: secretKey},
+			)
+
+			assert.Equal(t, tt.expectedExitCode, exitCode)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tt.expectedOutput {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
+
+func TestRun_JSONOutput(t *testing.T) {
+	opts := &Options{
+		Filenames: []string{writeManifest(t, testClusterManifest)},
+		Namespace: "default",
+		Output:    OutputJSON,
+		Timeout:   preflight.Timeout,
+	}
+	out := &bytes.Buffer{}
+
+	exitCode, err := Run(
+		t.Context(),
+		opts,
+		nil,
+		out,
+		nil,
+		&secretChecker{key: ctrlclient.ObjectKey{Namespace: "default", Name: "missing"}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ExitFailed, exitCode)
+
+	report := &Report{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), report))
+	assert.Equal(t, &Report{
+		Allowed: false,
+		Checks: []CheckReport{{
+			Name: "Secret",
+			Causes: []CauseReport{{
+				Message: `secrets "missing" not found`,
+				Field:   "$.spec.topology.variables[?@.name=='clusterConfig'].value.credentials",
+			}},
+		}},
+	}, report)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          Options
+		expectedError string
+	}{
+		{
+			name:          "no files",
+			opts:          Options{Output: OutputTable, Timeout: preflight.Timeout},
+			expectedError: "at least one manifest file is required",
+		},
+		{
+			name:          "unsupported output",
+			opts:          Options{Filenames: []string{"-"}, Output: "yaml", Timeout: preflight.Timeout},
+			expectedError: `unsupported output format "yaml"`,
+		},
+		{
+			name:          "no timeout",
+			opts:          Options{Filenames: []string{"-"}, Output: OutputJSON},
+			expectedError: "timeout must be positive",
+		},
+		{
+			name: "valid",
+			opts: Options{Filenames: []string{"-"}, Output: OutputJSON, Timeout: preflight.Timeout},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
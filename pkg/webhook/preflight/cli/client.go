@@ -0,0 +1,52 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// NewClient returns a client that reads the objects of the manifests. If live is not nil, objects that
+// are not in the manifests are read from live, for example, from the cluster of the current kubeconfig.
+func NewClient(scheme *runtime.Scheme, manifests *Manifests, live ctrlclient.Reader) ctrlclient.Client {
+	objects := make([]ctrlclient.Object, 0, len(manifests.Objects)+1)
+	objects = append(objects, manifests.Cluster.DeepCopy())
+	for _, obj := range manifests.Objects {
+		objects = append(objects, obj.DeepCopyObject().(ctrlclient.Object))
+	}
+
+	manifestsClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		Build()
+	if live == nil {
+		return manifestsClient
+	}
+	return &layeredClient{Client: manifestsClient, live: live}
+}
+
+// layeredClient reads objects from the manifests first, and from the live cluster if they are not in
+// the manifests. The preflight checks only get objects, so the other methods use the manifests only.
+type layeredClient struct {
+	ctrlclient.Client
+	live ctrlclient.Reader
+}
+
+func (c *layeredClient) Get(
+	ctx context.Context,
+	key ctrlclient.ObjectKey,
+	obj ctrlclient.Object,
+	opts ...ctrlclient.GetOption,
+) error {
+	err := c.Client.Get(ctx, key, obj, opts...)
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+	return c.live.Get(ctx, key, obj, opts...)
+}
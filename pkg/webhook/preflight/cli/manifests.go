@@ -0,0 +1,115 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	capxv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/github.com/nutanix-cloud-native/cluster-api-provider-nutanix/api/v1beta1"
+	capiutils "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/common/pkg/capi/utils"
+)
+
+// NewScheme returns a scheme with the types that the preflight checks read.
+func NewScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(clusterv1beta1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1.AddToScheme(scheme))
+	utilruntime.Must(capxv1.AddToScheme(scheme))
+	return scheme
+}
+
+// Manifests are the objects read from manifest files.
+type Manifests struct {
+	// Cluster is the Cluster to check.
+	Cluster *clusterv1.Cluster
+
+	// Objects are the other objects, for example, Secrets, that the checks may read.
+	Objects []ctrlclient.Object
+}
+
+// ReadManifests reads the objects from the YAML or JSON documents of the readers. The documents must
+// contain exactly one Cluster, which is converted to v1beta2 if it is a v1beta1 Cluster. Objects without
+// a namespace are put in the given namespace. Objects of kinds that are not in the scheme are skipped,
+// because the checks do not read them.
+func ReadManifests(scheme *runtime.Scheme, namespace string, readers ...io.Reader) (*Manifests, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	manifests := &Manifests{}
+	for _, r := range readers {
+		yamlReader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+		for {
+			doc, err := yamlReader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to read manifest: %w", err)
+			}
+			if isEmptyDocument(doc) {
+				continue
+			}
+
+			obj, gvk, err := decoder.Decode(doc, nil, nil)
+			if runtime.IsNotRegisteredError(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode manifest: %w", err)
+			}
+			if v1beta1Cluster, ok := obj.(*clusterv1beta1.Cluster); ok {
+				obj, err = capiutils.ConvertV1Beta1ClusterToV1Beta2(v1beta1Cluster)
+				if err != nil {
+					return nil, err
+				}
+			}
+			clientObj, ok := obj.(ctrlclient.Object)
+			if !ok {
+				return nil, fmt.Errorf("unsupported object of kind %s", gvk.Kind)
+			}
+			if clientObj.GetNamespace() == "" {
+				clientObj.SetNamespace(namespace)
+			}
+
+			if cluster, ok := clientObj.(*clusterv1.Cluster); ok {
+				if manifests.Cluster != nil {
+					return nil, fmt.Errorf(
+						"manifests must contain exactly one Cluster, found %s and %s",
+						ctrlclient.ObjectKeyFromObject(manifests.Cluster),
+						ctrlclient.ObjectKeyFromObject(cluster),
+					)
+				}
+				manifests.Cluster = cluster
+				continue
+			}
+			manifests.Objects = append(manifests.Objects, clientObj)
+		}
+	}
+
+	if manifests.Cluster == nil {
+		return nil, errors.New("manifests must contain exactly one Cluster, found none")
+	}
+	return manifests, nil
+}
+
+// isEmptyDocument returns true if the document contains only whitespace and comments.
+func isEmptyDocument(doc []byte) bool {
+	var obj map[string]any
+	if err := utilyaml.Unmarshal(doc, &obj); err != nil {
+		return false
+	}
+	return len(obj) == 0
+}
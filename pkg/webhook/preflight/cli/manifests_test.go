@@ -0,0 +1,126 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const testClusterManifest = `
+apiVersion: cluster.x-k8s.io/v1beta2
+kind: Cluster
+metadata:
+  name: test-cluster
+spec:
+  topology:
+    classRef:
+      name: test-class
+    version: v1.33.0
+`
+
+const testV1Beta1ClusterManifest = `
+apiVersion: cluster.x-k8s.io/v1beta1
+kind: Cluster
+metadata:
+  name: test-cluster
+spec:
+  topology:
+    class: test-class
+    version: v1.33.0
+`
+
+const testSecretManifest = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: test-secret
+  namespace: other
+stringData:
+  key: value
+`
+
+func TestReadManifests(t *testing.T) {
+	tests := []struct {
+		name            string
+		manifests       []string
+		expectedError   string
+		expectedObjects []string
+	}{
+		{
+			name:      "cluster only",
+			manifests: []string{testClusterManifest},
+		},
+		{
+			name: "cluster and secret in one file, with empty documents",
+			manifests: []string{
+				"# comment\n---\n" + testClusterManifest + "---\n" + testSecretManifest + "---\n",
+			},
+			expectedObjects: []string{"other/test-secret"},
+		},
+		{
+			name:            "cluster and secret in separate files",
+			manifests:       []string{testSecretManifest, testClusterManifest},
+			expectedObjects: []string{"other/test-secret"},
+		},
+		{
+			name:          "no cluster",
+			manifests:     []string{testSecretManifest},
+			expectedError: "manifests must contain exactly one Cluster, found none",
+		},
+		{
+			name:          "two clusters",
+			manifests:     []string{testClusterManifest, testClusterManifest},
+			expectedError: "manifests must contain exactly one Cluster, found test-ns/test-cluster and test-ns/test-cluster",
+		},
+		{
+			name:      "v1beta1 cluster",
+			manifests: []string{testV1Beta1ClusterManifest},
+		},
+		{
+			name: "unknown kind is skipped",
+			manifests: []string{
+				testClusterManifest,
+				"apiVersion: example.com/v1\nkind: Unknown\nmetadata:\n  name: test\n",
+			},
+		},
+		{
+			name:          "invalid manifest",
+			manifests:     []string{"kind: Secret\nmetadata:\n  name: test\n"},
+			expectedError: "failed to decode manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readers := make([]io.Reader, 0, len(tt.manifests))
+			for _, manifest := range tt.manifests {
+				readers = append(readers, strings.NewReader(manifest))
+			}
+
+			manifests, err := ReadManifests(NewScheme(), "test-ns", readers...)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "test-ns", manifests.Cluster.Namespace)
+			assert.Equal(t, "test-cluster", manifests.Cluster.Name)
+			assert.Equal(t, "test-class", manifests.Cluster.Spec.Topology.ClassRef.Name)
+
+			objects := make([]string, 0, len(manifests.Objects))
+			for _, obj := range manifests.Objects {
+				require.IsType(t, &corev1.Secret{}, obj)
+				objects = append(objects, obj.GetNamespace()+"/"+obj.GetName())
+			}
+			assert.ElementsMatch(t, tt.expectedObjects, objects)
+		})
+	}
+}
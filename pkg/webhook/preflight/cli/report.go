@@ -0,0 +1,142 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+const (
+	// OutputTable writes the results as a table, with one row per cause or warning.
+	OutputTable = "table"
+
+	// OutputJSON writes the results as a JSON document.
+	OutputJSON = "json"
+)
+
+// Report is the result of running the preflight checks for a Cluster.
+type Report struct {
+	// Allowed is true if all checks passed.
+	Allowed bool `json:"allowed"`
+
+	// InternalError is true if any check failed to run.
+	InternalError bool `json:"internalError"`
+
+	// Checks are the results of the checks, in the order the checks ran.
+	Checks []CheckReport `json:"checks"`
+
+	// Warnings are warnings that do not belong to any check.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// CheckReport is the result of a single check.
+type CheckReport struct {
+	Name          string        `json:"name"`
+	Allowed       bool          `json:"allowed"`
+	InternalError bool          `json:"internalError,omitempty"`
+	Causes        []CauseReport `json:"causes,omitempty"`
+	Warnings      []string      `json:"warnings,omitempty"`
+}
+
+// CauseReport is a cause of a check failure, with the path of the field it relates to, if any.
+type CauseReport struct {
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+func newReport(results []preflight.NamedResult) *Report {
+	report := &Report{
+		Allowed: true,
+		Checks:  make([]CheckReport, 0, len(results)),
+	}
+	for _, result := range results {
+		check := CheckReport{
+			Name:          result.Name,
+			Allowed:       result.Allowed,
+			InternalError: result.InternalError,
+			Warnings:      result.Warnings,
+		}
+		for _, cause := range result.Causes {
+			check.Causes = append(check.Causes, CauseReport{
+				Message: cause.Message,
+				Field:   cause.Field,
+			})
+		}
+		report.Checks = append(report.Checks, check)
+
+		if !result.Allowed {
+			report.Allowed = false
+		}
+		if result.InternalError {
+			report.InternalError = true
+		}
+	}
+	return report
+}
+
+// ExitCode returns ExitPassed if all checks passed, ExitFailed if any check failed, and ExitError
+// if any check failed to run.
+func (r *Report) ExitCode() int {
+	switch {
+	case r.InternalError:
+		return ExitError
+	case !r.Allowed:
+		return ExitFailed
+	default:
+		return ExitPassed
+	}
+}
+
+// Write writes the report in the given output format.
+func (r *Report) Write(out io.Writer, output string) error {
+	switch output {
+	case OutputJSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r)
+	case OutputTable:
+		return r.writeTable(out)
+	default:
+		return fmt.Errorf("unsupported output format %q", output)
+	}
+}
+
+func (r *Report) writeTable(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tRESULT\tFIELD\tMESSAGE")
+	for _, warning := range r.Warnings {
+		fmt.Fprintf(w, "\t%s\t\t%s\n", "Warning", oneLine(warning))
+	}
+	for _, check := range r.Checks {
+		result := "Passed"
+		switch {
+		case check.InternalError:
+			result = "Error"
+		case !check.Allowed:
+			result = "Failed"
+		}
+
+		if len(check.Causes) == 0 {
+			fmt.Fprintf(w, "%s\t%s\t\t\n", check.Name, result)
+		}
+		for _, cause := range check.Causes {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", check.Name, result, cause.Field, oneLine(cause.Message))
+		}
+		for _, warning := range check.Warnings {
+			fmt.Fprintf(w, "%s\t%s\t\t%s\n", check.Name, "Warning", oneLine(warning))
+		}
+	}
+	return w.Flush()
+}
+
+// oneLine replaces line breaks, so that a message does not break the table.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
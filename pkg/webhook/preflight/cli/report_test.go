@@ -0,0 +1,84 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
+)
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		name             string
+		results          []preflight.NamedResult
+		expectedExitCode int
+		expectedTable    string
+	}{
+		{
+			name: "all passed",
+			results: []preflight.NamedResult{
+				{Name: "Check1", CheckResult: preflight.CheckResult{Allowed: true}},
+				{Name: "Check2", CheckResult: preflight.CheckResult{
+					Allowed:  true,
+					Warnings: []string{"some\nwarning"},
+				}},
+			},
+			expectedExitCode: ExitPassed,
+			expectedTable: "" +
+				"CHECK    RESULT    FIELD   MESSAGE\n" +
+				"Check1   Passed            \n" +
+				"Check2   Passed            \n" +
+				"Check2   Warning           some warning\n",
+		},
+		{
+			name: "failed",
+			results: []preflight.NamedResult{
+				{Name: "Check1", CheckResult: preflight.CheckResult{Allowed: true}},
+				{Name: "Check2", CheckResult: preflight.CheckResult{
+					Causes: []preflight.Cause{
+						{Message: "first", Field: "$.spec.a"},
+						{Message: "second"},
+					},
+				}},
+			},
+			expectedExitCode: ExitFailed,
+			expectedTable: "" +
+				"CHECK    RESULT   FIELD      MESSAGE\n" +
+				"Check1   Passed              \n" +
+				"Check2   Failed   $.spec.a   first\n" +
+				"Check2   Failed              second\n",
+		},
+		{
+			name: "internal error",
+			results: []preflight.NamedResult{
+				{Name: "Check1", CheckResult: preflight.CheckResult{}},
+				{Name: "Check2", CheckResult: preflight.CheckResult{
+					InternalError: true,
+					Causes:        []preflight.Cause{{Message: "timed out"}},
+				}},
+			},
+			expectedExitCode: ExitError,
+			expectedTable: "" +
+				"CHECK    RESULT   FIELD   MESSAGE\n" +
+				"Check1   Failed           \n" +
+				"Check2   Error            timed out\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := newReport(tt.results)
+			assert.Equal(t, tt.expectedExitCode, report.ExitCode())
+
+			out := &bytes.Buffer{}
+			require.NoError(t, report.Write(out, OutputTable))
+			assert.Equal(t, tt.expectedTable, out.String())
+		})
+	}
+}
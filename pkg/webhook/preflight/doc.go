@@ -2,7 +2,10 @@
 // SPDX-License-Identifier: Apache-2.0
 package preflight
 
-// +kubebuilder:webhook:path=/preflight-cluster,mutating=false,failurePolicy=fail,groups="cluster.x-k8s.io",resources=clusters,verbs=create;update,versions=v1beta2,name=preflight.cluster.caren.nutanix.com,admissionReviewVersions=v1,sideEffects=None,timeoutSeconds=30
+// +kubebuilder:webhook:path=/preflight-cluster,mutating=false,failurePolicy=fail,groups="cluster.x-k8s.io",resources=clusters,verbs=create;update,versions=v1beta2,name=preflight.cluster.caren.nutanix.com,admissionReviewVersions=v1,sideEffects=NoneOnDryRun,timeoutSeconds=30
+
+// Report-only checks set a condition on the Cluster.
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters/status,verbs=get;patch
 
 // NOTE The webhook is not configured to handle the status subresource. This means that update
 // operations on the status subresource will not trigger the webhook.
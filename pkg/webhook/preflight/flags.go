@@ -0,0 +1,25 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type Options struct {
+	// ResultCacheTTL is how long the results of cacheable checks are reused. Zero disables the cache.
+	ResultCacheTTL time.Duration
+}
+
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(
+		&o.ResultCacheTTL,
+		"preflight-result-cache-ttl",
+		5*time.Minute,
+		"How long the results of preflight checks that call an infrastructure API are reused for "+
+			"Clusters with the same check input. Set to 0 to disable the cache.",
+	)
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	checkDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "caren",
+			Subsystem: "preflight",
+			Name:      "check_duration_seconds",
+			Help:      "Duration of preflight checks, by check, allowed and internal error.",
+			// Buckets up to Timeout, so that checks timing out can be identified.
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 15, 20, 25, 30},
+		},
+		[]string{"check", "allowed", "internal_error"},
+	)
+	checkTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "caren",
+			Subsystem: "preflight",
+			Name:      "checks_total",
+			Help:      "Total number of preflight checks run, by check, allowed and internal error.",
+		},
+		[]string{"check", "allowed", "internal_error"},
+	)
+	checkCacheHitsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "caren",
+			Subsystem: "preflight",
+			Name:      "check_cache_hits_total",
+			Help:      "Total number of preflight check results returned from the result cache, by check.",
+		},
+		[]string{"check"},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(checkDuration, checkTotal, checkCacheHitsTotal)
+}
+
+// observeCheck records the duration and result of a single preflight check.
+func observeCheck(name string, seconds float64, result CheckResult) {
+	allowed := strconv.FormatBool(result.Allowed)
+	internalError := strconv.FormatBool(result.InternalError)
+	checkDuration.WithLabelValues(name, allowed, internalError).Observe(seconds)
+	checkTotal.WithLabelValues(name, allowed, internalError).Inc()
+}
+
+// observeCheckCacheHit records that the result of a preflight check was returned from the result cache.
+func observeCheckCacheHit(name string) {
+	checkCacheHitsTotal.WithLabelValues(name).Inc()
+}
@@ -9,6 +9,8 @@ import (
 	v4Converged "github.com/nutanix-cloud-native/prism-go-client/converged/v4"
 	prismtypes "github.com/nutanix-cloud-native/prism-go-client/environment/types"
 	v3 "github.com/nutanix-cloud-native/prism-go-client/v3"
+
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
 )
 
 // NutanixClientCache is the cache of prism clients to be shared across the different controllers.
@@ -36,3 +38,14 @@ func (c *CacheParams) ManagementEndpoint() prismtypes.ManagementEndpoint {
 func (c *CacheParams) Key() string {
 	return c.ClusterNamespacedName.String()
 }
+
+// ResultCacheKey returns a key for the cached results of preflight checks that call the Prism Central API
+// with a client created from these parameters. It identifies the cluster, the Prism Central endpoint, and
+// the user, but not the password.
+func (c *CacheParams) ResultCacheKey() string {
+	address := ""
+	if c.PrismManagementEndpoint.Address != nil {
+		address = c.PrismManagementEndpoint.Address.String()
+	}
+	return preflight.CacheKey(c.Key(), address, c.PrismManagementEndpoint.Username)
+}
@@ -92,7 +92,10 @@ type checkDependencies struct {
 
 	nclient   client
 	pcVersion string
-	log       logr.Logger
+	// resultCacheKey identifies the Prism Central client, and is part of the cache key of every
+	// check that calls the Prism Central API.
+	resultCacheKey string
+	log            logr.Logger
 }
 
 func (n *nutanixChecker) Init(
@@ -48,6 +48,21 @@ func (c *cidrValidationCheck) Name() string {
 	return "NutanixCIDRValidation"
 }
 
+// CacheKey identifies the Pod and Service CIDRs, and the node subnets, which are resolved using the Prism Central API.
+func (c *cidrValidationCheck) CacheKey() string {
+	if c == nil || c.cd == nil || c.cd.cluster == nil || c.cd.resultCacheKey == "" {
+		return ""
+	}
+
+	// Key the subnets by field, because they are not collected in a stable order.
+	subnets := map[string][]*capxv1.NutanixResourceIdentifier{}
+	for _, subnet := range collectNutanixSubnets(c.cd) {
+		subnets[subnet.field] = []*capxv1.NutanixResourceIdentifier{&subnet.id, subnet.cluster}
+	}
+	cn := c.cd.cluster.Spec.ClusterNetwork
+	return preflight.CacheKey(c.cd.resultCacheKey, cn.Pods.CIDRBlocks, cn.Services.CIDRBlocks, subnets)
+}
+
 func (c *cidrValidationCheck) Run(ctx context.Context) preflight.CheckResult {
 	result := preflight.CheckResult{
 		Allowed: true,
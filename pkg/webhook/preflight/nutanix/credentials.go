@@ -201,12 +201,13 @@ func newCredentialsCheck(
 	}
 
 	// Initialize the Nutanix client (with optional additional trust bundle).
+	clusterNamespacedName := types.NamespacedName{
+		Name:      cd.cluster.Name,
+		Namespace: cd.cluster.Namespace,
+	}
 	nclient, err := nclientFactory(
 		credentials,
-		types.NamespacedName{
-			Name:      cd.cluster.Name,
-			Namespace: cd.cluster.Namespace,
-		},
+		clusterNamespacedName,
 		additionalTrustBundlePEM,
 	)
 	if err != nil {
@@ -224,19 +225,56 @@ func newCredentialsCheck(
 		return credentialsCheck
 	}
 
-	// Validate the credentials using an API call.
-	err = nclient.ValidateCredentials(ctx)
-	if err == nil {
-		// We initialized the converged client and verified the credentials using the Users API.
-		cd.nclient = nclient
+	// Validate the credentials using an API call. The result is cached, because the other checks are only
+	// initialized if the credentials are valid. The password is part of the key, so that the result is not reused
+	// after the credentials Secret changes. The key is a hash, so the password is not kept in memory.
+	credentialsCheck.result = preflight.CachedResult(
+		ctx,
+		credentialsCheck.Name(),
+		preflight.CacheKey(
+			clusterNamespacedName,
+			credentials.URL,
+			credentials.Username,
+			credentials.Password,
+			credentials.Insecure,
+			additionalTrustBundlePEM,
+		),
+		func() preflight.CheckResult {
+			return validateCredentials(ctx, nclient, additionalTrustBundlePEM)
+		},
+	)
+	if !credentialsCheck.result.Allowed {
 		return credentialsCheck
 	}
 
+	// We initialized the converged client and verified the credentials using the Users API.
+	cd.nclient = nclient
+	// Key the cached check results to the same parameters as the cached client.
+	if endpoint, err := buildManagementEndpoint(&credentials, additionalTrustBundlePEM); err == nil {
+		cd.resultCacheKey = (&CacheParams{
+			ClusterNamespacedName:   clusterNamespacedName,
+			PrismManagementEndpoint: endpoint,
+		}).ResultCacheKey()
+	}
+	return credentialsCheck
+}
+
+// validateCredentials validates the credentials of the client using an API call.
+func validateCredentials(ctx context.Context, nclient client, additionalTrustBundlePEM string) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
+	err := nclient.ValidateCredentials(ctx)
+	if err == nil {
+		return result
+	}
+
 	// Handle the different error cases.
 	switch {
 	case strings.Contains(err.Error(), "invalid Nutanix credentials"):
-		credentialsCheck.result.Allowed = false
-		credentialsCheck.result.Causes = append(credentialsCheck.result.Causes,
+		result.Allowed = false
+		result.Causes = append(result.Causes,
 			preflight.Cause{
 				Message: fmt.Sprintf(
 					"Failed to validate credentials: %s. Please check the username and/or password.", ///nolint:lll // Message is long.
@@ -246,9 +284,9 @@ func newCredentialsCheck(
 			},
 		)
 	case strings.Contains(err.Error(), "failed to verify certificate"):
-		credentialsCheck.result.Allowed = false
+		result.Allowed = false
 		if additionalTrustBundlePEM == "" {
-			credentialsCheck.result.Causes = append(credentialsCheck.result.Causes,
+			result.Causes = append(result.Causes,
 				preflight.Cause{
 					Message: fmt.Sprintf(
 						"Failed to verify certificate: %s. If you are using a self-signed certificate, you need to provide the additional trust bundle.", ///nolint:lll // Message is long.
@@ -258,7 +296,7 @@ func newCredentialsCheck(
 				},
 			)
 		} else {
-			credentialsCheck.result.Causes = append(credentialsCheck.result.Causes,
+			result.Causes = append(result.Causes,
 				preflight.Cause{
 					Message: fmt.Sprintf(
 						"Failed to verify certificate: %s. Please check the additional trust bundle.", ///nolint:lll // Message is long.
@@ -269,9 +307,9 @@ func newCredentialsCheck(
 			)
 		}
 	default:
-		credentialsCheck.result.Allowed = false
-		credentialsCheck.result.InternalError = true
-		credentialsCheck.result.Causes = append(credentialsCheck.result.Causes,
+		result.Allowed = false
+		result.InternalError = true
+		result.Causes = append(result.Causes,
 			preflight.Cause{
 				Message: fmt.Sprintf(
 					"Failed to validate credentials: %s. The error may be related to the URL, or the credentials. Please check both.", //nolint:lll // Message is long.
@@ -282,5 +320,5 @@ func newCredentialsCheck(
 		)
 	}
 
-	return credentialsCheck
+	return result
 }
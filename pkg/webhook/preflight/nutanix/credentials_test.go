@@ -35,6 +35,7 @@ func TestNewCredentialsCheck_Success(t *testing.T) {
 	assert.True(t, result.Allowed)
 	assert.False(t, result.InternalError)
 	assert.Empty(t, result.Causes)
+	assert.NotEmpty(t, cd.resultCacheKey, "expected the result cache key to be set with the client")
 }
 
 func TestNewCredentialsCheck_NoNutanixConfig(t *testing.T) {
@@ -28,6 +28,8 @@ type failureDomainCheck struct {
 	field             string
 	kclient           ctrlclient.Client
 	nclient           client
+	// resultCacheKey identifies the Prism Central client.
+	resultCacheKey string
 
 	// The error message set if error hit when adding the check
 	errMessage *string
@@ -37,6 +39,15 @@ func (fdc *failureDomainCheck) Name() string {
 	return "NutanixFailureDomain"
 }
 
+// CacheKey identifies the failure domain by name, so changes to the NutanixFailureDomain are only checked once the
+// cached result expires.
+func (fdc *failureDomainCheck) CacheKey() string {
+	if fdc.resultCacheKey == "" || fdc.errMessage != nil {
+		return ""
+	}
+	return preflight.CacheKey(fdc.resultCacheKey, fdc.field, fdc.namespace, fdc.failureDomainName)
+}
+
 func newFailureDomainChecks(cd *checkDependencies) []preflight.Check {
 	checks := []preflight.Check{}
 
@@ -60,6 +71,7 @@ func newFailureDomainChecks(cd *checkDependencies) []preflight.Check {
 						field:             "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.failureDomains", //nolint:lll // field is long.
 						kclient:           cd.kclient,
 						nclient:           cd.nclient,
+						resultCacheKey:    cd.resultCacheKey,
 					}
 					check.errMessage = ptr.To(err.Error())
 
@@ -74,6 +86,7 @@ func newFailureDomainChecks(cd *checkDependencies) []preflight.Check {
 						field:             "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.failureDomains", //nolint:lll // field is long.
 						kclient:           cd.kclient,
 						nclient:           cd.nclient,
+						resultCacheKey:    cd.resultCacheKey,
 					})
 				}
 			}
@@ -102,6 +115,7 @@ func newFailureDomainChecks(cd *checkDependencies) []preflight.Check {
 					field:             "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.failureDomains", //nolint:lll // field is long.
 					kclient:           cd.kclient,
 					nclient:           cd.nclient,
+					resultCacheKey:    cd.resultCacheKey,
 				}
 				check.errMessage = ptr.To(err.Error())
 
@@ -117,8 +131,9 @@ func newFailureDomainChecks(cd *checkDependencies) []preflight.Check {
 						"$.spec.topology.workers.machineDeployments[?@.name==%q].failureDomain",
 						md.Name,
 					),
-					kclient: cd.kclient,
-					nclient: cd.nclient,
+					kclient:        cd.kclient,
+					nclient:        cd.nclient,
+					resultCacheKey: cd.resultCacheKey,
 				})
 			}
 		}
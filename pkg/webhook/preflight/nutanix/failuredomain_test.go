@@ -22,6 +22,7 @@ import (
 
 	capxv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/external/github.com/nutanix-cloud-native/cluster-api-provider-nutanix/api/v1beta1"
 	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight"
 )
 
 func TestInitFailureDomainChecks(t *testing.T) {
@@ -367,3 +368,25 @@ func getK8sClient() ctrlclient.Client {
 	utilruntime.Must(capxv1.AddToScheme(scheme))
 	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(fdObj).Build()
 }
+
+func TestFailureDomainCheck_CacheKey(t *testing.T) {
+	newCheck := func(resultCacheKey, failureDomainName string) *failureDomainCheck {
+		return &failureDomainCheck{
+			failureDomainName: failureDomainName,
+			namespace:         "default",
+			field:             "test-field",
+			resultCacheKey:    resultCacheKey,
+		}
+	}
+
+	var _ preflight.CacheableCheck = newCheck("pc", "fd")
+
+	assert.Equal(t, newCheck("pc", "fd").CacheKey(), newCheck("pc", "fd").CacheKey())
+	assert.NotEqual(t, newCheck("pc", "fd").CacheKey(), newCheck("pc", "other-fd").CacheKey())
+	assert.NotEqual(t, newCheck("pc", "fd").CacheKey(), newCheck("other-pc", "fd").CacheKey())
+	assert.Empty(t, newCheck("", "fd").CacheKey(), "expected no caching without a Prism Central client key")
+
+	withError := newCheck("pc", "fd")
+	withError.errMessage = ptr.To("failed")
+	assert.Empty(t, withError.CacheKey(), "expected no caching of a check that failed to initialize")
+}
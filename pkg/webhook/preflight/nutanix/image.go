@@ -18,12 +18,20 @@ type imageCheck struct {
 	machineDetails *carenv1.NutanixMachineDetails
 	field          string
 	nclient        client
+	resultCacheKey string
 }
 
 func (c *imageCheck) Name() string {
 	return "NutanixVMImage"
 }
 
+func (c *imageCheck) CacheKey() string {
+	if c.resultCacheKey == "" {
+		return ""
+	}
+	return preflight.CacheKey(c.resultCacheKey, c.field, c.machineDetails.Image, c.machineDetails.ImageLookup)
+}
+
 func (c *imageCheck) Run(ctx context.Context) preflight.CheckResult {
 	result := preflight.CheckResult{
 		Allowed: false,
@@ -102,6 +110,7 @@ func newVMImageChecks(
 				machineDetails: &cd.nutanixClusterConfigSpec.ControlPlane.Nutanix.MachineDetails,
 				field:          "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.machineDetails", ///nolint:lll // Field is long.
 				nclient:        cd.nclient,
+				resultCacheKey: cd.resultCacheKey,
 			},
 		)
 	}
@@ -116,7 +125,8 @@ func newVMImageChecks(
 						"$.spec.topology.workers.machineDeployments[?@.name==%q].variables[?@.name=workerConfig].value.nutanix.machineDetails",
 						mdName,
 					),
-					nclient: cd.nclient,
+					nclient:        cd.nclient,
+					resultCacheKey: cd.resultCacheKey,
 				},
 			)
 		}
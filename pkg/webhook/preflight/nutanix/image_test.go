@@ -740,3 +740,25 @@ func TestNewVMImageChecks(t *testing.T) {
 		})
 	}
 }
+
+func TestVMImageCheck_CacheKey(t *testing.T) {
+	newCheck := func(resultCacheKey, image string) *imageCheck {
+		return &imageCheck{
+			machineDetails: &carenv1.NutanixMachineDetails{
+				Image: &capxv1.NutanixResourceIdentifier{
+					Type: capxv1.NutanixIdentifierName,
+					Name: ptr.To(image),
+				},
+			},
+			field:          "test-field",
+			resultCacheKey: resultCacheKey,
+		}
+	}
+
+	var _ preflight.CacheableCheck = newCheck("pc", "image")
+
+	assert.Equal(t, newCheck("pc", "image").CacheKey(), newCheck("pc", "image").CacheKey())
+	assert.NotEqual(t, newCheck("pc", "image").CacheKey(), newCheck("pc", "other-image").CacheKey())
+	assert.NotEqual(t, newCheck("pc", "image").CacheKey(), newCheck("other-pc", "image").CacheKey())
+	assert.Empty(t, newCheck("", "image").CacheKey(), "expected no caching without a Prism Central client key")
+}
@@ -19,12 +19,26 @@ type imageKubernetesVersionCheck struct {
 	field             string
 	nclient           client
 	clusterK8sVersion string
+	resultCacheKey    string
 }
 
 func (c *imageKubernetesVersionCheck) Name() string {
 	return "NutanixVMImageKubernetesVersion"
 }
 
+func (c *imageKubernetesVersionCheck) CacheKey() string {
+	if c.resultCacheKey == "" {
+		return ""
+	}
+	return preflight.CacheKey(
+		c.resultCacheKey,
+		c.field,
+		c.machineDetails.Image,
+		c.machineDetails.ImageLookup,
+		c.clusterK8sVersion,
+	)
+}
+
 func (c *imageKubernetesVersionCheck) Run(ctx context.Context) preflight.CheckResult {
 	if c.machineDetails.ImageLookup != nil {
 		return preflight.CheckResult{
@@ -152,6 +166,7 @@ func newVMImageKubernetesVersionChecks(
 				field:             "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.machineDetails", ///nolint:lll // Field is long.
 				nclient:           cd.nclient,
 				clusterK8sVersion: clusterK8sVersion,
+				resultCacheKey:    cd.resultCacheKey,
 			},
 		)
 	}
@@ -168,6 +183,7 @@ func newVMImageKubernetesVersionChecks(
 					),
 					nclient:           cd.nclient,
 					clusterK8sVersion: clusterK8sVersion,
+					resultCacheKey:    cd.resultCacheKey,
 				},
 			)
 		}
@@ -37,6 +37,8 @@ type metroCheck struct {
 	kclient    ctrlclient.Client
 	nclient    client
 	errMessage *string
+	// resultCacheKey identifies the Prism Central client.
+	resultCacheKey string
 }
 
 func failCheck(result *preflight.CheckResult, field, message string) {
@@ -56,6 +58,14 @@ func (mc *metroCheck) Name() string {
 	return nutanixMetroName
 }
 
+// CacheKey identifies the NutanixMetro by name. The metro checks share a name, so the key also identifies the check.
+func (mc *metroCheck) CacheKey() string {
+	if mc.resultCacheKey == "" || mc.errMessage != nil {
+		return ""
+	}
+	return preflight.CacheKey(mc.resultCacheKey, "metro", mc.field, mc.namespace, mc.metroName)
+}
+
 func newMetroChecks(cd *checkDependencies) []preflight.Check {
 	checks := []preflight.Check{}
 
@@ -70,12 +80,13 @@ func newMetroChecks(cd *checkDependencies) []preflight.Check {
 	var firstField string
 	for _, ref := range refs {
 		checks = append(checks, &metroCheck{
-			metroName:  ref.metroName,
-			namespace:  cd.cluster.Namespace,
-			field:      ref.field,
-			kclient:    cd.kclient,
-			nclient:    cd.nclient,
-			errMessage: ref.errMessage,
+			metroName:      ref.metroName,
+			namespace:      cd.cluster.Namespace,
+			field:          ref.field,
+			kclient:        cd.kclient,
+			nclient:        cd.nclient,
+			resultCacheKey: cd.resultCacheKey,
+			errMessage:     ref.errMessage,
 		})
 
 		// Only count successfully resolved metros towards the single-metro rule.
@@ -111,6 +122,7 @@ func newMetroChecks(cd *checkDependencies) []preflight.Check {
 				field:              firstField,
 				kclient:            cd.kclient,
 				nclient:            cd.nclient,
+				resultCacheKey:     cd.resultCacheKey,
 				errMessage:         errMessage,
 			},
 			// Prism Central must not reside on either of the metro's Prism
@@ -122,6 +134,7 @@ func newMetroChecks(cd *checkDependencies) []preflight.Check {
 				field:              firstField,
 				kclient:            cd.kclient,
 				nclient:            cd.nclient,
+				resultCacheKey:     cd.resultCacheKey,
 				errMessage:         errMessage,
 			},
 			// The network round-trip time between the two metro Prism Elements
@@ -133,6 +146,7 @@ func newMetroChecks(cd *checkDependencies) []preflight.Check {
 				field:              firstField,
 				kclient:            cd.kclient,
 				nclient:            cd.nclient,
+				resultCacheKey:     cd.resultCacheKey,
 				errMessage:         errMessage,
 			},
 			// Every Control Plane and Worker node pool must be placed on a
@@ -320,12 +334,37 @@ type clusterPrismElementScaleCheck struct {
 	kclient            ctrlclient.Client
 	nclient            client
 	errMessage         *string
+	resultCacheKey     string
 }
 
 func (c *clusterPrismElementScaleCheck) Name() string {
 	return nutanixMetroName
 }
 
+func (c *clusterPrismElementScaleCheck) CacheKey() string {
+	return failureDomainsCacheKey(
+		c.resultCacheKey,
+		"prismElementScale",
+		c.field,
+		c.namespace,
+		c.failureDomainNames,
+		c.errMessage,
+	)
+}
+
+// failureDomainsCacheKey returns the cache key of a metro check of the failure domains of the Cluster. The metro
+// checks share a name, so the key also identifies the check.
+func failureDomainsCacheKey(
+	resultCacheKey, check, field, namespace string,
+	failureDomainNames []string,
+	errMessage *string,
+) string {
+	if resultCacheKey == "" || errMessage != nil {
+		return ""
+	}
+	return preflight.CacheKey(resultCacheKey, check, field, namespace, failureDomainNames)
+}
+
 func (c *clusterPrismElementScaleCheck) Run(ctx context.Context) preflight.CheckResult {
 	result := preflight.CheckResult{Allowed: true}
 
@@ -409,12 +448,24 @@ type prismCentralMetroHostingCheck struct {
 	kclient            ctrlclient.Client
 	nclient            client
 	errMessage         *string
+	resultCacheKey     string
 }
 
 func (c *prismCentralMetroHostingCheck) Name() string {
 	return nutanixMetroName
 }
 
+func (c *prismCentralMetroHostingCheck) CacheKey() string {
+	return failureDomainsCacheKey(
+		c.resultCacheKey,
+		"prismCentralHosting",
+		c.field,
+		c.namespace,
+		c.failureDomainNames,
+		c.errMessage,
+	)
+}
+
 func (c *prismCentralMetroHostingCheck) Run(ctx context.Context) preflight.CheckResult {
 	result := preflight.CheckResult{Allowed: true}
 
@@ -473,12 +524,24 @@ type metroReplicationLatencyCheck struct {
 	kclient            ctrlclient.Client
 	nclient            client
 	errMessage         *string
+	resultCacheKey     string
 }
 
 func (c *metroReplicationLatencyCheck) Name() string {
 	return nutanixMetroName
 }
 
+func (c *metroReplicationLatencyCheck) CacheKey() string {
+	return failureDomainsCacheKey(
+		c.resultCacheKey,
+		"replicationLatency",
+		c.field,
+		c.namespace,
+		c.failureDomainNames,
+		c.errMessage,
+	)
+}
+
 func (c *metroReplicationLatencyCheck) Run(ctx context.Context) preflight.CheckResult {
 	result := preflight.CheckResult{Allowed: true}
 
@@ -55,11 +55,29 @@ func newPrismCentralVersionCheck(ctx context.Context, cd *checkDependencies) pre
 		return check
 	}
 
+	// The result is cached, because the other checks are only initialized if the version is supported.
+	check.result = preflight.CachedResult(ctx, check.Name(), cd.resultCacheKey, func() preflight.CheckResult {
+		return checkPrismCentralVersion(ctx, cd)
+	})
+	// A cached result does not include the version, so set a fake version to allow other checks to run.
+	if check.result.Allowed && cd.pcVersion == "" {
+		cd.pcVersion = "cached"
+	}
+	return check
+}
+
+// checkPrismCentralVersion gets the version of Prism Central, and checks that it is supported. It sets the version in
+// the check dependencies if it is supported.
+func checkPrismCentralVersion(ctx context.Context, cd *checkDependencies) preflight.CheckResult {
+	result := preflight.CheckResult{
+		Allowed: true,
+	}
+
 	version, err := cd.nclient.GetPrismCentralVersion(ctx)
 	if err != nil {
-		check.result.Allowed = false
-		check.result.InternalError = true
-		check.result.Causes = append(check.result.Causes, preflight.Cause{
+		result.Allowed = false
+		result.InternalError = true
+		result.Causes = append(result.Causes, preflight.Cause{
 			Message: fmt.Sprintf(
 				"Failed to get Prism Central version: %s. This is usually a temporary error. Please retry.",
 				err,
@@ -67,20 +85,20 @@ func newPrismCentralVersionCheck(ctx context.Context, cd *checkDependencies) pre
 			Field: prismCentralEndpointFieldPath,
 		})
 		// pcVersion remains empty on failure
-		return check
+		return result
 	}
 
 	lowerVersion := strings.ToLower(strings.TrimSpace(version))
 	if strings.Contains(lowerVersion, internalPrismCentralVersionLabel) {
 		cd.pcVersion = version
-		return check
+		return result
 	}
 
 	cleanVersion := nutanixutils.CleanPCVersion(lowerVersion)
 	if cleanVersion == "" {
-		check.result.Allowed = false
-		check.result.InternalError = false
-		check.result.Causes = append(check.result.Causes, preflight.Cause{
+		result.Allowed = false
+		result.InternalError = false
+		result.Causes = append(result.Causes, preflight.Cause{
 			Message: fmt.Sprintf(
 				"Prism Central reported version %q, which is not a valid version. Upgrade Prism Central to %s or later, wait for the upgrade to finish, then retry.", ///nolint:lll // Message includes full upgrade instruction.
 				version,
@@ -89,12 +107,12 @@ func newPrismCentralVersionCheck(ctx context.Context, cd *checkDependencies) pre
 			Field: prismCentralEndpointFieldPath,
 		})
 		// pcVersion remains empty on failure
-		return check
+		return result
 	}
 
 	if nutanixutils.ComparePCVersions(cleanVersion, minSupportedPrismCentralVersion) == -1 {
-		check.result.Allowed = false
-		check.result.Causes = append(check.result.Causes, preflight.Cause{
+		result.Allowed = false
+		result.Causes = append(result.Causes, preflight.Cause{
 			Message: fmt.Sprintf(
 				"Prism Central version %q is older than the minimum supported version %s. Upgrade Prism Central to %s or later, wait for the upgrade to finish, then retry.", ///nolint:lll // Message includes version and upgrade guidance.
 				version,
@@ -104,11 +122,11 @@ func newPrismCentralVersionCheck(ctx context.Context, cd *checkDependencies) pre
 			Field: prismCentralEndpointFieldPath,
 		})
 		// pcVersion remains empty on failure
-		return check
+		return result
 	}
 
 	cd.pcVersion = version
-	return check
+	return result
 }
 
 func (c *prismCentralVersionCheck) Name() string {
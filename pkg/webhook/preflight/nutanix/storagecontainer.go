@@ -33,6 +33,8 @@ type storageContainerCheck struct {
 	field   string
 	csiSpec *carenv1.CSIProvider
 	nclient client
+	// resultCacheKey identifies the Prism Central client.
+	resultCacheKey string
 
 	// The error message set if error hit when adding the check
 	errMessage *string
@@ -42,6 +44,24 @@ func (c *storageContainerCheck) Name() string {
 	return "NutanixStorageContainer"
 }
 
+func (c *storageContainerCheck) CacheKey() string {
+	if c.resultCacheKey == "" || c.errMessage != nil {
+		return ""
+	}
+	var clusterIdentifier *capxv1.NutanixResourceIdentifier
+	if c.machineSpec != nil {
+		clusterIdentifier = c.machineSpec.Cluster
+	}
+	return preflight.CacheKey(
+		c.resultCacheKey,
+		c.field,
+		c.namespace,
+		c.failureDomainName,
+		clusterIdentifier,
+		c.csiSpec.StorageClassConfigs,
+	)
+}
+
 func (c *storageContainerCheck) Run(ctx context.Context) preflight.CheckResult {
 	result := preflight.CheckResult{
 		Allowed: true,
@@ -245,6 +265,7 @@ func newStorageContainerChecks(cd *checkDependencies) []preflight.Check {
 							field:             "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.failureDomains", //nolint:lll // field is long.
 							kclient:           cd.kclient,
 							nclient:           cd.nclient,
+							resultCacheKey:    cd.resultCacheKey,
 						}
 						check.errMessage = ptr.To(err.Error())
 
@@ -261,6 +282,7 @@ func newStorageContainerChecks(cd *checkDependencies) []preflight.Check {
 								field:             "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.failureDomains", //nolint:lll // The field is long.
 								csiSpec:           &cd.nutanixClusterConfigSpec.Addons.CSI.Providers.NutanixCSI,
 								nclient:           cd.nclient,
+								resultCacheKey:    cd.resultCacheKey,
 							},
 						)
 					}
@@ -269,10 +291,11 @@ func newStorageContainerChecks(cd *checkDependencies) []preflight.Check {
 		} else {
 			checks = append(checks,
 				&storageContainerCheck{
-					machineSpec: &controlPlaneNutanix.MachineDetails,
-					field:       "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.machineDetails",
-					csiSpec:     &cd.nutanixClusterConfigSpec.Addons.CSI.Providers.NutanixCSI,
-					nclient:     cd.nclient,
+					machineSpec:    &controlPlaneNutanix.MachineDetails,
+					field:          "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.machineDetails",
+					csiSpec:        &cd.nutanixClusterConfigSpec.Addons.CSI.Providers.NutanixCSI,
+					nclient:        cd.nclient,
+					resultCacheKey: cd.resultCacheKey,
 				},
 			)
 		}
@@ -295,6 +318,7 @@ func newStorageContainerChecks(cd *checkDependencies) []preflight.Check {
 						field:             "$.spec.topology.variables[?@.name==\"clusterConfig\"].value.controlPlane.nutanix.failureDomains", //nolint:lll // field is long.
 						kclient:           cd.kclient,
 						nclient:           cd.nclient,
+						resultCacheKey:    cd.resultCacheKey,
 					}
 					check.errMessage = ptr.To(err.Error())
 
@@ -314,8 +338,9 @@ func newStorageContainerChecks(cd *checkDependencies) []preflight.Check {
 								"$.spec.topology.workers.machineDeployments[?@.name==%q].failureDomain",
 								mdName,
 							),
-							csiSpec: &cd.nutanixClusterConfigSpec.Addons.CSI.Providers.NutanixCSI,
-							nclient: cd.nclient,
+							csiSpec:        &cd.nutanixClusterConfigSpec.Addons.CSI.Providers.NutanixCSI,
+							nclient:        cd.nclient,
+							resultCacheKey: cd.resultCacheKey,
 						},
 					)
 				}
@@ -329,8 +354,9 @@ func newStorageContainerChecks(cd *checkDependencies) []preflight.Check {
 							"$.spec.topology.workers.machineDeployments[?@.name==%q].variables[?@.name=workerConfig].value.nutanix.machineDetails",
 							mdName,
 						),
-						csiSpec: &cd.nutanixClusterConfigSpec.Addons.CSI.Providers.NutanixCSI,
-						nclient: cd.nclient,
+						csiSpec:        &cd.nutanixClusterConfigSpec.Addons.CSI.Providers.NutanixCSI,
+						nclient:        cd.nclient,
+						resultCacheKey: cd.resultCacheKey,
 					},
 				)
 			}
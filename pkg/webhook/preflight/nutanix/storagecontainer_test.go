@@ -1669,3 +1669,28 @@ func TestGetClusters(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageContainerCheck_CacheKey(t *testing.T) {
+	newCheck := func(resultCacheKey, storageContainer string) *storageContainerCheck {
+		return &storageContainerCheck{
+			failureDomainName: "fd",
+			namespace:         "default",
+			field:             "test-field",
+			csiSpec: &carenv1.CSIProvider{
+				StorageClassConfigs: map[string]carenv1.StorageClassConfig{
+					"volume": {
+						Parameters: map[string]string{"storageContainer": storageContainer},
+					},
+				},
+			},
+			resultCacheKey: resultCacheKey,
+		}
+	}
+
+	var _ preflight.CacheableCheck = newCheck("pc", "container")
+
+	assert.Equal(t, newCheck("pc", "container").CacheKey(), newCheck("pc", "container").CacheKey())
+	assert.NotEqual(t, newCheck("pc", "container").CacheKey(), newCheck("pc", "other-container").CacheKey())
+	assert.NotEqual(t, newCheck("pc", "container").CacheKey(), newCheck("other-pc", "container").CacheKey())
+	assert.Empty(t, newCheck("", "container").CacheKey(), "expected no caching without a Prism Central client key")
+}
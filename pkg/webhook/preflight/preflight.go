@@ -15,12 +15,14 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
 	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/skip"
 )
 
@@ -55,6 +57,29 @@ type (
 		Run(ctx context.Context) CheckResult
 	}
 
+	// CacheableCheck is a Check whose result depends only on its input, and can be reused by later
+	// requests while the result is cached. Checks that call an infrastructure API should implement
+	// it, so that re-applying an unchanged Cluster does not repeat the API calls.
+	CacheableCheck interface {
+		Check
+
+		// CacheKey returns a key that identifies the input of the check, including the
+		// infrastructure API endpoint and user. Checks with the same name and key must return
+		// the same result. An empty key means the result must not be cached.
+		CacheKey() string
+	}
+
+	// DependentCheck is a Check that runs only after other checks of the same Checker have passed,
+	// e.g. a check that calls the API of an infrastructure region runs only if the region is available.
+	// If a dependency does not pass, the check does not run, and its result is a warning.
+	DependentCheck interface {
+		Check
+
+		// DependsOn returns the names of the checks that must pass before the check runs. Names of
+		// checks that are not run together with the check are ignored.
+		DependsOn() []string
+	}
+
 	// CheckResult represents the result of a check.
 	// It contains the name of the check, a boolean indicating whether the check passed, an
 	// error boolean indicating whether there was an internal error running the check, and a
@@ -99,18 +124,38 @@ type WebhookHandler struct {
 	client   ctrlclient.Client
 	decoder  admission.Decoder
 	checkers []Checker
+
+	// resultCache is nil if the result cache is disabled.
+	resultCache *resultCache
+
+	reportOnlyRunsLock sync.Mutex
+	reportOnlyRuns     map[types.NamespacedName]*reportOnlyRun
 }
 
 func New(client ctrlclient.Client, decoder admission.Decoder, checkers ...Checker) *WebhookHandler {
+	return NewWithOptions(client, decoder, Options{}, checkers...)
+}
+
+func NewWithOptions(
+	client ctrlclient.Client,
+	decoder admission.Decoder,
+	opts Options,
+	checkers ...Checker,
+) *WebhookHandler {
 	h := &WebhookHandler{
-		client:   client,
-		decoder:  decoder,
-		checkers: checkers,
+		client:         client,
+		decoder:        decoder,
+		checkers:       checkers,
+		reportOnlyRuns: make(map[types.NamespacedName]*reportOnlyRun),
+	}
+	if opts.ResultCacheTTL > 0 {
+		h.resultCache = newResultCache(opts.ResultCacheTTL)
 	}
 	return h
 }
 
-type namedResult struct {
+// NamedResult is the result of a check, with the name of the check.
+type NamedResult struct {
 	Name string
 	CheckResult
 }
@@ -186,55 +231,75 @@ func (h *WebhookHandler) Handle(ctx context.Context, req admission.Request) admi
 		)
 	}
 
+	checkers := h.checkers
+	reportOnlyEvaluator := newReportOnlyEvaluator(cluster)
+	deferred := &deferredChecks{}
+	if h.resultCache != nil || reportOnlyEvaluator.Any() {
+		checkers = make([]Checker, 0, len(h.checkers))
+		for _, checker := range h.checkers {
+			checkers = append(checkers, &handlerChecker{
+				Checker:             checker,
+				cache:               h.resultCache,
+				skipEvaluator:       skipEvaluator,
+				reportOnlyEvaluator: reportOnlyEvaluator,
+				deferred:            deferred,
+			})
+		}
+	}
+
 	// Reserve time for checks to handle context cancellation, so
 	// that we have time to summarize the results, and return a response.
 	checkTimeout := Timeout - 2*time.Second
 	checkCtx, checkCtxCancel := context.WithTimeout(ctx, checkTimeout)
+	if h.resultCache != nil {
+		checkCtx = withResultCache(checkCtx, h.resultCache)
+	}
 	log.V(5).Info("Running preflight checks")
-	resultsOrderedByCheckerAndCheck := run(checkCtx, h.client, cluster, oldCluster, skipEvaluator, h.checkers)
+	resultsOrderedByCheckerAndCheck := run(checkCtx, h.client, cluster, oldCluster, skipEvaluator, checkers)
 	checkCtxCancel()
 
 	// Summarize the results.
+	allowed, internalError, causes, warnings := summarize(resultsOrderedByCheckerAndCheck)
 	resp := admission.Response{
 		AdmissionResponse: admissionv1.AdmissionResponse{
-			Allowed: true,
+			Allowed: allowed,
 			Result: &metav1.Status{
-				Details: &metav1.StatusDetails{},
+				Details: &metav1.StatusDetails{
+					Causes: causes,
+				},
 			},
+			Warnings: warnings,
 		},
 	}
-	internalError := false
-	for _, results := range resultsOrderedByCheckerAndCheck {
-		for _, result := range results {
-			if result.InternalError {
-				internalError = true
-			}
-			if !result.Allowed {
-				resp.Allowed = false
-			}
-			for _, cause := range result.Causes {
-				resp.Result.Details.Causes = append(resp.Result.Details.Causes,
-					metav1.StatusCause{
-						Type:    metav1.CauseType(result.Name),
-						Message: cause.Message,
-						Field:   cause.Field,
-					},
-				)
-			}
-			resp.Warnings = append(resp.Warnings, result.Warnings...)
-		}
-	}
+	humanReadableCausesSummary := SummarizeCauses(causes)
 
-	humanReadableCausesSummary := strings.Builder{}
-	for i, cause := range resp.Result.Details.Causes {
-		if i > 0 {
-			humanReadableCausesSummary.WriteString("; ")
+	if reportOnlyChecks := deferred.list(); len(reportOnlyChecks) > 0 {
+		names := make([]string, 0, len(reportOnlyChecks))
+		for _, check := range reportOnlyChecks {
+			names = append(names, check.Name())
 		}
-		causeSummary := fmt.Sprintf("%s: %s", cause.Type, cause.Message)
-		if cause.Field != "" {
-			causeSummary += fmt.Sprintf(" (field: %s)", cause.Field)
+		switch {
+		case ptr.Deref(req.DryRun, false):
+			// Report-only checks write their results to the Cluster, so they must not run for dry-run requests.
+			resp.Warnings = append(resp.Warnings, fmt.Sprintf(
+				"Report-only preflight checks %s do not run for dry-run requests",
+				strings.Join(names, ", "),
+			))
+		case !resp.Allowed:
+			// The Cluster is not created or updated, so there is no Cluster to report the results on, or the
+			// results would be for a spec that the Cluster does not have.
+			resp.Warnings = append(resp.Warnings, fmt.Sprintf(
+				"Report-only preflight checks %s do not run for rejected requests",
+				strings.Join(names, ", "),
+			))
+		default:
+			h.runReportOnly(ctx, cluster, reportOnlyChecks)
+			resp.Warnings = append(resp.Warnings, fmt.Sprintf(
+				"Report-only preflight checks %s run asynchronously, and report their results in the %s condition of the Cluster", //nolint:lll // Message is long.
+				strings.Join(names, ", "),
+				carenv1.PreflightChecksPassedCondition,
+			))
 		}
-		humanReadableCausesSummary.WriteString(causeSummary)
 	}
 
 	switch {
@@ -242,7 +307,7 @@ func (h *WebhookHandler) Handle(ctx context.Context, req admission.Request) admi
 		// Internal errors take precedence over check failures.
 		resp.Result.Message = fmt.Sprintf(
 			"preflight checks failed due to an internal error: %s",
-			humanReadableCausesSummary.String(),
+			humanReadableCausesSummary,
 		)
 		resp.Result.Code = http.StatusInternalServerError
 		resp.Result.Reason = metav1.StatusReasonInternalError
@@ -251,7 +316,7 @@ func (h *WebhookHandler) Handle(ctx context.Context, req admission.Request) admi
 		// Because the response is not allowed, preflights must have failed.
 		resp.Result.Message = fmt.Sprintf(
 			"preflight checks failed: %s",
-			humanReadableCausesSummary.String(),
+			humanReadableCausesSummary,
 		)
 		resp.Result.Code = http.StatusUnprocessableEntity
 		resp.Result.Reason = metav1.StatusReasonInvalid
@@ -263,8 +328,78 @@ func (h *WebhookHandler) Handle(ctx context.Context, req admission.Request) admi
 	return resp
 }
 
+// RunChecks runs the checks of all checkers for the cluster, the same way the webhook does, and returns
+// the results ordered by checker and check. Checks named in the skip annotation of the cluster are skipped.
+// Unlike the webhook, it runs report-only checks like any other check, and does not cache results.
+// It is used to run the checks outside of the webhook, for example, from the command line.
+func RunChecks(
+	ctx context.Context,
+	client ctrlclient.Client,
+	cluster *clusterv1.Cluster,
+	oldCluster *clusterv1.Cluster,
+	checkers ...Checker,
+) []NamedResult {
+	results := []NamedResult{}
+	for _, resultsOrderedByCheck := range run(ctx, client, cluster, oldCluster, skip.New(cluster), checkers) {
+		results = append(results, resultsOrderedByCheck...)
+	}
+	return results
+}
+
+// summarize aggregates the results of checks. The results are allowed only if every check is allowed.
+func summarize(resultsOrderedByCheckerAndCheck [][]NamedResult) (
+	allowed bool,
+	internalError bool,
+	causes []metav1.StatusCause,
+	warnings []string,
+) {
+	allowed = true
+	for _, results := range resultsOrderedByCheckerAndCheck {
+		for _, result := range results {
+			if result.InternalError {
+				internalError = true
+			}
+			if !result.Allowed {
+				allowed = false
+			}
+			for _, cause := range result.Causes {
+				causes = append(causes,
+					metav1.StatusCause{
+						Type:    metav1.CauseType(result.Name),
+						Message: cause.Message,
+						Field:   cause.Field,
+					},
+				)
+			}
+			warnings = append(warnings, result.Warnings...)
+		}
+	}
+	return allowed, internalError, causes, warnings
+}
+
+// SummarizeCauses returns a human-readable summary of the causes. Each cause is prefixed with its type,
+// which is the name of the check for the causes of preflight checks, unless the type is empty.
+func SummarizeCauses(causes []metav1.StatusCause) string {
+	humanReadableCausesSummary := strings.Builder{}
+	for i, cause := range causes {
+		if i > 0 {
+			humanReadableCausesSummary.WriteString("; ")
+		}
+		causeSummary := cause.Message
+		if cause.Type != "" {
+			causeSummary = fmt.Sprintf("%s: %s", cause.Type, cause.Message)
+		}
+		if cause.Field != "" {
+			causeSummary += fmt.Sprintf(" (field: %s)", cause.Field)
+		}
+		humanReadableCausesSummary.WriteString(causeSummary)
+	}
+	return humanReadableCausesSummary.String()
+}
+
 // run runs all checks for the cluster, concurrently, and returns the results ordered by checker and check.
-// Checker are initialized concurrently, and checks runs concurrently as well.
+// Checker are initialized concurrently, and checks runs concurrently as well, except for checks that
+// depend on other checks.
 // oldCluster is the cluster state before an Update operation and nil for Create operations.
 func run(ctx context.Context,
 	client ctrlclient.Client,
@@ -272,8 +407,8 @@ func run(ctx context.Context,
 	oldCluster *clusterv1.Cluster,
 	skipEvaluator *skip.Evaluator,
 	checkers []Checker,
-) [][]namedResult {
-	resultsOrderedByCheckerAndCheck := make([][]namedResult, len(checkers))
+) [][]NamedResult {
+	resultsOrderedByCheckerAndCheck := make([][]NamedResult, len(checkers))
 
 	checkersWG := sync.WaitGroup{}
 	for i, checker := range checkers {
@@ -290,73 +425,7 @@ func run(ctx context.Context,
 			defer checkersWG.Done()
 
 			checks := checker.Init(ctx, client, cluster, oldCluster)
-			resultsOrderedByCheck := make([]namedResult, len(checks))
-
-			checksWG := sync.WaitGroup{}
-			for j, check := range checks {
-				ctrl.LoggerFrom(ctx).V(5).Info(
-					"running preflight check",
-					"checkName", check.Name(),
-				)
-				if skipEvaluator.For(check.Name()) {
-					ctrl.LoggerFrom(ctx).V(5).Info(
-						"Skipping preflight check",
-						"checkName", check.Name(),
-					)
-					resultsOrderedByCheck[j] = namedResult{
-						Name: check.Name(),
-						CheckResult: CheckResult{
-							Allowed:       true,
-							InternalError: false,
-							Causes:        nil,
-							Warnings: []string{
-								fmt.Sprintf("Cluster has skipped preflight check %q", check.Name()),
-							},
-						},
-					}
-					continue
-				}
-				checksWG.Add(1)
-				go func(
-					ctx context.Context,
-					check Check,
-					j int,
-				) {
-					defer checksWG.Done()
-					defer func() {
-						if r := recover(); r != nil {
-							resultsOrderedByCheck[j] = namedResult{
-								Name: check.Name(),
-								CheckResult: CheckResult{
-									InternalError: true,
-									Causes: []Cause{
-										{
-											Message: fmt.Sprintf(
-												"The preflight check code had a specific internal error called a \"panic\". This error should not happen under normal circumstances. Please report it, and include the following information: %s", ///nolint:lll // Message is long.
-												r,
-											),
-											Field: "",
-										},
-									},
-								},
-							}
-							ctrl.LoggerFrom(ctx).Error(
-								fmt.Errorf("preflight check panic"),
-								fmt.Sprintf("%v", r),
-								"checkName", check.Name(),
-								"stackTrace", string(debug.Stack()),
-							)
-						}
-					}()
-					result := check.Run(ctx)
-					resultsOrderedByCheck[j] = namedResult{
-						Name:        check.Name(),
-						CheckResult: result,
-					}
-				}(ctx, check, j)
-			}
-			checksWG.Wait()
-			resultsOrderedByCheckerAndCheck[i] = resultsOrderedByCheck
+			resultsOrderedByCheckerAndCheck[i] = runChecks(ctx, checks, skipEvaluator)
 		}(
 			ctx,
 			client,
@@ -371,3 +440,172 @@ func run(ctx context.Context,
 
 	return resultsOrderedByCheckerAndCheck
 }
+
+// runChecks runs the checks concurrently, and returns the results ordered by check. A DependentCheck
+// runs after the checks it depends on, and only if they pass. Checks skipped by the skipEvaluator pass
+// without running. skipEvaluator may be nil, if no checks are skipped.
+func runChecks(ctx context.Context, checks []Check, skipEvaluator *skip.Evaluator) []NamedResult {
+	results := make([]NamedResult, len(checks))
+	// Check names are not unique, e.g. a check runs once for every MachineDeployment.
+	indexesByName := make(map[string][]int, len(checks))
+	for j, check := range checks {
+		indexesByName[check.Name()] = append(indexesByName[check.Name()], j)
+	}
+	done := make([]bool, len(checks))
+	passed := make([]bool, len(checks))
+
+	for remaining := len(checks); remaining > 0; {
+		ready := make([]int, 0, remaining)
+		for j, check := range checks {
+			if !done[j] && dependenciesDone(check, indexesByName, done) {
+				ready = append(ready, j)
+			}
+		}
+		if len(ready) == 0 {
+			// The remaining checks depend on each other, so none can wait for the others.
+			for j := range checks {
+				if !done[j] {
+					ready = append(ready, j)
+				}
+			}
+		}
+
+		checksWG := sync.WaitGroup{}
+		for _, j := range ready {
+			check := checks[j]
+			ctrl.LoggerFrom(ctx).V(5).Info(
+				"running preflight check",
+				"checkName", check.Name(),
+			)
+			if skipEvaluator != nil && skipEvaluator.For(check.Name()) {
+				ctrl.LoggerFrom(ctx).V(5).Info(
+					"Skipping preflight check",
+					"checkName", check.Name(),
+				)
+				results[j] = NamedResult{
+					Name: check.Name(),
+					CheckResult: CheckResult{
+						Allowed:       true,
+						InternalError: false,
+						Causes:        nil,
+						Warnings: []string{
+							fmt.Sprintf("Cluster has skipped preflight check %q", check.Name()),
+						},
+					},
+				}
+				passed[j] = true
+				continue
+			}
+			if failed := failedDependency(check, indexesByName, done, passed); failed != "" {
+				ctrl.LoggerFrom(ctx).V(5).Info(
+					"Not running preflight check, because a dependency did not pass",
+					"checkName", check.Name(),
+					"dependency", failed,
+				)
+				results[j] = NamedResult{
+					Name: check.Name(),
+					CheckResult: CheckResult{
+						Allowed: true,
+						Warnings: []string{
+							fmt.Sprintf(
+								"Preflight check %q did not run, because preflight check %q did not pass",
+								check.Name(),
+								failed,
+							),
+						},
+					},
+				}
+				continue
+			}
+			checksWG.Add(1)
+			go func(
+				ctx context.Context,
+				check Check,
+				j int,
+			) {
+				defer checksWG.Done()
+				results[j] = runCheck(ctx, check)
+				passed[j] = results[j].Allowed
+			}(ctx, check, j)
+		}
+		checksWG.Wait()
+
+		for _, j := range ready {
+			done[j] = true
+		}
+		remaining -= len(ready)
+	}
+
+	return results
+}
+
+// dependencies returns the names of the checks that the check depends on.
+func dependencies(check Check) []string {
+	if dependentCheck, ok := check.(DependentCheck); ok {
+		return dependentCheck.DependsOn()
+	}
+	return nil
+}
+
+// dependenciesDone returns true if all checks that the check depends on are done.
+func dependenciesDone(check Check, indexesByName map[string][]int, done []bool) bool {
+	for _, name := range dependencies(check) {
+		for _, j := range indexesByName[name] {
+			if !done[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// failedDependency returns the name of a done check that the check depends on, and that did not pass,
+// or an empty string if all done dependencies passed.
+func failedDependency(check Check, indexesByName map[string][]int, done, passed []bool) string {
+	for _, name := range dependencies(check) {
+		for _, j := range indexesByName[name] {
+			if done[j] && !passed[j] {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// runCheck runs the check, records its metrics, and returns its result.
+// If the check panics, runCheck returns an internal error result.
+func runCheck(ctx context.Context, check Check) (result NamedResult) {
+	start := time.Now()
+	defer func() {
+		observeCheck(check.Name(), time.Since(start).Seconds(), result.CheckResult)
+	}()
+	defer func() {
+		if r := recover(); r != nil {
+			result = NamedResult{
+				Name: check.Name(),
+				CheckResult: CheckResult{
+					InternalError: true,
+					Causes: []Cause{
+						{
+							Message: fmt.Sprintf(
+								"The preflight check code had a specific internal error called a \"panic\". This error should not happen under normal circumstances. Please report it, and include the following information: %s", ///nolint:lll // Message is long.
+								r,
+							),
+							Field: "",
+						},
+					},
+				},
+			}
+			ctrl.LoggerFrom(ctx).Error(
+				fmt.Errorf("preflight check panic"),
+				fmt.Sprintf("%v", r),
+				"checkName", check.Name(),
+				"stackTrace", string(debug.Stack()),
+			)
+		}
+	}()
+	return NamedResult{
+		Name:        check.Name(),
+		CheckResult: check.Run(ctx),
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -1060,7 +1061,7 @@ func TestRun_ErrorHandlingInChecks(t *testing.T) {
 	assert.Len(t, resultsOrderedByCheckerAndCheck, 1, "expected results for 1 checker")
 	assert.Len(t, resultsOrderedByCheckerAndCheck[0], 1, "expected 1 result from the checker")
 
-	wantErrorCheckResult := namedResult{
+	wantErrorCheckResult := NamedResult{
 		Name: "error-check",
 		CheckResult: CheckResult{
 			InternalError: true,
@@ -1075,6 +1076,120 @@ func TestRun_ErrorHandlingInChecks(t *testing.T) {
 	assert.Equal(t, wantErrorCheckResult, resultsOrderedByCheckerAndCheck[0][0], "expected error check result")
 }
 
+type dependentMockCheck struct {
+	*mockCheck
+	dependsOn []string
+}
+
+func (c *dependentMockCheck) DependsOn() []string {
+	return c.dependsOn
+}
+
+func TestRun_DependentChecks(t *testing.T) {
+	tests := []struct {
+		name               string
+		dependencyResult   CheckResult
+		skipDependency     bool
+		expectDependentRun bool
+		expectedWarnings   []string
+	}{
+		{
+			name:               "dependency passes",
+			dependencyResult:   CheckResult{Allowed: true},
+			expectDependentRun: true,
+		},
+		{
+			name:               "dependency fails",
+			dependencyResult:   CheckResult{Allowed: false},
+			expectDependentRun: false,
+			expectedWarnings: []string{
+				`Preflight check "dependent" did not run, because preflight check "dependency" did not pass`,
+			},
+		},
+		{
+			name:               "dependency has an internal error",
+			dependencyResult:   CheckResult{InternalError: true},
+			expectDependentRun: false,
+			expectedWarnings: []string{
+				`Preflight check "dependent" did not run, because preflight check "dependency" did not pass`,
+			},
+		},
+		{
+			name:               "dependency skipped",
+			dependencyResult:   CheckResult{Allowed: false},
+			skipDependency:     true,
+			expectDependentRun: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := topologyCluster()
+			if tt.skipDependency {
+				cluster = topologyCluster("dependency")
+			}
+
+			dependency := &mockCheck{name: "dependency", result: tt.dependencyResult}
+			dependent := &dependentMockCheck{
+				mockCheck: &mockCheck{name: "dependent", result: CheckResult{Allowed: true}},
+				dependsOn: []string{"dependency"},
+			}
+			// The dependent check is first, to verify that it waits for its dependency.
+			checker := &mockChecker{checks: []Check{dependent, dependency}}
+
+			resultsOrderedByCheckerAndCheck := run(
+				context.Background(), nil, cluster, nil, skip.New(cluster), []Checker{checker},
+			)
+
+			assert.Equal(t, tt.expectDependentRun, dependent.run)
+			results := resultsOrderedByCheckerAndCheck[0]
+			assert.Equal(t, "dependent", results[0].Name)
+			assert.True(t, results[0].Allowed)
+			assert.Equal(t, tt.expectedWarnings, results[0].Warnings)
+		})
+	}
+}
+
+func TestRun_DependentChecksWithSameName(t *testing.T) {
+	cluster := topologyCluster()
+
+	// Checks may share a name, e.g. a check that runs for every MachineDeployment. A check depending on
+	// the name runs only if all of them pass.
+	dependent := &dependentMockCheck{
+		mockCheck: &mockCheck{name: "dependent", result: CheckResult{Allowed: true}},
+		dependsOn: []string{"dependency"},
+	}
+	checker := &mockChecker{checks: []Check{
+		&mockCheck{name: "dependency", result: CheckResult{Allowed: true}},
+		dependent,
+		&mockCheck{name: "dependency", result: CheckResult{Allowed: false}},
+	}}
+
+	run(context.Background(), nil, cluster, nil, skip.New(cluster), []Checker{checker})
+
+	assert.False(t, dependent.run)
+}
+
+func TestRun_DependencyCycle(t *testing.T) {
+	cluster := topologyCluster()
+
+	check1 := &dependentMockCheck{
+		mockCheck: &mockCheck{name: "check1", result: CheckResult{Allowed: true}},
+		dependsOn: []string{"check2"},
+	}
+	check2 := &dependentMockCheck{
+		mockCheck: &mockCheck{name: "check2", result: CheckResult{Allowed: true}},
+		dependsOn: []string{"check1"},
+	}
+	checker := &mockChecker{checks: []Check{check1, check2}}
+
+	run(context.Background(), nil, cluster, nil, skip.New(cluster), []Checker{checker})
+
+	// Checks that depend on each other run anyway, so that they do not block each other.
+	assert.True(t, check1.run)
+	assert.True(t, check2.run)
+}
+
 type panicCheck struct {
 	name string
 }
@@ -1115,13 +1230,13 @@ func TestRun_PanicHandlingInChecks(t *testing.T) {
 	assert.Len(t, resultsOrderedByCheckerAndCheck, 1, "expected results for 1 checker")
 	assert.Len(t, resultsOrderedByCheckerAndCheck[0], 2, "expected 2 results from the checker")
 
-	wantNormalCheckResult := namedResult{
+	wantNormalCheckResult := NamedResult{
 		Name: "normal-check",
 		CheckResult: CheckResult{
 			Allowed: true,
 		},
 	}
-	wantPanicCheckResult := namedResult{
+	wantPanicCheckResult := NamedResult{
 		Name: "panicking-check",
 		CheckResult: CheckResult{
 			InternalError: true,
@@ -1183,3 +1298,84 @@ func TestRun_ZeroChecksFromChecker(t *testing.T) {
 
 	assert.Equal(t, "check1", normalResults[0].Name, "expected result name to be 'check1'")
 }
+
+func TestRun_RecordsCheckMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := topologyCluster("metrics-skipped-check")
+
+	checker := &mockChecker{
+		checks: []Check{
+			&mockCheck{
+				name:   "metrics-allowed-check",
+				result: CheckResult{Allowed: true},
+			},
+			&mockCheck{
+				name:   "metrics-internal-error-check",
+				result: CheckResult{InternalError: true},
+			},
+			&panicCheck{name: "metrics-panicking-check"},
+			&mockCheck{
+				name:   "metrics-skipped-check",
+				result: CheckResult{Allowed: true},
+			},
+		},
+	}
+
+	run(ctx, nil, cluster, nil, skip.New(cluster), []Checker{checker})
+
+	assert.InDelta(t, 1, testutil.ToFloat64(
+		checkTotal.WithLabelValues("metrics-allowed-check", "true", "false"),
+	), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(
+		checkTotal.WithLabelValues("metrics-internal-error-check", "false", "true"),
+	), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(
+		checkTotal.WithLabelValues("metrics-panicking-check", "false", "true"),
+	), 0)
+	// Skipped checks are not run, so they are not recorded.
+	assert.InDelta(t, 0, testutil.ToFloat64(
+		checkTotal.WithLabelValues("metrics-skipped-check", "true", "false"),
+	), 0)
+}
+
+func TestRunChecks(t *testing.T) {
+	cluster := topologyCluster("Skipped")
+
+	checker1 := &mockChecker{
+		checks: []Check{
+			&mockCheck{name: "Check1", result: CheckResult{Allowed: true}},
+			&mockCheck{name: "Skipped", result: CheckResult{Allowed: false}},
+		},
+	}
+	checker2 := &mockChecker{
+		checks: []Check{
+			&mockCheck{name: "Check2", result: CheckResult{Causes: []Cause{{Message: "failed"}}}},
+		},
+	}
+
+	results := RunChecks(context.Background(), nil, cluster, nil, checker1, checker2)
+
+	assert.Equal(t, []NamedResult{
+		{Name: "Check1", CheckResult: CheckResult{Allowed: true}},
+		{Name: "Skipped", CheckResult: CheckResult{
+			Allowed:  true,
+			Warnings: []string{`Cluster has skipped preflight check "Skipped"`},
+		}},
+		{Name: "Check2", CheckResult: CheckResult{Causes: []Cause{{Message: "failed"}}}},
+	}, results)
+}
+
+func TestSummarizeCauses(t *testing.T) {
+	causes := []metav1.StatusCause{
+		{Type: "check1", Message: "first", Field: "spec.first"},
+		{Type: "check2", Message: "second"},
+		{Message: "untyped", Field: "spec.untyped"},
+	}
+	assert.Equal(
+		t,
+		"check1: first (field: spec.first); check2: second; untyped (field: spec.untyped)",
+		SummarizeCauses(causes),
+	)
+	assert.Empty(t, SummarizeCauses(nil))
+}
@@ -0,0 +1,265 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+	"github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/pkg/webhook/preflight/skip"
+)
+
+const (
+	// ReportOnlyTimeout is the duration that report-only checks have to run, and to report their
+	// results on the Cluster. It includes the time waiting for a new Cluster to be created.
+	ReportOnlyTimeout = 5 * time.Minute
+
+	// reportOnlyClusterPollInterval is the interval at which the Cluster is read while waiting for
+	// it to be created.
+	reportOnlyClusterPollInterval = 2 * time.Second
+)
+
+// reportOnlyEvaluator is used to determine which checks run in report-only mode, based on the
+// cluster's annotations.
+type reportOnlyEvaluator struct {
+	normalizedCheckNames map[string]struct{}
+	all                  bool
+}
+
+func newReportOnlyEvaluator(cluster *clusterv1.Cluster) *reportOnlyEvaluator {
+	e := &reportOnlyEvaluator{
+		normalizedCheckNames: make(map[string]struct{}),
+	}
+
+	value, exists := cluster.GetAnnotations()[carenv1.PreflightChecksReportOnlyAnnotationKey]
+	if !exists {
+		return e
+	}
+
+	for checkName := range strings.SplitSeq(value, ",") {
+		normalizedCheckName := strings.TrimSpace(strings.ToLower(checkName))
+		if normalizedCheckName == "" {
+			continue
+		}
+		if normalizedCheckName == carenv1.PreflightChecksSkipAllAnnotationValue {
+			e.all = true
+		}
+		e.normalizedCheckNames[normalizedCheckName] = struct{}{}
+	}
+	return e
+}
+
+// For returns true if the check runs in report-only mode. The check name is case-insensitive.
+func (e *reportOnlyEvaluator) For(checkName string) bool {
+	if e.all {
+		return true
+	}
+	_, exists := e.normalizedCheckNames[strings.TrimSpace(strings.ToLower(checkName))]
+	return exists
+}
+
+// Any returns true if any check runs in report-only mode.
+func (e *reportOnlyEvaluator) Any() bool {
+	return len(e.normalizedCheckNames) > 0
+}
+
+// deferredChecks collects the report-only checks returned by all checkers.
+type deferredChecks struct {
+	mu     sync.Mutex
+	checks []Check
+}
+
+func (d *deferredChecks) add(check Check) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.checks = append(d.checks, check)
+}
+
+// list returns the checks, ordered by name.
+func (d *deferredChecks) list() []Check {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	checks := slices.Clone(d.checks)
+	slices.SortStableFunc(checks, func(a, b Check) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return checks
+}
+
+// handlerChecker wraps a Checker to cache the results of its cacheable checks, and to defer its
+// report-only checks, so that they do not run during admission.
+type handlerChecker struct {
+	Checker
+
+	cache               *resultCache
+	skipEvaluator       *skip.Evaluator
+	reportOnlyEvaluator *reportOnlyEvaluator
+	deferred            *deferredChecks
+}
+
+func (c *handlerChecker) Init(
+	ctx context.Context,
+	client ctrlclient.Client,
+	cluster, oldCluster *clusterv1.Cluster,
+) []Check {
+	checks := c.Checker.Init(ctx, client, cluster, oldCluster)
+	admissionChecks := make([]Check, 0, len(checks))
+	for _, check := range checks {
+		if cacheableCheck, ok := check.(CacheableCheck); ok && c.cache != nil {
+			check = &cachedCheck{CacheableCheck: cacheableCheck, cache: c.cache}
+		}
+		// Skipped checks are handled like any other skipped check, even if they are report-only.
+		if !c.skipEvaluator.For(check.Name()) && c.reportOnlyEvaluator.For(check.Name()) {
+			c.deferred.add(check)
+			continue
+		}
+		admissionChecks = append(admissionChecks, check)
+	}
+	return admissionChecks
+}
+
+// reportOnlyRun identifies a run of the report-only checks of a Cluster.
+type reportOnlyRun struct {
+	cancel context.CancelFunc
+}
+
+// runReportOnly runs the report-only checks in the background, and sets the PreflightChecksPassed
+// condition on the Cluster from their results. A later run for the same Cluster cancels an
+// earlier run that has not yet reported its results.
+func (h *WebhookHandler) runReportOnly(ctx context.Context, cluster *clusterv1.Cluster, checks []Check) {
+	key := ctrlclient.ObjectKeyFromObject(cluster)
+	log := ctrl.LoggerFrom(ctx).WithValues("cluster", key)
+
+	// The checks must outlive the admission request.
+	runCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ReportOnlyTimeout)
+	runCtx = ctrl.LoggerInto(runCtx, log)
+	current := &reportOnlyRun{cancel: cancel}
+
+	h.reportOnlyRunsLock.Lock()
+	if previous, ok := h.reportOnlyRuns[key]; ok {
+		previous.cancel()
+	}
+	h.reportOnlyRuns[key] = current
+	h.reportOnlyRunsLock.Unlock()
+
+	go func() {
+		defer func() {
+			cancel()
+			h.reportOnlyRunsLock.Lock()
+			if h.reportOnlyRuns[key] == current {
+				delete(h.reportOnlyRuns, key)
+			}
+			h.reportOnlyRunsLock.Unlock()
+		}()
+
+		log.V(5).Info("Running report-only preflight checks")
+		// Skipped checks are never deferred.
+		results := runChecks(runCtx, checks, nil)
+
+		if runCtx.Err() != nil {
+			log.V(5).Info(
+				"Not reporting report-only preflight check results, because the run was cancelled or timed out",
+			)
+			return
+		}
+
+		err := setReportOnlyCondition(runCtx, h.client, key, reportOnlyCondition(results))
+		if err != nil {
+			log.Error(err, "failed to set report-only preflight checks condition on cluster")
+		}
+	}()
+}
+
+// reportOnlyCondition returns the condition that summarizes the results of the report-only checks.
+func reportOnlyCondition(results []NamedResult) metav1.Condition {
+	allowed, internalError, causes, warnings := summarize([][]NamedResult{results})
+
+	condition := metav1.Condition{
+		Type: carenv1.PreflightChecksPassedCondition,
+	}
+	switch {
+	case internalError:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = carenv1.PreflightChecksInternalErrorReason
+		condition.Message = fmt.Sprintf(
+			"preflight checks failed due to an internal error: %s",
+			SummarizeCauses(causes),
+		)
+	case !allowed:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = carenv1.PreflightChecksFailedReason
+		condition.Message = fmt.Sprintf("preflight checks failed: %s", SummarizeCauses(causes))
+	default:
+		names := make([]string, 0, len(results))
+		for _, result := range results {
+			names = append(names, result.Name)
+		}
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = carenv1.PreflightChecksPassedReason
+		condition.Message = fmt.Sprintf("preflight checks passed: %s", strings.Join(names, ", "))
+	}
+	if len(warnings) > 0 {
+		condition.Message += fmt.Sprintf(" (warnings: %s)", strings.Join(warnings, "; "))
+	}
+	return condition
+}
+
+// setReportOnlyCondition sets the condition on the Cluster. If the Cluster is being created, it
+// waits for the Cluster to exist.
+func setReportOnlyCondition(
+	ctx context.Context,
+	c ctrlclient.Client,
+	key types.NamespacedName,
+	condition metav1.Condition,
+) error {
+	err := wait.PollUntilContextCancel(
+		ctx,
+		reportOnlyClusterPollInterval,
+		true,
+		func(ctx context.Context) (bool, error) {
+			err := c.Get(ctx, key, &clusterv1.Cluster{})
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return err == nil, err
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to wait for cluster to exist: %w", err)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &clusterv1.Cluster{}
+		if err := c.Get(ctx, key, latest); err != nil {
+			return err
+		}
+		original := latest.DeepCopy()
+
+		condition.ObservedGeneration = latest.GetGeneration()
+		if !meta.SetStatusCondition(&latest.Status.Conditions, condition) {
+			return nil
+		}
+
+		return c.Status().Patch(
+			ctx,
+			latest,
+			ctrlclient.MergeFromWithOptions(original, ctrlclient.MergeFromWithOptimisticLock{}),
+		)
+	})
+}
@@ -0,0 +1,300 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	carenv1 "github.com/nutanix-cloud-native/cluster-api-runtime-extensions-nutanix/api/v1alpha1"
+)
+
+// blockingCheck runs until its context is done.
+type blockingCheck struct {
+	name      string
+	cancelled chan struct{}
+}
+
+func (c *blockingCheck) Name() string {
+	return c.name
+}
+
+func (c *blockingCheck) Run(ctx context.Context) CheckResult {
+	<-ctx.Done()
+	close(c.cancelled)
+	return CheckResult{InternalError: true}
+}
+
+func reportOnlyCluster(reportOnlyCheckNames string) *clusterv1beta2.Cluster {
+	cluster := topologyCluster()
+	cluster.Namespace = metav1.NamespaceDefault
+	cluster.Annotations[carenv1.PreflightChecksReportOnlyAnnotationKey] = reportOnlyCheckNames
+	return cluster
+}
+
+func TestReportOnlyEvaluator(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation *string
+		checkName  string
+		expectFor  bool
+		expectAny  bool
+	}{
+		{
+			name:       "no annotation",
+			annotation: nil,
+			checkName:  "Check1",
+			expectFor:  false,
+			expectAny:  false,
+		},
+		{
+			name:       "check listed, case-insensitive, with whitespace",
+			annotation: ptr.To("check1, Check2"),
+			checkName:  "CHECK2",
+			expectFor:  true,
+			expectAny:  true,
+		},
+		{
+			name:       "check not listed",
+			annotation: ptr.To("Check1,,"),
+			checkName:  "Check2",
+			expectFor:  false,
+			expectAny:  true,
+		},
+		{
+			name:       "all checks",
+			annotation: ptr.To("all"),
+			checkName:  "Check2",
+			expectFor:  true,
+			expectAny:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &clusterv1beta2.Cluster{}
+			if tt.annotation != nil {
+				cluster.Annotations = map[string]string{
+					carenv1.PreflightChecksReportOnlyAnnotationKey: *tt.annotation,
+				}
+			}
+			e := newReportOnlyEvaluator(cluster)
+			assert.Equal(t, tt.expectFor, e.For(tt.checkName))
+			assert.Equal(t, tt.expectAny, e.Any())
+		})
+	}
+}
+
+func TestReportOnlyCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		results  []NamedResult
+		expected metav1.Condition
+	}{
+		{
+			name: "passed",
+			results: []NamedResult{
+				{Name: "Check1", CheckResult: CheckResult{Allowed: true}},
+				{Name: "Check2", CheckResult: CheckResult{Allowed: true, Warnings: []string{"warning"}}},
+			},
+			expected: metav1.Condition{
+				Type:    carenv1.PreflightChecksPassedCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  carenv1.PreflightChecksPassedReason,
+				Message: "preflight checks passed: Check1, Check2 (warnings: warning)",
+			},
+		},
+		{
+			name: "failed",
+			results: []NamedResult{
+				{Name: "Check1", CheckResult: CheckResult{Allowed: true}},
+				{Name: "Check2", CheckResult: CheckResult{
+					Causes: []Cause{{Message: "cause", Field: "field"}},
+				}},
+			},
+			expected: metav1.Condition{
+				Type:    carenv1.PreflightChecksPassedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  carenv1.PreflightChecksFailedReason,
+				Message: "preflight checks failed: Check2: cause (field: field)",
+			},
+		},
+		{
+			name: "internal error",
+			results: []NamedResult{
+				{Name: "Check1", CheckResult: CheckResult{
+					Causes: []Cause{{Message: "cause"}},
+				}},
+				{Name: "Check2", CheckResult: CheckResult{
+					InternalError: true,
+					Causes:        []Cause{{Message: "timeout"}},
+				}},
+			},
+			expected: metav1.Condition{
+				Type:    carenv1.PreflightChecksPassedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  carenv1.PreflightChecksInternalErrorReason,
+				Message: "preflight checks failed due to an internal error: Check1: cause; Check2: timeout",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, reportOnlyCondition(tt.results))
+		})
+	}
+}
+
+func TestHandle_ReportOnly(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clusterv1beta2.AddToScheme(scheme))
+	decoder := admission.NewDecoder(scheme)
+
+	newRequest := func(t *testing.T, cluster *clusterv1beta2.Cluster, dryRun bool) admission.Request {
+		t.Helper()
+		jsonCluster, err := json.Marshal(cluster)
+		require.NoError(t, err)
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: jsonCluster},
+				DryRun:    ptr.To(dryRun),
+			},
+		}
+	}
+
+	t.Run("report-only check does not block admission, and reports its result on the Cluster", func(t *testing.T) {
+		cluster := reportOnlyCluster("Slow")
+		client := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(cluster.DeepCopy()).
+			WithStatusSubresource(&clusterv1beta2.Cluster{}).
+			Build()
+
+		slowCheck := &mockCheck{
+			name:   "Slow",
+			result: CheckResult{Causes: []Cause{{Message: "image not found"}}},
+		}
+		fastCheck := &mockCheck{name: "Fast", result: CheckResult{Allowed: true}}
+		handler := New(client, decoder, &mockChecker{checks: []Check{slowCheck, fastCheck}})
+
+		got := handler.Handle(context.Background(), newRequest(t, cluster, false))
+
+		assert.True(t, got.Allowed)
+		assert.Equal(t, []string{
+			"Report-only preflight checks Slow run asynchronously, and report their results in the PreflightChecksPassed condition of the Cluster", //nolint:lll // Message is long.
+		}, got.Warnings)
+		assert.True(t, fastCheck.run)
+
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			latest := &clusterv1beta2.Cluster{}
+			require.NoError(c, client.Get(context.Background(), ctrlclient.ObjectKeyFromObject(cluster), latest))
+			condition := meta.FindStatusCondition(latest.Status.Conditions, carenv1.PreflightChecksPassedCondition)
+			require.NotNil(c, condition)
+			assert.Equal(c, metav1.ConditionFalse, condition.Status)
+			assert.Equal(c, carenv1.PreflightChecksFailedReason, condition.Reason)
+			assert.Equal(c, "preflight checks failed: Slow: image not found", condition.Message)
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("skipped check is not run in report-only mode", func(t *testing.T) {
+		cluster := reportOnlyCluster("all")
+		cluster.Annotations[carenv1.PreflightChecksSkipAnnotationKey] = "Skipped"
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		skippedCheck := &mockCheck{name: "Skipped", result: CheckResult{Allowed: true}}
+		handler := New(client, decoder, &mockChecker{checks: []Check{skippedCheck}})
+
+		got := handler.Handle(context.Background(), newRequest(t, cluster, false))
+
+		assert.True(t, got.Allowed)
+		assert.Equal(t, []string{`Cluster has skipped preflight check "Skipped"`}, got.Warnings)
+		assert.False(t, skippedCheck.run)
+	})
+
+	t.Run("report-only check does not run for dry-run request", func(t *testing.T) {
+		cluster := reportOnlyCluster("Slow")
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		slowCheck := &mockCheck{name: "Slow", result: CheckResult{Allowed: true}}
+		handler := New(client, decoder, &mockChecker{checks: []Check{slowCheck}})
+
+		got := handler.Handle(context.Background(), newRequest(t, cluster, true))
+
+		assert.True(t, got.Allowed)
+		assert.Equal(t, []string{"Report-only preflight checks Slow do not run for dry-run requests"}, got.Warnings)
+		assert.Empty(t, handler.reportOnlyRuns)
+		assert.False(t, slowCheck.run)
+	})
+
+	t.Run("report-only check does not run for rejected request", func(t *testing.T) {
+		cluster := reportOnlyCluster("Slow")
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		slowCheck := &mockCheck{name: "Slow", result: CheckResult{Allowed: true}}
+		failedCheck := &mockCheck{
+			name:   "Failed",
+			result: CheckResult{Causes: []Cause{{Message: "invalid"}}},
+		}
+		handler := New(client, decoder, &mockChecker{checks: []Check{slowCheck, failedCheck}})
+
+		got := handler.Handle(context.Background(), newRequest(t, cluster, false))
+
+		assert.False(t, got.Allowed)
+		assert.Equal(t, []string{"Report-only preflight checks Slow do not run for rejected requests"}, got.Warnings)
+		assert.Empty(t, handler.reportOnlyRuns)
+		assert.False(t, slowCheck.run)
+	})
+}
+
+func TestRunReportOnly_CancelsEarlierRun(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clusterv1beta2.AddToScheme(scheme))
+	cluster := reportOnlyCluster("all")
+	client := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(cluster.DeepCopy()).
+		WithStatusSubresource(&clusterv1beta2.Cluster{}).
+		Build()
+	handler := New(client, admission.NewDecoder(scheme))
+
+	blockedCheck := &blockingCheck{name: "Blocked", cancelled: make(chan struct{})}
+	handler.runReportOnly(context.Background(), cluster, []Check{blockedCheck})
+	handler.runReportOnly(context.Background(), cluster, []Check{
+		&mockCheck{name: "Fast", result: CheckResult{Allowed: true}},
+	})
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		latest := &clusterv1beta2.Cluster{}
+		require.NoError(c, client.Get(context.Background(), ctrlclient.ObjectKeyFromObject(cluster), latest))
+		condition := meta.FindStatusCondition(latest.Status.Conditions, carenv1.PreflightChecksPassedCondition)
+		require.NotNil(c, condition)
+		assert.Equal(c, metav1.ConditionTrue, condition.Status)
+		assert.Equal(c, "preflight checks passed: Fast", condition.Message)
+
+		handler.reportOnlyRunsLock.Lock()
+		defer handler.reportOnlyRunsLock.Unlock()
+		assert.Empty(c, handler.reportOnlyRuns)
+	}, 5*time.Second, 10*time.Millisecond)
+	select {
+	case <-blockedCheck.cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the earlier run to be cancelled")
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// CacheKey returns a key that identifies the given input of a check. It is a helper for implementing
+// CacheableCheck. It returns an empty key, which disables caching, if the input cannot be encoded.
+func CacheKey(input ...any) string {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+type resultCacheEntry struct {
+	result    CheckResult
+	expiresAt time.Time
+}
+
+// resultCache stores the results of cacheable checks until they expire.
+type resultCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	entries   map[string]resultCacheEntry
+	lastSweep time.Time
+}
+
+func newResultCache(ttl time.Duration) *resultCache {
+	return &resultCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]resultCacheEntry),
+	}
+}
+
+func resultCacheKey(name, key string) string {
+	return name + "/" + key
+}
+
+// get returns the result stored for the check with the given name and key, if it has not expired.
+func (c *resultCache) get(name, key string) (CheckResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[resultCacheKey(name, key)]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return CheckResult{}, false
+	}
+	return cloneResult(entry.result), true
+}
+
+// add stores the result for the check with the given name and key. Results of internal errors are
+// not stored, because they are usually temporary.
+func (c *resultCache) add(name, key string, result CheckResult) {
+	if result.InternalError {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	// Remove expired entries at most once per TTL, so that the cache does not grow without bound.
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[resultCacheKey(name, key)] = resultCacheEntry{
+		result:    cloneResult(result),
+		expiresAt: now.Add(c.ttl),
+	}
+}
+
+func cloneResult(result CheckResult) CheckResult {
+	result.Causes = slices.Clone(result.Causes)
+	result.Warnings = slices.Clone(result.Warnings)
+	return result
+}
+
+// cachedCheck returns the cached result of the check, if there is one, and otherwise runs the check,
+// and caches its result.
+type cachedCheck struct {
+	CacheableCheck
+	cache *resultCache
+}
+
+// DependsOn returns the dependencies of the check, so that caching its result does not run it before
+// its dependencies.
+func (c *cachedCheck) DependsOn() []string {
+	return dependencies(c.CacheableCheck)
+}
+
+func (c *cachedCheck) Run(ctx context.Context) CheckResult {
+	return c.cache.getOrRun(ctx, c.Name(), c.CacheKey(), func() CheckResult {
+		return c.CacheableCheck.Run(ctx)
+	})
+}
+
+// getOrRun returns the result stored for the check with the given name and key, if there is one, and
+// otherwise runs the check, and stores its result. The check is run without storing its result if
+// the key is empty.
+func (c *resultCache) getOrRun(ctx context.Context, name, key string, run func() CheckResult) CheckResult {
+	if key == "" {
+		return run()
+	}
+
+	if result, ok := c.get(name, key); ok {
+		ctrl.LoggerFrom(ctx).V(5).Info(
+			"Using cached preflight check result",
+			"checkName", name,
+		)
+		observeCheckCacheHit(name)
+		return result
+	}
+
+	result := run()
+	// A check cancelled by the timeout may return a result that is not an internal error, but is
+	// incomplete, so it is not cached.
+	if ctx.Err() == nil {
+		c.add(name, key, result)
+	}
+	return result
+}
+
+type resultCacheContextKey struct{}
+
+// withResultCache returns a context that makes the result cache available to CachedResult.
+func withResultCache(ctx context.Context, cache *resultCache) context.Context {
+	return context.WithValue(ctx, resultCacheContextKey{}, cache)
+}
+
+// CachedResult returns the cached result of the check with the given name and key, if there is one,
+// and otherwise runs the check, and caches its result. It is a helper for checks that a Checker runs
+// in Init, because the checks it initializes depend on their result, e.g. a check that validates the
+// credentials of an infrastructure API. Checks that run in Run should implement CacheableCheck instead.
+// The check is run without caching its result if the key is empty, or if the result cache is disabled.
+func CachedResult(ctx context.Context, name, key string, run func() CheckResult) CheckResult {
+	cache, ok := ctx.Value(resultCacheContextKey{}).(*resultCache)
+	if !ok {
+		return run()
+	}
+	return cache.getOrRun(ctx, name, key, run)
+}
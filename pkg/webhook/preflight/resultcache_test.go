@@ -0,0 +1,210 @@
+// Copyright 2026 Nutanix. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type mockCacheableCheck struct {
+	name   string
+	key    string
+	result CheckResult
+	runs   atomic.Int32
+}
+
+func (m *mockCacheableCheck) Name() string {
+	return m.name
+}
+
+func (m *mockCacheableCheck) CacheKey() string {
+	return m.key
+}
+
+func (m *mockCacheableCheck) Run(_ context.Context) CheckResult {
+	m.runs.Add(1)
+	return m.result
+}
+
+func TestCacheKey(t *testing.T) {
+	assert.Equal(t, CacheKey("endpoint", "image-1"), CacheKey("endpoint", "image-1"))
+	assert.NotEqual(t, CacheKey("endpoint", "image-1"), CacheKey("endpoint", "image-2"))
+	assert.NotEqual(t, CacheKey("endpoint", "image-1"), CacheKey("endpoint-image-1"))
+	assert.Empty(t, CacheKey(make(chan int)), "input that cannot be encoded must disable caching")
+}
+
+func TestResultCache(t *testing.T) {
+	now := time.Now()
+	cache := newResultCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.get("check", "key")
+	assert.False(t, ok, "expected no result before it is added")
+
+	cache.add("check", "key", CheckResult{
+		Allowed:  false,
+		Causes:   []Cause{{Message: "failed"}},
+		Warnings: []string{"warning"},
+	})
+
+	result, ok := cache.get("check", "key")
+	require.True(t, ok)
+	assert.Equal(t, []Cause{{Message: "failed"}}, result.Causes)
+
+	// Results returned by the cache must not share memory with the cached result.
+	result.Causes[0].Message = "changed"
+	result, ok = cache.get("check", "key")
+	require.True(t, ok)
+	assert.Equal(t, "failed", result.Causes[0].Message)
+
+	_, ok = cache.get("other-check", "key")
+	assert.False(t, ok, "expected the result to be keyed by check name")
+
+	now = now.Add(time.Minute)
+	_, ok = cache.get("check", "key")
+	assert.False(t, ok, "expected the result to expire after the TTL")
+
+	cache.add("check", "other-key", CheckResult{Allowed: true})
+	assert.Len(t, cache.entries, 1, "expected expired results to be removed")
+}
+
+func TestResultCache_InternalErrorsAreNotCached(t *testing.T) {
+	cache := newResultCache(time.Minute)
+
+	cache.add("check", "key", CheckResult{InternalError: true})
+
+	_, ok := cache.get("check", "key")
+	assert.False(t, ok)
+}
+
+func TestCachedCheck(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("result is reused", func(t *testing.T) {
+		check := &mockCacheableCheck{name: "check", key: "key", result: CheckResult{Allowed: true}}
+		cached := &cachedCheck{CacheableCheck: check, cache: newResultCache(time.Minute)}
+
+		assert.Equal(t, CheckResult{Allowed: true}, cached.Run(ctx))
+		assert.Equal(t, CheckResult{Allowed: true}, cached.Run(ctx))
+		assert.Equal(t, int32(1), check.runs.Load())
+	})
+
+	t.Run("empty key disables caching", func(t *testing.T) {
+		check := &mockCacheableCheck{name: "check", result: CheckResult{Allowed: true}}
+		cached := &cachedCheck{CacheableCheck: check, cache: newResultCache(time.Minute)}
+
+		cached.Run(ctx)
+		cached.Run(ctx)
+		assert.Equal(t, int32(2), check.runs.Load())
+	})
+
+	t.Run("result of cancelled check is not cached", func(t *testing.T) {
+		check := &mockCacheableCheck{name: "check", key: "key", result: CheckResult{Allowed: true}}
+		cached := &cachedCheck{CacheableCheck: check, cache: newResultCache(time.Minute)}
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		cached.Run(cancelledCtx)
+		cached.Run(ctx)
+		assert.Equal(t, int32(2), check.runs.Load())
+	})
+
+	t.Run("dependencies are kept", func(t *testing.T) {
+		check := &dependentCacheableCheck{
+			mockCacheableCheck: &mockCacheableCheck{name: "check", key: "key"},
+			dependsOn:          []string{"dependency"},
+		}
+		cached := &cachedCheck{CacheableCheck: check, cache: newResultCache(time.Minute)}
+
+		assert.Equal(t, []string{"dependency"}, cached.DependsOn())
+	})
+}
+
+type dependentCacheableCheck struct {
+	*mockCacheableCheck
+	dependsOn []string
+}
+
+func (c *dependentCacheableCheck) DependsOn() []string {
+	return c.dependsOn
+}
+
+func TestCachedResult(t *testing.T) {
+	runs := 0
+	run := func() CheckResult {
+		runs++
+		return CheckResult{Allowed: true}
+	}
+
+	t.Run("result is not cached if the result cache is disabled", func(t *testing.T) {
+		runs = 0
+		ctx := context.Background()
+
+		assert.Equal(t, CheckResult{Allowed: true}, CachedResult(ctx, "check", "key", run))
+		assert.Equal(t, CheckResult{Allowed: true}, CachedResult(ctx, "check", "key", run))
+		assert.Equal(t, 2, runs)
+	})
+
+	t.Run("result is reused if the result cache is enabled", func(t *testing.T) {
+		runs = 0
+		ctx := withResultCache(context.Background(), newResultCache(time.Minute))
+
+		assert.Equal(t, CheckResult{Allowed: true}, CachedResult(ctx, "check", "key", run))
+		assert.Equal(t, CheckResult{Allowed: true}, CachedResult(ctx, "check", "key", run))
+		assert.Equal(t, 1, runs)
+
+		CachedResult(ctx, "check", "", run)
+		assert.Equal(t, 2, runs, "expected an empty key to disable caching")
+	})
+}
+
+func TestHandle_ResultCache(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clusterv1beta2.AddToScheme(scheme))
+	decoder := admission.NewDecoder(scheme)
+
+	cacheableCheck := &mockCacheableCheck{
+		name:   "Cacheable",
+		key:    "key",
+		result: CheckResult{Allowed: true},
+	}
+	check := &mockCheck{name: "NotCacheable", result: CheckResult{Allowed: true}}
+	checker := &mockChecker{checks: []Check{cacheableCheck, check}}
+
+	jsonCluster, err := json.Marshal(topologyCluster())
+	require.NoError(t, err)
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: jsonCluster},
+		},
+	}
+
+	handler := NewWithOptions(
+		fake.NewClientBuilder().Build(),
+		decoder,
+		Options{ResultCacheTTL: time.Minute},
+		checker,
+	)
+
+	for range 2 {
+		check.run = false
+		got := handler.Handle(context.Background(), req)
+		assert.True(t, got.Allowed)
+		assert.True(t, check.run, "expected the check that is not cacheable to run every time")
+	}
+	assert.Equal(t, int32(1), cacheableCheck.runs.Load(), "expected the cacheable check to run once")
+}